@@ -26,6 +26,7 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
 )
 
@@ -58,6 +59,15 @@ func main() {
 	enableGPUMonitoring := fs.Bool("monitor-gpu", true, "Enable GPU Monitoring (default: true, enabled by default)")
 	sriov := fs.Bool("sriov-enable", false, "sriov host mode (default: false, disabled by default)")
 	bindAddr := fs.String("bind", "0.0.0.0", "bind address for metrics server (default: 0.0.0.0)")
+	slurmDiscovery := fs.String("slurm-discovery", scheduler.SlurmDiscoveryFiles,
+		"slurm job discovery, files: prolog job files, cgroup: slurm job cgroups (default: files)")
+	batchScheduler := fs.String("batch-scheduler", scheduler.BatchSchedulerSlurm,
+		"batch scheduler of the jobs, slurm or pbs (default: slurm)")
+	containerRuntime := fs.String("container-runtime", "",
+		"attribute the GPUs to the containers outside kubernetes, docker or containerd (default: disabled)")
+	sysRoot := fs.String("sys-root", globals.SysRoot, "sysfs root of the GPU devices, ex: the host sysfs mounted in the container (default: /sys)")
+	procRoot := fs.String("proc-root", globals.ProcRoot, "procfs root of the GPU processes (default: /proc)")
+	configCRD := fs.String("config-crd", "", "MetricsExporterConfig resource name to read the config from, the config file is used as fallback (default: disabled)")
 
 	// Parse with error handling
 	err := fs.Parse(os.Args[1:])
@@ -93,6 +103,23 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *slurmDiscovery != scheduler.SlurmDiscoveryFiles && *slurmDiscovery != scheduler.SlurmDiscoveryCgroup {
+		fmt.Printf("invalid slurm-discovery %v exiting", *slurmDiscovery)
+		os.Exit(1)
+	}
+
+	if *batchScheduler != scheduler.BatchSchedulerSlurm && *batchScheduler != scheduler.BatchSchedulerPBS {
+		fmt.Printf("invalid batch-scheduler %v exiting", *batchScheduler)
+		os.Exit(1)
+	}
+
+	switch *containerRuntime {
+	case "", scheduler.ContainerRuntimeDocker, scheduler.ContainerRuntimeContainerd:
+	default:
+		fmt.Printf("invalid container-runtime %v exiting", *containerRuntime)
+		os.Exit(1)
+	}
+
 	if !*enableNICMonitoring && !*enableGPUMonitoring {
 		fmt.Printf("NIC Agent and GPU Agent are both disabled, exiting")
 		os.Exit(1)
@@ -111,6 +138,12 @@ func main() {
 		exporter.WithGPUMonitoring(*enableGPUMonitoring),
 		exporter.WithSRIOV(*sriov),
 		exporter.WithBindAddr(*bindAddr),
+		exporter.WithConfigCRD(*configCRD),
+		exporter.WithSlurmDiscovery(*slurmDiscovery),
+		exporter.WithBatchScheduler(*batchScheduler),
+		exporter.WithContainerRuntime(*containerRuntime),
+		exporter.WithSysRoot(*sysRoot),
+		exporter.WithProcRoot(*procRoot),
 	)
 
 	enableDebugAPI := true // default
@@ -0,0 +1,59 @@
+#
+#Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+
+#Licensed under the Apache License, Version 2.0 (the \"License\");
+#you may not use this file except in compliance with the License.
+#You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+#Unless required by applicable law or agreed to in writing, software
+#distributed under the License is distributed on an \"AS IS\" BASIS,
+#WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+#See the License for the specific language governing permissions and
+#limitations under the License.
+#
+
+# PBS Pro/OpenPBS hook reporting the jobs to the metrics exporter, the job
+# file is written on execjob_launch and removed on execjob_end
+import json
+import os
+
+import pbs
+
+EXPORT_DIR = "/var/run/exporter/pbs"
+# the exporter resolves the job gpus from the first variable set in
+# PBS_GPUS, ROCR_VISIBLE_DEVICES, HIP_VISIBLE_DEVICES, CUDA_VISIBLE_DEVICES
+# and GPU_DEVICE_ORDINAL
+ENV_KEYS = [
+    "PBS_O_LOGNAME",
+    "PBS_ARRAY_ID",
+    "PBS_ARRAY_INDEX",
+    "PBS_GPUS",
+    "ROCR_VISIBLE_DEVICES",
+    "HIP_VISIBLE_DEVICES",
+    "CUDA_VISIBLE_DEVICES",
+    "GPU_DEVICE_ORDINAL",
+]
+
+e = pbs.event()
+try:
+    job_file = os.path.join(EXPORT_DIR, "job-" + e.job.id)
+    if e.type == pbs.EXECJOB_LAUNCH and os.path.isdir(EXPORT_DIR):
+        msg = {
+            "PBS_JOBID": e.job.id,
+            "PBS_QUEUE": str(e.job.queue),
+            "USER": str(e.job.euser),
+        }
+        for key in ENV_KEYS:
+            if e.env.get(key):
+                msg[key] = str(e.env[key])
+        tmp_file = job_file + ".tmp"
+        with open(tmp_file, "w") as f:
+            json.dump(msg, f)
+        os.rename(tmp_file, job_file)
+    elif e.type == pbs.EXECJOB_END and os.path.exists(job_file):
+        os.remove(job_file)
+except Exception as err:
+    pbs.logmsg(pbs.EVENT_DEBUG, "metrics exporter hook failed: %s" % err)
+e.accept()
@@ -142,9 +142,28 @@ function mysplit (inputstr, sep)
         return t
 end
 
+-- allocated gpus, by the exporter order of precedence
+local gpuEnvs = {
+    "SLURM_STEP_GPUS",
+    "SLURM_JOB_GPUS",
+    "ROCR_VISIBLE_DEVICES",
+    "HIP_VISIBLE_DEVICES",
+    "CUDA_VISIBLE_DEVICES",
+    "GPU_DEVICE_ORDINAL",
+}
+
+function GetAllocGPUs (spank)
+    for _, name in ipairs(gpuEnvs) do
+        local gpus = spank:getenv(name)
+        if gpus ~= nil and gpus ~= "" and gpus ~= "NoDevFiles" then
+            return gpus
+        end
+    end
+    return ""
+end
+
 function GetSpankData (spank)
-    local cudadev = spank:getenv("CUDA_VISIBLE_DEVICES")
-    if cudadev ~= nil then cudadev = "" end
+    local gpus = GetAllocGPUs(spank)
     local spankData = {
 	JobID = spank:get_item("S_JOB_ID"),
 	JobGID = spank:get_item("S_JOB_GID"),
@@ -156,7 +175,7 @@ function GetSpankData (spank)
 	TaskID = spank:get_item("S_TASK_ID"),
 	TaskPID = spank:get_item("S_TASK_PID"),
 	AllocCores = mysplit(spank:get_item("S_JOB_ALLOC_CORES"), " "),
-	AllocGPUs = mysplit(cudadev, ",")
+	AllocGPUs = mysplit(gpus, ",")
     }
     return spankData
 end
@@ -17,40 +17,5 @@
 #
 
 EXPORT_DIR="/var/run/exporter/"
-mod128_array() {
-    local arr_str="$1"
-    local arr result
-
-    # convert string to array using comma as delimiter
-    IFS=',' read -ra arr <<< "$arr_str"
-
-    # modulo 128 to each element
-    for i in "${!arr[@]}"; do
-        arr[i]=$(( ${arr[i]} % 128 ))
-    done
-
-    # join array back into a comma-separated string
-    result=$(IFS=','; echo "${arr[*]}")
-
-    echo "$result"
-}
-AMDGPU_DEVICES=$(mod128_array "${CUDA_VISIBLE_DEVICES}")
-AMD_SLURM_GPUS=$(mod128_array "${SLURM_JOB_GPUS}")
-MSG=$(
-	cat <<EOF
-    {
-    "SLURM_JOB_ID": "${SLURM_JOB_ID}",
-    "SLURM_JOB_USER": "${SLURM_JOB_USER}",
-    "SLURM_JOB_PARTITION": "${SLURM_JOB_PARTITION}",
-    "SLURM_CLUSTER_NAME": "${SLURM_CLUSTER_NAME}",
-    "SLURM_JOB_GPUS": "${AMD_SLURM_GPUS}",
-    "CUDA_VISIBLE_DEVICES": "${AMDGPU_DEVICES}",
-    "SLURM_SCRIPT_CONTEXT": "${SLURM_SCRIPT_CONTEXT}"
-   }
-EOF
-)
 [ -d ${EXPORT_DIR} ] || exit 0
-GPUS=$(echo ${AMDGPU_DEVICES} | tr "," "\n")
-for GPUID in ${GPUS}; do
-	rm -f ${EXPORT_DIR}/${GPUID}
-done
+rm -f ${EXPORT_DIR}/job-${SLURM_JOB_ID}
@@ -17,25 +17,9 @@
 #
 
 EXPORT_DIR="/var/run/exporter/"
-mod128_array() {
-    local arr_str="$1"
-    local arr result
-
-    # convert string to array using comma as delimiter
-    IFS=',' read -ra arr <<< "$arr_str"
-
-    # modulo 128 to each element
-    for i in "${!arr[@]}"; do
-        arr[i]=$(( ${arr[i]} % 128 ))
-    done
-
-    # join array back into a comma-separated string
-    result=$(IFS=','; echo "${arr[*]}")
-
-    echo "$result"
-}
-AMDGPU_DEVICES=$(mod128_array "${CUDA_VISIBLE_DEVICES}")
-AMD_SLURM_GPUS=$(mod128_array "${SLURM_JOB_GPUS}")
+# the exporter resolves the job gpus from the first variable set in
+# SLURM_STEP_GPUS, SLURM_JOB_GPUS, ROCR_VISIBLE_DEVICES, HIP_VISIBLE_DEVICES,
+# CUDA_VISIBLE_DEVICES and GPU_DEVICE_ORDINAL
 MSG=$(
 	cat <<EOF
     {
@@ -43,14 +27,17 @@ MSG=$(
     "SLURM_JOB_USER": "${SLURM_JOB_USER}",
     "SLURM_JOB_PARTITION": "${SLURM_JOB_PARTITION}",
     "SLURM_CLUSTER_NAME": "${SLURM_CLUSTER_NAME}",
-    "SLURM_JOB_GPUS": "${AMD_SLURM_GPUS}",
-    "CUDA_VISIBLE_DEVICES": "${AMDGPU_DEVICES}",
+    "SLURM_NODEID": "${SLURM_NODEID}",
+    "SLURM_ARRAY_JOB_ID": "${SLURM_ARRAY_JOB_ID}",
+    "SLURM_ARRAY_TASK_ID": "${SLURM_ARRAY_TASK_ID}",
+    "SLURM_JOB_GPUS": "${SLURM_JOB_GPUS}",
+    "ROCR_VISIBLE_DEVICES": "${ROCR_VISIBLE_DEVICES}",
+    "HIP_VISIBLE_DEVICES": "${HIP_VISIBLE_DEVICES}",
+    "CUDA_VISIBLE_DEVICES": "${CUDA_VISIBLE_DEVICES}",
+    "GPU_DEVICE_ORDINAL": "${GPU_DEVICE_ORDINAL}",
     "SLURM_SCRIPT_CONTEXT": "${SLURM_SCRIPT_CONTEXT}"
    }
 EOF
 )
 [ -d ${EXPORT_DIR} ] || exit 0
-GPUS=$(echo ${AMDGPU_DEVICES} | tr "," "\n")
-for GPUID in ${GPUS}; do
-	echo ${MSG} >${EXPORT_DIR}/${GPUID}
-done
+echo ${MSG} >${EXPORT_DIR}/job-${SLURM_JOB_ID}
@@ -0,0 +1 @@
+../../debian/usr/local/etc/metrics/pbs/exporter-hook.py
\ No newline at end of file
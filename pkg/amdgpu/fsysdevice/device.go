@@ -24,24 +24,27 @@ import (
 	"strconv"
 	"sync"
 
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 )
 
 const (
 	AMDLogicalDevicePrefix = "amdgpu_xcp_"
 	AMDGPURenderStartID    = 128
+
+	kfdTopologyNodesDir = "class/kfd/kfd/topology/nodes"
 )
 
 var (
 	once sync.Once
 )
 
-func getUsedVRAM(nodeid string) (float64, error) {
+func getUsedVRAM(sysRoot, nodeid string) (float64, error) {
 	if nodeid == "" {
 		return 0, fmt.Errorf("nodeid is empty")
 	}
 
-	filePath := filepath.Join("/sys/class/kfd/kfd/topology/nodes", nodeid, "mem_banks/0/used_memory")
+	filePath := filepath.Join(sysRoot, kfdTopologyNodesDir, nodeid, "mem_banks/0/used_memory")
 	fileInfo, err := os.Stat(filePath)
 	if err != nil {
 		return 0, fmt.Errorf("failed to stat file: %w", err)
@@ -70,9 +73,9 @@ func getUsedVRAM(nodeid string) (float64, error) {
 	return usedVRAM, nil
 }
 
-func getAllUsedVRAM() (map[string]float64, error) {
+func getAllUsedVRAM(sysRoot string) (map[string]float64, error) {
 	result := make(map[string]float64)
-	nodesPath := "/sys/class/kfd/kfd/topology/nodes"
+	nodesPath := filepath.Join(sysRoot, kfdTopologyNodesDir)
 
 	entries, err := os.ReadDir(nodesPath)
 	if err != nil {
@@ -84,7 +87,7 @@ func getAllUsedVRAM() (map[string]float64, error) {
 			continue
 		}
 		nodeid := entry.Name()
-		usedVRAM, err := getUsedVRAM(nodeid)
+		usedVRAM, err := getUsedVRAM(sysRoot, nodeid)
 		if err != nil {
 			// Optionally log error and continue
 			continue
@@ -97,9 +100,13 @@ func getAllUsedVRAM() (map[string]float64, error) {
 // FindAMDGPUDevices scans the system for AMDGPU XCP devices and returns a map
 // where the key is "gpu_id" and value is device name "amdgpu_xcp_N"
 func FindAMDGPUDevices() (map[string]string, error) {
+	return findAMDGPUDevices(globals.SysRoot)
+}
+
+func findAMDGPUDevices(sysRoot string) (map[string]string, error) {
 	result := make(map[string]string)
 
-	basePattern := "/sys/devices/platform/amdgpu_xcp_*/drm/renderD*"
+	basePattern := filepath.Join(sysRoot, "devices/platform/amdgpu_xcp_*/drm/renderD*")
 	matches, err := filepath.Glob(basePattern)
 	if err != nil {
 		return nil, fmt.Errorf("glob error: %w", err)
@@ -137,6 +144,7 @@ func FindAMDGPUDevices() (map[string]string, error) {
 
 type FsysDevice struct {
 	mu      sync.Mutex
+	sysRoot string
 	lgpuMap map[string]string
 }
 
@@ -144,16 +152,24 @@ var FsysDeviceHandler *FsysDevice
 
 func GetFsysDeviceHandler() *FsysDevice {
 	if FsysDeviceHandler == nil {
-		FsysDeviceHandler = &FsysDevice{
-			lgpuMap: make(map[string]string),
-		}
-		FsysDeviceHandler.init()
+		FsysDeviceHandler = NewFsysDevice(globals.SysRoot)
 	}
 	return FsysDeviceHandler
 }
 
+// NewFsysDevice creates a device handler reading the sysfs mounted at
+// sysRoot
+func NewFsysDevice(sysRoot string) *FsysDevice {
+	fs := &FsysDevice{
+		sysRoot: sysRoot,
+		lgpuMap: make(map[string]string),
+	}
+	fs.init()
+	return fs
+}
+
 func (fs *FsysDevice) init() {
-	lgpuMap, err := FindAMDGPUDevices()
+	lgpuMap, err := findAMDGPUDevices(fs.sysRoot)
 	if err != nil {
 		logger.Log.Printf("FindAMDGPUDevices error :%v", err)
 		return
@@ -173,9 +189,9 @@ func (fs *FsysDevice) GetDeviceNameFromRenderID(renderId string) (string, error)
 func (fs *FsysDevice) GetUsedVRAM(nodeid string) (float64, error) {
 	fs.mu.Lock()
 	defer fs.mu.Unlock()
-	return getUsedVRAM(nodeid)
+	return getUsedVRAM(fs.sysRoot, nodeid)
 }
 
 func (fs *FsysDevice) GetAllUsedVRAM() (map[string]float64, error) {
-	return getAllUsedVRAM()
+	return getAllUsedVRAM(fs.sysRoot)
 }
@@ -0,0 +1,334 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package fsysdevice
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gpumetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+const (
+	drmClassDir = "class/drm"
+	amdVendorId = "0x1002"
+)
+
+var (
+	cardRe     = regexp.MustCompile(`^card(\d+)$`)
+	renderRe   = regexp.MustCompile(`renderD(\d+)$`)
+	hwmonInRe  = regexp.MustCompile(`^in(\d+)_label$`)
+	hwmonTmpRe = regexp.MustCompile(`^temp(\d+)_label$`)
+)
+
+// hwmon temperature and voltage labels of amdgpu
+var (
+	hwmonTemperatureFields = map[string]exportermetrics.GPUMetricField{
+		"edge":     exportermetrics.GPUMetricField_GPU_EDGE_TEMPERATURE,
+		"junction": exportermetrics.GPUMetricField_GPU_JUNCTION_TEMPERATURE,
+		"mem":      exportermetrics.GPUMetricField_GPU_MEMORY_TEMPERATURE,
+	}
+	hwmonVoltageFields = map[string]exportermetrics.GPUMetricField{
+		"vddnb":  exportermetrics.GPUMetricField_GPU_VOLTAGE,
+		"vddgfx": exportermetrics.GPUMetricField_GPU_GFX_VOLTAGE,
+	}
+)
+
+// GPUMetrics are the metrics of an amdgpu device read from sysfs, the
+// values are keyed by the GPUMetricField names in the exported units
+type GPUMetrics struct {
+	// Index is derived from the render node as for the logical devices
+	Index     int
+	CardId    int
+	RenderId  int
+	PCIeBusId string
+	SerialNum string
+	CardModel string
+	Values    map[string]float64
+}
+
+// GPUDevice is an amdgpu pci device of the drm class
+type GPUDevice struct {
+	// Index is derived from the render node as for the logical devices
+	Index     int
+	CardId    int
+	RenderId  int
+	PCIeBusId string
+	// DevDir is the sysfs directory of the device attributes
+	DevDir string
+}
+
+// GetGPUDevices returns the amdgpu devices of the drm cards sorted by index
+func (fs *FsysDevice) GetGPUDevices() ([]*GPUDevice, error) {
+	entries, err := os.ReadDir(filepath.Join(fs.sysRoot, drmClassDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read drm class directory: %w", err)
+	}
+	devices := []*GPUDevice{}
+	for _, entry := range entries {
+		match := cardRe.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		devDir := filepath.Join(fs.sysRoot, drmClassDir, entry.Name(), "device")
+		// partitions of the logical devices are not pci devices
+		if vendor, err := readString(filepath.Join(devDir, "vendor")); err != nil || vendor != amdVendorId {
+			continue
+		}
+		cardId, _ := strconv.Atoi(match[1])
+		dev := &GPUDevice{
+			Index:  cardId,
+			CardId: cardId,
+			DevDir: devDir,
+		}
+		if renders, _ := filepath.Glob(filepath.Join(devDir, "drm", "renderD*")); len(renders) != 0 {
+			sort.Strings(renders)
+			if match := renderRe.FindStringSubmatch(renders[0]); match != nil {
+				dev.RenderId, _ = strconv.Atoi(match[1])
+				dev.Index = dev.RenderId % AMDGPURenderStartID
+			}
+		}
+		dev.PCIeBusId = pciSlotName(devDir)
+		devices = append(devices, dev)
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].Index < devices[j].Index
+	})
+	return devices, nil
+}
+
+// GetRenderIds returns the render node ids of the amdgpu devices and of
+// their logical partitions, sorted
+func (fs *FsysDevice) GetRenderIds() ([]int, error) {
+	devices, err := fs.GetGPUDevices()
+	if err != nil {
+		return nil, err
+	}
+	ids := []int{}
+	for _, dev := range devices {
+		if dev.RenderId != 0 {
+			ids = append(ids, dev.RenderId)
+		}
+	}
+	lgpus, err := findAMDGPUDevices(fs.sysRoot)
+	if err != nil {
+		return nil, err
+	}
+	for gpuID := range lgpus {
+		index, err := strconv.Atoi(gpuID)
+		if err != nil {
+			continue
+		}
+		if id := index + AMDGPURenderStartID; !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// GetGPUMetrics reads the metrics of the amdgpu devices from the device
+// attributes, the hwmon and the gpu_metrics table of the drm cards, the
+// devices are sorted by index
+func (fs *FsysDevice) GetGPUMetrics() ([]*GPUMetrics, error) {
+	devices, err := fs.GetGPUDevices()
+	if err != nil {
+		return nil, err
+	}
+	gpus := []*GPUMetrics{}
+	for _, dev := range devices {
+		gpu := &GPUMetrics{
+			Index:     dev.Index,
+			CardId:    dev.CardId,
+			RenderId:  dev.RenderId,
+			PCIeBusId: dev.PCIeBusId,
+			Values:    make(map[string]float64),
+		}
+		gpu.SerialNum, _ = readString(filepath.Join(dev.DevDir, "unique_id"))
+		gpu.CardModel, _ = readString(filepath.Join(dev.DevDir, "product_name"))
+		readDeviceMetrics(dev.DevDir, gpu.Values)
+		if hwmons, _ := filepath.Glob(filepath.Join(dev.DevDir, "hwmon", "hwmon*")); len(hwmons) != 0 {
+			sort.Strings(hwmons)
+			readHwmonMetrics(hwmons[0], gpu.Values)
+		}
+		readGPUMetricsTable(dev.DevDir, gpu.Values)
+		gpus = append(gpus, gpu)
+	}
+	return gpus, nil
+}
+
+// readGPUMetricsTable adds the fields of the gpu_metrics table not read
+// from the device attributes and the hwmon, fields with instances are
+// skipped as the sysfs metrics have no instance labels
+func readGPUMetricsTable(devDir string, values map[string]float64) {
+	table, err := gpumetrics.Read(devDir)
+	if err != nil {
+		return
+	}
+	for _, v := range table.Values() {
+		if v.Index >= 0 {
+			continue
+		}
+		if _, ok := values[v.Field]; !ok {
+			values[v.Field] = v.Value
+		}
+	}
+}
+
+// pciSlotName returns the pci address of the device from its uevent
+func pciSlotName(devDir string) string {
+	data, err := os.ReadFile(filepath.Join(devDir, "uevent"))
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if slot, ok := strings.CutPrefix(line, "PCI_SLOT_NAME="); ok {
+			return strings.ToLower(strings.TrimSpace(slot))
+		}
+	}
+	return ""
+}
+
+// readDeviceMetrics reads the activity, memory and pcie attributes of the
+// amdgpu device
+func readDeviceMetrics(devDir string, values map[string]float64) {
+	set := func(field exportermetrics.GPUMetricField, file string, scale float64) {
+		if v, err := readFloat(filepath.Join(devDir, file)); err == nil {
+			values[field.String()] = v * scale
+		}
+	}
+	set(exportermetrics.GPUMetricField_GPU_GFX_ACTIVITY, "gpu_busy_percent", 1)
+	set(exportermetrics.GPUMetricField_GPU_UMC_ACTIVITY, "mem_busy_percent", 1)
+
+	// memory in bytes exported in MB
+	const mb = 1.0 / (1024 * 1024)
+	memFields := []struct {
+		total, used, free exportermetrics.GPUMetricField
+		prefix            string
+	}{
+		{exportermetrics.GPUMetricField_GPU_TOTAL_VRAM, exportermetrics.GPUMetricField_GPU_USED_VRAM,
+			exportermetrics.GPUMetricField_GPU_FREE_VRAM, "mem_info_vram"},
+		{exportermetrics.GPUMetricField_GPU_TOTAL_VISIBLE_VRAM, exportermetrics.GPUMetricField_GPU_USED_VISIBLE_VRAM,
+			exportermetrics.GPUMetricField_GPU_FREE_VISIBLE_VRAM, "mem_info_vis_vram"},
+		{exportermetrics.GPUMetricField_GPU_TOTAL_GTT, exportermetrics.GPUMetricField_GPU_USED_GTT,
+			exportermetrics.GPUMetricField_GPU_FREE_GTT, "mem_info_gtt"},
+	}
+	for _, m := range memFields {
+		total, err := readFloat(filepath.Join(devDir, m.prefix+"_total"))
+		if err != nil {
+			continue
+		}
+		values[m.total.String()] = total * mb
+		if used, err := readFloat(filepath.Join(devDir, m.prefix+"_used")); err == nil {
+			values[m.used.String()] = used * mb
+			values[m.free.String()] = (total - used) * mb
+		}
+	}
+
+	// received and sent messages of the last second with their max payload
+	// size, exported in Mb/s
+	if data, err := readString(filepath.Join(devDir, "pcie_bw")); err == nil {
+		if fields := strings.Fields(data); len(fields) == 3 {
+			rx, err1 := strconv.ParseUint(fields[0], 10, 64)
+			tx, err2 := strconv.ParseUint(fields[1], 10, 64)
+			mps, err3 := strconv.ParseUint(fields[2], 10, 64)
+			if err1 == nil && err2 == nil && err3 == nil {
+				values[exportermetrics.GPUMetricField_PCIE_BANDWIDTH.String()] = float64((rx+tx)*mps*8) / 1e6
+			}
+		}
+	}
+	for field, file := range map[exportermetrics.GPUMetricField]string{
+		exportermetrics.GPUMetricField_PCIE_SPEED:     "current_link_speed",
+		exportermetrics.GPUMetricField_PCIE_MAX_SPEED: "max_link_speed",
+	} {
+		// ex: 16.0 GT/s PCIe
+		if data, err := readString(filepath.Join(devDir, file)); err == nil {
+			if fields := strings.Fields(data); len(fields) != 0 {
+				if v, err := strconv.ParseFloat(fields[0], 64); err == nil {
+					values[field.String()] = v
+				}
+			}
+		}
+	}
+}
+
+// readHwmonMetrics reads the power, energy, temperatures, fan and voltages
+// of the amdgpu hwmon
+func readHwmonMetrics(hwmonDir string, values map[string]float64) {
+	set := func(field exportermetrics.GPUMetricField, file string, scale float64) bool {
+		v, err := readFloat(filepath.Join(hwmonDir, file))
+		if err != nil {
+			return false
+		}
+		values[field.String()] = v * scale
+		return true
+	}
+	// power in microwatts, the average power is the only power reported by
+	// the older devices
+	avg := set(exportermetrics.GPUMetricField_GPU_AVERAGE_PACKAGE_POWER, "power1_average", 1e-6)
+	if !set(exportermetrics.GPUMetricField_GPU_PACKAGE_POWER, "power1_input", 1e-6) && avg {
+		values[exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String()] =
+			values[exportermetrics.GPUMetricField_GPU_AVERAGE_PACKAGE_POWER.String()]
+	}
+	set(exportermetrics.GPUMetricField_GPU_ENERGY_CONSUMED, "energy1_input", 1)
+	set(exportermetrics.GPUMetricField_GPU_FAN_SPEED, "fan1_input", 1)
+
+	entries, err := os.ReadDir(hwmonDir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		// temperatures in millidegrees Celsius
+		if match := hwmonTmpRe.FindStringSubmatch(name); match != nil {
+			label, _ := readString(filepath.Join(hwmonDir, name))
+			if field, ok := hwmonTemperatureFields[label]; ok {
+				set(field, "temp"+match[1]+"_input", 1e-3)
+			}
+		}
+		// voltages in millivolts
+		if match := hwmonInRe.FindStringSubmatch(name); match != nil {
+			label, _ := readString(filepath.Join(hwmonDir, name))
+			if field, ok := hwmonVoltageFields[label]; ok {
+				set(field, "in"+match[1]+"_input", 1)
+			}
+		}
+	}
+}
+
+func readString(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func readFloat(path string) (float64, error) {
+	data, err := readString(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(data, 64)
+}
@@ -0,0 +1,153 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package fsysdevice
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func writeFixture(t *testing.T, root string, files map[string]string) {
+	for path, data := range files {
+		path = filepath.Join(root, path)
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NilError(t, os.WriteFile(path, []byte(data+"\n"), 0644))
+	}
+}
+
+func TestGetGPUMetrics(t *testing.T) {
+	logger.Init(true)
+	root := t.TempDir()
+	card1 := "class/drm/card1/device/"
+	hwmon := card1 + "hwmon/hwmon3/"
+	writeFixture(t, root, map[string]string{
+		card1 + "vendor":                "0x1002",
+		card1 + "uevent":                "DRIVER=amdgpu\nPCI_SLOT_NAME=0000:C1:00.0",
+		card1 + "unique_id":             "d1c7b3e5a1e8f0a2",
+		card1 + "product_name":          "AMD Instinct MI210",
+		card1 + "drm/renderD129/dev":    "226:129",
+		card1 + "gpu_busy_percent":      "45",
+		card1 + "mem_busy_percent":      "12",
+		card1 + "mem_info_vram_total":   "68702699520",
+		card1 + "mem_info_vram_used":    "1073741824",
+		card1 + "mem_info_gtt_total":    "2147483648",
+		card1 + "pcie_bw":               "1000 500 256",
+		card1 + "current_link_speed":    "16.0 GT/s PCIe",
+		card1 + "max_link_speed":        "32.0 GT/s PCIe",
+		hwmon + "power1_average":        "95000000",
+		hwmon + "fan1_input":            "1200",
+		hwmon + "temp1_label":           "edge",
+		hwmon + "temp1_input":           "41000",
+		hwmon + "temp2_label":           "junction",
+		hwmon + "temp2_input":           "45500",
+		hwmon + "temp3_label":           "mem",
+		hwmon + "temp3_input":           "50000",
+		hwmon + "in0_label":             "vddgfx",
+		hwmon + "in0_input":             "806",
+		hwmon + "in1_label":             "vddnb",
+		hwmon + "in1_input":             "790",
+		"class/drm/card0/device/vendor": "0x1002",
+		"class/drm/card0/device/uevent": "PCI_SLOT_NAME=0000:03:00.0",
+		"class/drm/card2/device/vendor": "0x10de",
+		"class/drm/renderD129/dev":      "226:129",
+		"class/drm/card1-DP-1/status":   "disconnected",
+		"class/drm/card3/device/uevent": "DRIVER=amdgpu_xcp_drv",
+		"class/drm/version":             "drm 1.1.0 20060810",
+	})
+
+	// gpu_metrics v1_3 table with the edge temperature and memory voltage
+	table := make([]byte, 120)
+	binary.LittleEndian.PutUint16(table, 120)
+	table[2], table[3] = 1, 3
+	binary.LittleEndian.PutUint16(table[4:], 38)
+	binary.LittleEndian.PutUint16(table[108:], 1200)
+	assert.NilError(t, os.WriteFile(filepath.Join(root, "class/drm/card0/device/gpu_metrics"), table, 0644))
+
+	fs := NewFsysDevice(root)
+	gpus, err := fs.GetGPUMetrics()
+	assert.NilError(t, err)
+	assert.Equal(t, len(gpus), 2)
+
+	// card without render node nor hwmon, metrics from gpu_metrics
+	assert.Equal(t, gpus[0].Index, 0)
+	assert.Equal(t, gpus[0].PCIeBusId, "0000:03:00.0")
+	assert.Equal(t, gpus[0].Values[exportermetrics.GPUMetricField_GPU_EDGE_TEMPERATURE.String()], 38.0)
+	assert.Equal(t, gpus[0].Values[exportermetrics.GPUMetricField_GPU_MEMORY_VOLTAGE.String()], 1200.0)
+	_, ok := gpus[0].Values[exportermetrics.GPUMetricField_GPU_HBM_TEMPERATURE.String()]
+	assert.Assert(t, !ok)
+
+	gpu := gpus[1]
+	assert.Equal(t, gpu.Index, 1)
+	assert.Equal(t, gpu.CardId, 1)
+	assert.Equal(t, gpu.RenderId, 129)
+	assert.Equal(t, gpu.PCIeBusId, "0000:c1:00.0")
+	assert.Equal(t, gpu.SerialNum, "d1c7b3e5a1e8f0a2")
+	assert.Equal(t, gpu.CardModel, "AMD Instinct MI210")
+	assert.DeepEqual(t, gpu.Values, map[string]float64{
+		exportermetrics.GPUMetricField_GPU_GFX_ACTIVITY.String():          45,
+		exportermetrics.GPUMetricField_GPU_UMC_ACTIVITY.String():          12,
+		exportermetrics.GPUMetricField_GPU_TOTAL_VRAM.String():            65520,
+		exportermetrics.GPUMetricField_GPU_USED_VRAM.String():             1024,
+		exportermetrics.GPUMetricField_GPU_FREE_VRAM.String():             64496,
+		exportermetrics.GPUMetricField_GPU_TOTAL_GTT.String():             2048,
+		exportermetrics.GPUMetricField_PCIE_BANDWIDTH.String():            3.072,
+		exportermetrics.GPUMetricField_PCIE_SPEED.String():                16,
+		exportermetrics.GPUMetricField_PCIE_MAX_SPEED.String():            32,
+		exportermetrics.GPUMetricField_GPU_AVERAGE_PACKAGE_POWER.String(): 95,
+		exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String():         95,
+		exportermetrics.GPUMetricField_GPU_FAN_SPEED.String():             1200,
+		exportermetrics.GPUMetricField_GPU_EDGE_TEMPERATURE.String():      41,
+		exportermetrics.GPUMetricField_GPU_JUNCTION_TEMPERATURE.String():  45.5,
+		exportermetrics.GPUMetricField_GPU_MEMORY_TEMPERATURE.String():    50,
+		exportermetrics.GPUMetricField_GPU_GFX_VOLTAGE.String():           806,
+		exportermetrics.GPUMetricField_GPU_VOLTAGE.String():               790,
+	})
+
+	// sysfs without drm devices
+	_, err = NewFsysDevice(t.TempDir()).GetGPUMetrics()
+	assert.Assert(t, err != nil)
+}
+
+func TestGetRenderIds(t *testing.T) {
+	logger.Init(true)
+	root := t.TempDir()
+	writeFixture(t, root, map[string]string{
+		"class/drm/card0/device/vendor":                     "0x1002",
+		"class/drm/card0/device/drm/renderD128/dev":         "226:128",
+		"class/drm/card1/device/vendor":                     "0x1002",
+		"class/drm/card1/device/drm/renderD136/dev":         "226:136",
+		"class/drm/card2/device/vendor":                     "0x10de",
+		"class/drm/card2/device/drm/renderD144/dev":         "226:144",
+		"devices/platform/amdgpu_xcp_1/drm/renderD129/dev":  "226:129",
+		"devices/platform/amdgpu_xcp_2/drm/renderD130/dev":  "226:130",
+		"devices/platform/amdgpu_xcp_2/drm/card9/dev":       "226:9",
+		"devices/platform/amdgpu_xcp_10/drm/renderD137/dev": "226:137",
+	})
+	ids, err := NewFsysDevice(root).GetRenderIds()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ids, []int{128, 129, 130, 136, 137})
+
+	_, err = NewFsysDevice(t.TempDir()).GetRenderIds()
+	assert.Assert(t, err != nil)
+}
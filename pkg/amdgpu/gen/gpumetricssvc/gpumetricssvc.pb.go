@@ -30,6 +30,7 @@ package gpumetricssvc
 
 import (
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -49,6 +50,8 @@ const (
 	GPUHealth_UNKNOWN   GPUHealth = 0
 	GPUHealth_HEALTHY   GPUHealth = 1
 	GPUHealth_UNHEALTHY GPUHealth = 2
+	// warning level condition, GPU is still usable
+	GPUHealth_DEGRADED GPUHealth = 3
 )
 
 // Enum value maps for GPUHealth.
@@ -57,11 +60,13 @@ var (
 		0: "UNKNOWN",
 		1: "HEALTHY",
 		2: "UNHEALTHY",
+		3: "DEGRADED",
 	}
 	GPUHealth_value = map[string]int32{
 		"UNKNOWN":   0,
 		"HEALTHY":   1,
 		"UNHEALTHY": 2,
+		"DEGRADED":  3,
 	}
 )
 
@@ -92,6 +97,232 @@ func (GPUHealth) EnumDescriptor() ([]byte, []int) {
 	return file_gpumetricssvc_proto_rawDescGZIP(), []int{0}
 }
 
+type GPUHealthReasonType int32
+
+const (
+	GPUHealthReasonType_REASON_NONE GPUHealthReasonType = 0
+	// metric field crossed the configured health threshold
+	GPUHealthReasonType_REASON_FIELD_THRESHOLD GPUHealthReasonType = 1
+	// critical event reported for the GPU
+	GPUHealthReasonType_REASON_CRITICAL_EVENT GPUHealthReasonType = 2
+	// GPU data could not be pulled from gpuagent
+	GPUHealthReasonType_REASON_AGENT_UNAVAILABLE GPUHealthReasonType = 3
+	// compute node health forced by the operator
+	GPUHealthReasonType_REASON_NODE_HEALTH_OVERRIDE GPUHealthReasonType = 4
+)
+
+// Enum value maps for GPUHealthReasonType.
+var (
+	GPUHealthReasonType_name = map[int32]string{
+		0: "REASON_NONE",
+		1: "REASON_FIELD_THRESHOLD",
+		2: "REASON_CRITICAL_EVENT",
+		3: "REASON_AGENT_UNAVAILABLE",
+		4: "REASON_NODE_HEALTH_OVERRIDE",
+	}
+	GPUHealthReasonType_value = map[string]int32{
+		"REASON_NONE":                 0,
+		"REASON_FIELD_THRESHOLD":      1,
+		"REASON_CRITICAL_EVENT":       2,
+		"REASON_AGENT_UNAVAILABLE":    3,
+		"REASON_NODE_HEALTH_OVERRIDE": 4,
+	}
+)
+
+func (x GPUHealthReasonType) Enum() *GPUHealthReasonType {
+	p := new(GPUHealthReasonType)
+	*p = x
+	return p
+}
+
+func (x GPUHealthReasonType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GPUHealthReasonType) Descriptor() protoreflect.EnumDescriptor {
+	return file_gpumetricssvc_proto_enumTypes[1].Descriptor()
+}
+
+func (GPUHealthReasonType) Type() protoreflect.EnumType {
+	return &file_gpumetricssvc_proto_enumTypes[1]
+}
+
+func (x GPUHealthReasonType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GPUHealthReasonType.Descriptor instead.
+func (GPUHealthReasonType) EnumDescriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{1}
+}
+
+type StateEventType int32
+
+const (
+	// full list of the GPU states, always the first event of the stream
+	StateEventType_SNAPSHOT StateEventType = 0
+	// GPU reported for the first time
+	StateEventType_ADDED StateEventType = 1
+	// state of the GPU changed
+	StateEventType_MODIFIED StateEventType = 2
+	// GPU no longer reported
+	StateEventType_DELETED StateEventType = 3
+)
+
+// Enum value maps for StateEventType.
+var (
+	StateEventType_name = map[int32]string{
+		0: "SNAPSHOT",
+		1: "ADDED",
+		2: "MODIFIED",
+		3: "DELETED",
+	}
+	StateEventType_value = map[string]int32{
+		"SNAPSHOT": 0,
+		"ADDED":    1,
+		"MODIFIED": 2,
+		"DELETED":  3,
+	}
+)
+
+func (x StateEventType) Enum() *StateEventType {
+	p := new(StateEventType)
+	*p = x
+	return p
+}
+
+func (x StateEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StateEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_gpumetricssvc_proto_enumTypes[2].Descriptor()
+}
+
+func (StateEventType) Type() protoreflect.EnumType {
+	return &file_gpumetricssvc_proto_enumTypes[2]
+}
+
+func (x StateEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StateEventType.Descriptor instead.
+func (StateEventType) EnumDescriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{2}
+}
+
+type GPUHealthReason struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reason type string value of GPUHealthReasonType enum
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	// rule or metric field name that triggered the reason
+	Rule string `protobuf:"bytes,2,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	// observed value of the field
+	Value float64 `protobuf:"fixed64,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	// configured threshold of the field
+	Threshold float64 `protobuf:"fixed64,4,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	// event id of the critical event
+	EventID string `protobuf:"bytes,5,opt,name=EventID,proto3" json:"EventID,omitempty"`
+	// description of the reason
+	Description string `protobuf:"bytes,6,opt,name=Description,proto3" json:"Description,omitempty"`
+	// first time the reason was observed
+	FirstSeen *timestamp.Timestamp `protobuf:"bytes,7,opt,name=FirstSeen,proto3" json:"FirstSeen,omitempty"`
+	// last time the reason was observed
+	LastSeen *timestamp.Timestamp `protobuf:"bytes,8,opt,name=LastSeen,proto3" json:"LastSeen,omitempty"`
+}
+
+func (x *GPUHealthReason) Reset() {
+	*x = GPUHealthReason{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpumetricssvc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUHealthReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUHealthReason) ProtoMessage() {}
+
+func (x *GPUHealthReason) ProtoReflect() protoreflect.Message {
+	mi := &file_gpumetricssvc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUHealthReason.ProtoReflect.Descriptor instead.
+func (*GPUHealthReason) Descriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GPUHealthReason) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GPUHealthReason) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *GPUHealthReason) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *GPUHealthReason) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GPUHealthReason) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *GPUHealthReason) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GPUHealthReason) GetFirstSeen() *timestamp.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *GPUHealthReason) GetLastSeen() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
 type GPUState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -107,12 +338,16 @@ type GPUState struct {
 	AssociatedWorkload []string `protobuf:"bytes,4,rep,name=AssociatedWorkload,proto3" json:"AssociatedWorkload,omitempty"`
 	// PCIe Bus ID refers to device ID in amd device plugin
 	Device string `protobuf:"bytes,5,opt,name=Device,proto3" json:"Device,omitempty"`
+	// time of the last health state transition
+	LastTransitionTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=LastTransitionTime,proto3" json:"LastTransitionTime,omitempty"`
+	// reasons observed for the health state
+	Reasons []*GPUHealthReason `protobuf:"bytes,7,rep,name=Reasons,proto3" json:"Reasons,omitempty"`
 }
 
 func (x *GPUState) Reset() {
 	*x = GPUState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gpumetricssvc_proto_msgTypes[0]
+		mi := &file_gpumetricssvc_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -125,7 +360,7 @@ func (x *GPUState) String() string {
 func (*GPUState) ProtoMessage() {}
 
 func (x *GPUState) ProtoReflect() protoreflect.Message {
-	mi := &file_gpumetricssvc_proto_msgTypes[0]
+	mi := &file_gpumetricssvc_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -138,7 +373,7 @@ func (x *GPUState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUState.ProtoReflect.Descriptor instead.
 func (*GPUState) Descriptor() ([]byte, []int) {
-	return file_gpumetricssvc_proto_rawDescGZIP(), []int{0}
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *GPUState) GetID() string {
@@ -176,6 +411,20 @@ func (x *GPUState) GetDevice() string {
 	return ""
 }
 
+func (x *GPUState) GetLastTransitionTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return nil
+}
+
+func (x *GPUState) GetReasons() []*GPUHealthReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
 type GPUGetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -188,7 +437,7 @@ type GPUGetRequest struct {
 func (x *GPUGetRequest) Reset() {
 	*x = GPUGetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gpumetricssvc_proto_msgTypes[1]
+		mi := &file_gpumetricssvc_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -201,7 +450,7 @@ func (x *GPUGetRequest) String() string {
 func (*GPUGetRequest) ProtoMessage() {}
 
 func (x *GPUGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gpumetricssvc_proto_msgTypes[1]
+	mi := &file_gpumetricssvc_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,7 +463,7 @@ func (x *GPUGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUGetRequest.ProtoReflect.Descriptor instead.
 func (*GPUGetRequest) Descriptor() ([]byte, []int) {
-	return file_gpumetricssvc_proto_rawDescGZIP(), []int{1}
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GPUGetRequest) GetID() []string {
@@ -238,7 +487,7 @@ type GPUUpdateRequest struct {
 func (x *GPUUpdateRequest) Reset() {
 	*x = GPUUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gpumetricssvc_proto_msgTypes[2]
+		mi := &file_gpumetricssvc_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -251,7 +500,7 @@ func (x *GPUUpdateRequest) String() string {
 func (*GPUUpdateRequest) ProtoMessage() {}
 
 func (x *GPUUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gpumetricssvc_proto_msgTypes[2]
+	mi := &file_gpumetricssvc_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +513,7 @@ func (x *GPUUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUUpdateRequest.ProtoReflect.Descriptor instead.
 func (*GPUUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_gpumetricssvc_proto_rawDescGZIP(), []int{2}
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GPUUpdateRequest) GetID() []string {
@@ -293,7 +542,7 @@ type GPUStateResponse struct {
 func (x *GPUStateResponse) Reset() {
 	*x = GPUStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gpumetricssvc_proto_msgTypes[3]
+		mi := &file_gpumetricssvc_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -306,7 +555,7 @@ func (x *GPUStateResponse) String() string {
 func (*GPUStateResponse) ProtoMessage() {}
 
 func (x *GPUStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gpumetricssvc_proto_msgTypes[3]
+	mi := &file_gpumetricssvc_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,7 +568,7 @@ func (x *GPUStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUStateResponse.ProtoReflect.Descriptor instead.
 func (*GPUStateResponse) Descriptor() ([]byte, []int) {
-	return file_gpumetricssvc_proto_rawDescGZIP(), []int{3}
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GPUStateResponse) GetGPUState() []*GPUState {
@@ -346,7 +595,7 @@ type GPUErrorRequest struct {
 func (x *GPUErrorRequest) Reset() {
 	*x = GPUErrorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gpumetricssvc_proto_msgTypes[4]
+		mi := &file_gpumetricssvc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -359,7 +608,7 @@ func (x *GPUErrorRequest) String() string {
 func (*GPUErrorRequest) ProtoMessage() {}
 
 func (x *GPUErrorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gpumetricssvc_proto_msgTypes[4]
+	mi := &file_gpumetricssvc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +621,7 @@ func (x *GPUErrorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUErrorRequest.ProtoReflect.Descriptor instead.
 func (*GPUErrorRequest) Descriptor() ([]byte, []int) {
-	return file_gpumetricssvc_proto_rawDescGZIP(), []int{4}
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GPUErrorRequest) GetID() string {
@@ -408,7 +657,7 @@ type GPUErrorResponse struct {
 func (x *GPUErrorResponse) Reset() {
 	*x = GPUErrorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gpumetricssvc_proto_msgTypes[5]
+		mi := &file_gpumetricssvc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -421,7 +670,7 @@ func (x *GPUErrorResponse) String() string {
 func (*GPUErrorResponse) ProtoMessage() {}
 
 func (x *GPUErrorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gpumetricssvc_proto_msgTypes[5]
+	mi := &file_gpumetricssvc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +683,7 @@ func (x *GPUErrorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUErrorResponse.ProtoReflect.Descriptor instead.
 func (*GPUErrorResponse) Descriptor() ([]byte, []int) {
-	return file_gpumetricssvc_proto_rawDescGZIP(), []int{5}
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GPUErrorResponse) GetID() string {
@@ -451,135 +700,707 @@ func (x *GPUErrorResponse) GetFields() []string {
 	return nil
 }
 
-var File_gpumetricssvc_proto protoreflect.FileDescriptor
+type GPUHealthClearRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_gpumetricssvc_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x73, 0x76, 0x63, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12,
-	0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55,
-	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x41, 0x73,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x44, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22,
-	0x47, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08,
-	0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x51, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0d, 0x52, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x10, 0x47,
-	0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2a, 0x34, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x0d,
-	0x0a, 0x09, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x32, 0xf2, 0x01,
-	0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x4e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x1c, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e,
-	0x47, 0x50, 0x55, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50,
-	0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x41, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1f, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63,
-	0x2e, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x1e, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e,
-	0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e,
-	0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x13, 0x5a, 0x11, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// list of id of the GPU, empty list clears all the GPUs
+	ID []string `protobuf:"bytes,1,rep,name=ID,proto3" json:"ID,omitempty"`
 }
 
-var (
-	file_gpumetricssvc_proto_rawDescOnce sync.Once
-	file_gpumetricssvc_proto_rawDescData = file_gpumetricssvc_proto_rawDesc
-)
+func (x *GPUHealthClearRequest) Reset() {
+	*x = GPUHealthClearRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpumetricssvc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_gpumetricssvc_proto_rawDescGZIP() []byte {
-	file_gpumetricssvc_proto_rawDescOnce.Do(func() {
-		file_gpumetricssvc_proto_rawDescData = protoimpl.X.CompressGZIP(file_gpumetricssvc_proto_rawDescData)
-	})
-	return file_gpumetricssvc_proto_rawDescData
+func (x *GPUHealthClearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_gpumetricssvc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_gpumetricssvc_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_gpumetricssvc_proto_goTypes = []any{
-	(GPUHealth)(0),           // 0: gpumetricssvc.GPUHealth
-	(*GPUState)(nil),         // 1: gpumetricssvc.GPUState
-	(*GPUGetRequest)(nil),    // 2: gpumetricssvc.GPUGetRequest
-	(*GPUUpdateRequest)(nil), // 3: gpumetricssvc.GPUUpdateRequest
-	(*GPUStateResponse)(nil), // 4: gpumetricssvc.GPUStateResponse
-	(*GPUErrorRequest)(nil),  // 5: gpumetricssvc.GPUErrorRequest
-	(*GPUErrorResponse)(nil), // 6: gpumetricssvc.GPUErrorResponse
-	(*empty.Empty)(nil),      // 7: google.protobuf.Empty
+func (*GPUHealthClearRequest) ProtoMessage() {}
+
+func (x *GPUHealthClearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpumetricssvc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_gpumetricssvc_proto_depIdxs = []int32{
-	1, // 0: gpumetricssvc.GPUStateResponse.GPUState:type_name -> gpumetricssvc.GPUState
-	2, // 1: gpumetricssvc.MetricsService.GetGPUState:input_type -> gpumetricssvc.GPUGetRequest
-	7, // 2: gpumetricssvc.MetricsService.List:input_type -> google.protobuf.Empty
-	5, // 3: gpumetricssvc.MetricsService.SetError:input_type -> gpumetricssvc.GPUErrorRequest
-	4, // 4: gpumetricssvc.MetricsService.GetGPUState:output_type -> gpumetricssvc.GPUStateResponse
-	4, // 5: gpumetricssvc.MetricsService.List:output_type -> gpumetricssvc.GPUStateResponse
-	6, // 6: gpumetricssvc.MetricsService.SetError:output_type -> gpumetricssvc.GPUErrorResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+// Deprecated: Use GPUHealthClearRequest.ProtoReflect.Descriptor instead.
+func (*GPUHealthClearRequest) Descriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{7}
 }
 
-func init() { file_gpumetricssvc_proto_init() }
-func file_gpumetricssvc_proto_init() {
-	if File_gpumetricssvc_proto != nil {
-		return
+func (x *GPUHealthClearRequest) GetID() []string {
+	if x != nil {
+		return x.ID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_gpumetricssvc_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*GPUState); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_gpumetricssvc_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GPUGetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+type GPUHealthClearResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list of id of the GPU with the unhealthy state cleared
+	ID []string `protobuf:"bytes,1,rep,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *GPUHealthClearResponse) Reset() {
+	*x = GPUHealthClearResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpumetricssvc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUHealthClearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUHealthClearResponse) ProtoMessage() {}
+
+func (x *GPUHealthClearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpumetricssvc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUHealthClearResponse.ProtoReflect.Descriptor instead.
+func (*GPUHealthClearResponse) Descriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GPUHealthClearResponse) GetID() []string {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+type GPUHealthHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the GPU
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// uuid of the GPU
+	UUID string `protobuf:"bytes,2,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	// pcie bus id of the GPU
+	Device string `protobuf:"bytes,3,opt,name=Device,proto3" json:"Device,omitempty"`
+	// health state after the transition
+	Health string `protobuf:"bytes,4,opt,name=Health,proto3" json:"Health,omitempty"`
+	// health state before the transition, empty for a newly seen GPU
+	PreviousHealth string `protobuf:"bytes,5,opt,name=PreviousHealth,proto3" json:"PreviousHealth,omitempty"`
+	// time of the transition
+	Time *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Time,proto3" json:"Time,omitempty"`
+	// reasons for the new health state
+	Reasons []*GPUHealthReason `protobuf:"bytes,7,rep,name=Reasons,proto3" json:"Reasons,omitempty"`
+}
+
+func (x *GPUHealthHistoryEntry) Reset() {
+	*x = GPUHealthHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpumetricssvc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUHealthHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUHealthHistoryEntry) ProtoMessage() {}
+
+func (x *GPUHealthHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_gpumetricssvc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUHealthHistoryEntry.ProtoReflect.Descriptor instead.
+func (*GPUHealthHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GPUHealthHistoryEntry) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *GPUHealthHistoryEntry) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *GPUHealthHistoryEntry) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *GPUHealthHistoryEntry) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *GPUHealthHistoryEntry) GetPreviousHealth() string {
+	if x != nil {
+		return x.PreviousHealth
+	}
+	return ""
+}
+
+func (x *GPUHealthHistoryEntry) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GPUHealthHistoryEntry) GetReasons() []*GPUHealthReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type GPUHealthHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list of id of the GPU, empty list for all the GPUs
+	ID []string `protobuf:"bytes,1,rep,name=ID,proto3" json:"ID,omitempty"`
+	// transitions at or after the start time, unset for no lower bound
+	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	// transitions at or before the end time, unset for no upper bound
+	EndTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=EndTime,proto3" json:"EndTime,omitempty"`
+	// list of health states to match, empty list for all states
+	Health []string `protobuf:"bytes,4,rep,name=Health,proto3" json:"Health,omitempty"`
+	// max number of most recent entries returned, 0 for all
+	Limit uint32 `protobuf:"varint,5,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *GPUHealthHistoryRequest) Reset() {
+	*x = GPUHealthHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpumetricssvc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUHealthHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUHealthHistoryRequest) ProtoMessage() {}
+
+func (x *GPUHealthHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpumetricssvc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUHealthHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GPUHealthHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GPUHealthHistoryRequest) GetID() []string {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+func (x *GPUHealthHistoryRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GPUHealthHistoryRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GPUHealthHistoryRequest) GetHealth() []string {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *GPUHealthHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GPUHealthHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// health transitions ordered by time
+	Entry []*GPUHealthHistoryEntry `protobuf:"bytes,1,rep,name=Entry,proto3" json:"Entry,omitempty"`
+}
+
+func (x *GPUHealthHistoryResponse) Reset() {
+	*x = GPUHealthHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpumetricssvc_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUHealthHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUHealthHistoryResponse) ProtoMessage() {}
+
+func (x *GPUHealthHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpumetricssvc_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUHealthHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GPUHealthHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GPUHealthHistoryResponse) GetEntry() []*GPUHealthHistoryEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GPUWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list of id of the GPU to watch, empty list for all the GPUs
+	ID []string `protobuf:"bytes,1,rep,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *GPUWatchRequest) Reset() {
+	*x = GPUWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpumetricssvc_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUWatchRequest) ProtoMessage() {}
+
+func (x *GPUWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpumetricssvc_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUWatchRequest.ProtoReflect.Descriptor instead.
+func (*GPUWatchRequest) Descriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GPUWatchRequest) GetID() []string {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+type GPUStateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type StateEventType `protobuf:"varint,1,opt,name=Type,proto3,enum=gpumetricssvc.StateEventType" json:"Type,omitempty"`
+	// full list on SNAPSHOT, the changed GPU states otherwise
+	GPUState []*GPUState `protobuf:"bytes,2,rep,name=GPUState,proto3" json:"GPUState,omitempty"`
+}
+
+func (x *GPUStateEvent) Reset() {
+	*x = GPUStateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpumetricssvc_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUStateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUStateEvent) ProtoMessage() {}
+
+func (x *GPUStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gpumetricssvc_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUStateEvent.ProtoReflect.Descriptor instead.
+func (*GPUStateEvent) Descriptor() ([]byte, []int) {
+	return file_gpumetricssvc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GPUStateEvent) GetType() StateEventType {
+	if x != nil {
+		return x.Type
+	}
+	return StateEventType_SNAPSHOT
+}
+
+func (x *GPUStateEvent) GetGPUState() []*GPUState {
+	if x != nil {
+		return x.GPUState
+	}
+	return nil
+}
+
+var File_gpumetricssvc_proto protoreflect.FileDescriptor
+
+var file_gpumetricssvc_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x73, 0x76, 0x63, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x9b, 0x02, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x75,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38,
+	0x0a, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x46,
+	0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x22, 0x94, 0x02, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x41, 0x73, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65,
+	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4a, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a,
+	0x07, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47,
+	0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x22, 0x47, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x70, 0x75,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x51, 0x0a,
+	0x0f, 0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x22, 0x3a, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x27, 0x0a, 0x15,
+	0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22,
+	0xfd, 0x01, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x26, 0x0a,
+	0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22,
+	0xc7, 0x01, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x56, 0x0a, 0x18, 0x47, 0x50, 0x55,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x22, 0x77, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x73, 0x76, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x70, 0x75,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x42, 0x0a,
+	0x09, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54,
+	0x48, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x59, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10,
+	0x03, 0x2a, 0x9c, 0x01, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53,
+	0x48, 0x4f, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x47, 0x45, 0x4e,
+	0x54, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12,
+	0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x48,
+	0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45, 0x10, 0x04,
+	0x2a, 0x44, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d,
+	0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x32, 0x85, 0x04, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x67, 0x70, 0x75, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x08,
+	0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0e, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x24, 0x2e,
+	0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50,
+	0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x26, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63,
+	0x2e, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x70, 0x75, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x67,
+	0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67,
+	0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x13,
+	0x5a, 0x11, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x70, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gpumetricssvc_proto_rawDescOnce sync.Once
+	file_gpumetricssvc_proto_rawDescData = file_gpumetricssvc_proto_rawDesc
+)
+
+func file_gpumetricssvc_proto_rawDescGZIP() []byte {
+	file_gpumetricssvc_proto_rawDescOnce.Do(func() {
+		file_gpumetricssvc_proto_rawDescData = protoimpl.X.CompressGZIP(file_gpumetricssvc_proto_rawDescData)
+	})
+	return file_gpumetricssvc_proto_rawDescData
+}
+
+var file_gpumetricssvc_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_gpumetricssvc_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_gpumetricssvc_proto_goTypes = []any{
+	(GPUHealth)(0),                   // 0: gpumetricssvc.GPUHealth
+	(GPUHealthReasonType)(0),         // 1: gpumetricssvc.GPUHealthReasonType
+	(StateEventType)(0),              // 2: gpumetricssvc.StateEventType
+	(*GPUHealthReason)(nil),          // 3: gpumetricssvc.GPUHealthReason
+	(*GPUState)(nil),                 // 4: gpumetricssvc.GPUState
+	(*GPUGetRequest)(nil),            // 5: gpumetricssvc.GPUGetRequest
+	(*GPUUpdateRequest)(nil),         // 6: gpumetricssvc.GPUUpdateRequest
+	(*GPUStateResponse)(nil),         // 7: gpumetricssvc.GPUStateResponse
+	(*GPUErrorRequest)(nil),          // 8: gpumetricssvc.GPUErrorRequest
+	(*GPUErrorResponse)(nil),         // 9: gpumetricssvc.GPUErrorResponse
+	(*GPUHealthClearRequest)(nil),    // 10: gpumetricssvc.GPUHealthClearRequest
+	(*GPUHealthClearResponse)(nil),   // 11: gpumetricssvc.GPUHealthClearResponse
+	(*GPUHealthHistoryEntry)(nil),    // 12: gpumetricssvc.GPUHealthHistoryEntry
+	(*GPUHealthHistoryRequest)(nil),  // 13: gpumetricssvc.GPUHealthHistoryRequest
+	(*GPUHealthHistoryResponse)(nil), // 14: gpumetricssvc.GPUHealthHistoryResponse
+	(*GPUWatchRequest)(nil),          // 15: gpumetricssvc.GPUWatchRequest
+	(*GPUStateEvent)(nil),            // 16: gpumetricssvc.GPUStateEvent
+	(*timestamp.Timestamp)(nil),      // 17: google.protobuf.Timestamp
+	(*empty.Empty)(nil),              // 18: google.protobuf.Empty
+}
+var file_gpumetricssvc_proto_depIdxs = []int32{
+	17, // 0: gpumetricssvc.GPUHealthReason.FirstSeen:type_name -> google.protobuf.Timestamp
+	17, // 1: gpumetricssvc.GPUHealthReason.LastSeen:type_name -> google.protobuf.Timestamp
+	17, // 2: gpumetricssvc.GPUState.LastTransitionTime:type_name -> google.protobuf.Timestamp
+	3,  // 3: gpumetricssvc.GPUState.Reasons:type_name -> gpumetricssvc.GPUHealthReason
+	4,  // 4: gpumetricssvc.GPUStateResponse.GPUState:type_name -> gpumetricssvc.GPUState
+	17, // 5: gpumetricssvc.GPUHealthHistoryEntry.Time:type_name -> google.protobuf.Timestamp
+	3,  // 6: gpumetricssvc.GPUHealthHistoryEntry.Reasons:type_name -> gpumetricssvc.GPUHealthReason
+	17, // 7: gpumetricssvc.GPUHealthHistoryRequest.StartTime:type_name -> google.protobuf.Timestamp
+	17, // 8: gpumetricssvc.GPUHealthHistoryRequest.EndTime:type_name -> google.protobuf.Timestamp
+	12, // 9: gpumetricssvc.GPUHealthHistoryResponse.Entry:type_name -> gpumetricssvc.GPUHealthHistoryEntry
+	2,  // 10: gpumetricssvc.GPUStateEvent.Type:type_name -> gpumetricssvc.StateEventType
+	4,  // 11: gpumetricssvc.GPUStateEvent.GPUState:type_name -> gpumetricssvc.GPUState
+	5,  // 12: gpumetricssvc.MetricsService.GetGPUState:input_type -> gpumetricssvc.GPUGetRequest
+	18, // 13: gpumetricssvc.MetricsService.List:input_type -> google.protobuf.Empty
+	8,  // 14: gpumetricssvc.MetricsService.SetError:input_type -> gpumetricssvc.GPUErrorRequest
+	10, // 15: gpumetricssvc.MetricsService.ClearGPUHealth:input_type -> gpumetricssvc.GPUHealthClearRequest
+	13, // 16: gpumetricssvc.MetricsService.GetHealthHistory:input_type -> gpumetricssvc.GPUHealthHistoryRequest
+	15, // 17: gpumetricssvc.MetricsService.Watch:input_type -> gpumetricssvc.GPUWatchRequest
+	7,  // 18: gpumetricssvc.MetricsService.GetGPUState:output_type -> gpumetricssvc.GPUStateResponse
+	7,  // 19: gpumetricssvc.MetricsService.List:output_type -> gpumetricssvc.GPUStateResponse
+	9,  // 20: gpumetricssvc.MetricsService.SetError:output_type -> gpumetricssvc.GPUErrorResponse
+	11, // 21: gpumetricssvc.MetricsService.ClearGPUHealth:output_type -> gpumetricssvc.GPUHealthClearResponse
+	14, // 22: gpumetricssvc.MetricsService.GetHealthHistory:output_type -> gpumetricssvc.GPUHealthHistoryResponse
+	16, // 23: gpumetricssvc.MetricsService.Watch:output_type -> gpumetricssvc.GPUStateEvent
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_gpumetricssvc_proto_init() }
+func file_gpumetricssvc_proto_init() {
+	if File_gpumetricssvc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gpumetricssvc_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUHealthReason); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpumetricssvc_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
 		file_gpumetricssvc_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GPUUpdateRequest); i {
+			switch v := v.(*GPUGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -591,7 +1412,7 @@ func file_gpumetricssvc_proto_init() {
 			}
 		}
 		file_gpumetricssvc_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GPUStateResponse); i {
+			switch v := v.(*GPUUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -603,7 +1424,7 @@ func file_gpumetricssvc_proto_init() {
 			}
 		}
 		file_gpumetricssvc_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GPUErrorRequest); i {
+			switch v := v.(*GPUStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -615,6 +1436,18 @@ func file_gpumetricssvc_proto_init() {
 			}
 		}
 		file_gpumetricssvc_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUErrorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpumetricssvc_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*GPUErrorResponse); i {
 			case 0:
 				return &v.state
@@ -626,14 +1459,98 @@ func file_gpumetricssvc_proto_init() {
 				return nil
 			}
 		}
+		file_gpumetricssvc_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUHealthClearRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpumetricssvc_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUHealthClearResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpumetricssvc_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUHealthHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpumetricssvc_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUHealthHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpumetricssvc_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUHealthHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpumetricssvc_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpumetricssvc_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUStateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gpumetricssvc_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
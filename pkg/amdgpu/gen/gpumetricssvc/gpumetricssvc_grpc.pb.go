@@ -42,9 +42,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MetricsService_GetGPUState_FullMethodName = "/gpumetricssvc.MetricsService/GetGPUState"
-	MetricsService_List_FullMethodName        = "/gpumetricssvc.MetricsService/List"
-	MetricsService_SetError_FullMethodName    = "/gpumetricssvc.MetricsService/SetError"
+	MetricsService_GetGPUState_FullMethodName      = "/gpumetricssvc.MetricsService/GetGPUState"
+	MetricsService_List_FullMethodName             = "/gpumetricssvc.MetricsService/List"
+	MetricsService_SetError_FullMethodName         = "/gpumetricssvc.MetricsService/SetError"
+	MetricsService_ClearGPUHealth_FullMethodName   = "/gpumetricssvc.MetricsService/ClearGPUHealth"
+	MetricsService_GetHealthHistory_FullMethodName = "/gpumetricssvc.MetricsService/GetHealthHistory"
+	MetricsService_Watch_FullMethodName            = "/gpumetricssvc.MetricsService/Watch"
 )
 
 // MetricsServiceClient is the client API for MetricsService service.
@@ -55,6 +58,12 @@ type MetricsServiceClient interface {
 	GetGPUState(ctx context.Context, in *GPUGetRequest, opts ...grpc.CallOption) (*GPUStateResponse, error)
 	List(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GPUStateResponse, error)
 	SetError(ctx context.Context, in *GPUErrorRequest, opts ...grpc.CallOption) (*GPUErrorResponse, error)
+	// clear the sticky unhealthy state of the GPU
+	ClearGPUHealth(ctx context.Context, in *GPUHealthClearRequest, opts ...grpc.CallOption) (*GPUHealthClearResponse, error)
+	// GPU health transition history
+	GetHealthHistory(ctx context.Context, in *GPUHealthHistoryRequest, opts ...grpc.CallOption) (*GPUHealthHistoryResponse, error)
+	// stream of GPU state changes, starting with a snapshot of all states
+	Watch(ctx context.Context, in *GPUWatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GPUStateEvent], error)
 }
 
 type metricsServiceClient struct {
@@ -95,6 +104,45 @@ func (c *metricsServiceClient) SetError(ctx context.Context, in *GPUErrorRequest
 	return out, nil
 }
 
+func (c *metricsServiceClient) ClearGPUHealth(ctx context.Context, in *GPUHealthClearRequest, opts ...grpc.CallOption) (*GPUHealthClearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GPUHealthClearResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ClearGPUHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) GetHealthHistory(ctx context.Context, in *GPUHealthHistoryRequest, opts ...grpc.CallOption) (*GPUHealthHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GPUHealthHistoryResponse)
+	err := c.cc.Invoke(ctx, MetricsService_GetHealthHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) Watch(ctx context.Context, in *GPUWatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GPUStateEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MetricsService_ServiceDesc.Streams[0], MetricsService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GPUWatchRequest, GPUStateEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetricsService_WatchClient = grpc.ServerStreamingClient[GPUStateEvent]
+
 // MetricsServiceServer is the server API for MetricsService service.
 // All implementations must embed UnimplementedMetricsServiceServer
 // for forward compatibility.
@@ -103,6 +151,12 @@ type MetricsServiceServer interface {
 	GetGPUState(context.Context, *GPUGetRequest) (*GPUStateResponse, error)
 	List(context.Context, *empty.Empty) (*GPUStateResponse, error)
 	SetError(context.Context, *GPUErrorRequest) (*GPUErrorResponse, error)
+	// clear the sticky unhealthy state of the GPU
+	ClearGPUHealth(context.Context, *GPUHealthClearRequest) (*GPUHealthClearResponse, error)
+	// GPU health transition history
+	GetHealthHistory(context.Context, *GPUHealthHistoryRequest) (*GPUHealthHistoryResponse, error)
+	// stream of GPU state changes, starting with a snapshot of all states
+	Watch(*GPUWatchRequest, grpc.ServerStreamingServer[GPUStateEvent]) error
 	mustEmbedUnimplementedMetricsServiceServer()
 }
 
@@ -122,6 +176,15 @@ func (UnimplementedMetricsServiceServer) List(context.Context, *empty.Empty) (*G
 func (UnimplementedMetricsServiceServer) SetError(context.Context, *GPUErrorRequest) (*GPUErrorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetError not implemented")
 }
+func (UnimplementedMetricsServiceServer) ClearGPUHealth(context.Context, *GPUHealthClearRequest) (*GPUHealthClearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearGPUHealth not implemented")
+}
+func (UnimplementedMetricsServiceServer) GetHealthHistory(context.Context, *GPUHealthHistoryRequest) (*GPUHealthHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthHistory not implemented")
+}
+func (UnimplementedMetricsServiceServer) Watch(*GPUWatchRequest, grpc.ServerStreamingServer[GPUStateEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedMetricsServiceServer) mustEmbedUnimplementedMetricsServiceServer() {}
 func (UnimplementedMetricsServiceServer) testEmbeddedByValue()                        {}
 
@@ -197,6 +260,53 @@ func _MetricsService_SetError_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetricsService_ClearGPUHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GPUHealthClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ClearGPUHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ClearGPUHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ClearGPUHealth(ctx, req.(*GPUHealthClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_GetHealthHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GPUHealthHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).GetHealthHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_GetHealthHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).GetHealthHistory(ctx, req.(*GPUHealthHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GPUWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetricsServiceServer).Watch(m, &grpc.GenericServerStream[GPUWatchRequest, GPUStateEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetricsService_WatchServer = grpc.ServerStreamingServer[GPUStateEvent]
+
 // MetricsService_ServiceDesc is the grpc.ServiceDesc for MetricsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -216,7 +326,21 @@ var MetricsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetError",
 			Handler:    _MetricsService_SetError_Handler,
 		},
+		{
+			MethodName: "ClearGPUHealth",
+			Handler:    _MetricsService_ClearGPUHealth_Handler,
+		},
+		{
+			MethodName: "GetHealthHistory",
+			Handler:    _MetricsService_GetHealthHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _MetricsService_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "gpumetricssvc.proto",
 }
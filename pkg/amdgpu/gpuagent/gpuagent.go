@@ -19,9 +19,11 @@ package gpuagent
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
@@ -29,6 +31,9 @@ import (
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/fsysdevice"
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/healthhistory"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/jobaccounting"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/kfdprocess"
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/rocprofiler"
 	k8sclient "github.com/ROCm/device-metrics-exporter/pkg/client"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
@@ -39,11 +44,12 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// default polling of gpuagent, overridden by the polling config
 const (
 	// cachgpuid are updated after this many pull request
-	refreshInterval = 30 * time.Second
-	queryTimeout    = 15 * time.Second
-	cacheTimer      = 15 * time.Second
+	defaultRefreshInterval = 30 * time.Second
+	defaultQueryTimeout    = 15 * time.Second
+	defaultCacheTTL        = 15 * time.Second
 )
 
 type GPUAgentClient struct {
@@ -55,21 +61,46 @@ type GPUAgentClient struct {
 	rocpclient             *rocprofiler.ROCProfilerClient
 	m                      *metrics // client specific metrics
 	k8sApiClient           *k8sclient.K8sClient
+	remediator             nodeRemediator
+	eventRecorder          healthEventRecorder
+	pendingHealthEvents    []healthTransition
+	remediation            remediationState
 	k8sScheduler           scheduler.SchedulerClient
-	slurmScheduler         scheduler.SchedulerClient
+	batchScheduler         scheduler.SchedulerClient
+	registrationScheduler  scheduler.SchedulerClient
+	containerScheduler     scheduler.SchedulerClient
 	isKubernetes           bool // pod resource client enabled or not
 	enabledK8sApi          bool
 	enableZmq              bool
+	batchSchedulerName     string
+	containerRuntime       string
+	slurmDiscovery         string
+	sysRoot                string
+	procRoot               string
+	sysfsFallback          bool
+	sysfsFallbackActive    bool
 	enableProfileMetrics   bool
 	enableSriov            bool
 	staticHostLabels       map[string]string
 	ctx                    context.Context
 	cancel                 context.CancelFunc
 	healthState            map[string]*metricssvc.GPUState
+	healthTracker          map[string]*gpuHealthTracker
+	enableHealthHistory    bool
+	nodeLabelsRemoved      bool // health labels removed since disabled
+	healthHistory          atomic.Pointer[healthhistory.Store]
+	pendingHistory         []*metricssvc.GPUHealthHistoryEntry
+	jobAccounting          atomic.Pointer[jobaccounting.Accountant]
+	pm                     atomic.Pointer[processMetrics]
+	kfdReader              *kfdprocess.Reader
+	sm                     atomic.Pointer[samplerMetrics]
+	jm                     atomic.Pointer[jobMetrics]   // job summary metrics
 	mockEccField           map[string]map[string]uint32 // gpuid->fields->count
 	computeNodeHealthState bool
 	fsysDeviceHandler      *fsysdevice.FsysDevice
 	gCache                 *gpuCache
+	polling                atomic.Pointer[pollingSettings]
+	refreshReset           chan time.Duration
 	nodeHealthLabellerCfg  *utils.NodeHealthLabellerConfig
 	fl                     *fieldLogger
 }
@@ -91,16 +122,71 @@ func WithZmq(enableZmq bool) GPUAgentClientOptions {
 	}
 }
 
-func WithK8sClient(k8sclient *k8sclient.K8sClient) GPUAgentClientOptions {
+func WithK8sClient(k8sApiClient *k8sclient.K8sClient) GPUAgentClientOptions {
 	return func(ga *GPUAgentClient) {
-		if k8sclient == nil {
+		if k8sApiClient == nil {
 			logger.Log.Printf("K8sApiClient disabled")
 			ga.k8sApiClient = nil
 			return
 		}
 		ga.enabledK8sApi = true
 		logger.Log.Printf("K8sApiClient option set")
-		ga.k8sApiClient = k8sclient
+		ga.k8sApiClient = k8sApiClient
+		ga.remediator = k8sApiClient
+		ga.eventRecorder = k8sclient.NewEventRecorder(k8sApiClient, utils.GetNodeName())
+	}
+}
+
+// WithHealthHistory records the GPU health transitions in the history store
+// configured in the HealthHistory config
+func WithHealthHistory(enable bool) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		ga.enableHealthHistory = enable
+	}
+}
+
+// WithSlurmDiscovery sets how the slurm jobs are discovered, the prolog
+// job files are used by default
+func WithSlurmDiscovery(mode string) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		ga.slurmDiscovery = mode
+	}
+}
+
+// WithBatchScheduler sets the batch scheduler of the jobs, slurm by default
+func WithBatchScheduler(name string) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		ga.batchSchedulerName = name
+	}
+}
+
+// WithRegistrationClient merges the workloads registered by the external
+// schedulers, the client is shared with the nic agent and not closed
+func WithRegistrationClient(registration scheduler.SchedulerClient) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		ga.registrationScheduler = registration
+	}
+}
+
+// WithContainerRuntime attributes the GPUs to the containers of the docker
+// or containerd runtime, disabled when empty
+func WithContainerRuntime(runtime string) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		ga.containerRuntime = runtime
+	}
+}
+
+// WithSysRoot sets the sysfs root of the device attributes, defaults to /sys
+func WithSysRoot(root string) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		ga.sysRoot = root
+	}
+}
+
+// WithProcRoot sets the procfs root of the processes, defaults to /proc
+func WithProcRoot(root string) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		ga.procRoot = root
 	}
 }
 
@@ -138,6 +224,8 @@ func NewAgent(mh *metricsutil.MetricsHandler, opts ...GPUAgentClientOptions) *GP
 	ga := &GPUAgentClient{
 		mh:                     mh,
 		computeNodeHealthState: true,
+		sysRoot:                globals.SysRoot,
+		procRoot:               globals.ProcRoot,
 		nodeHealthLabellerCfg: &utils.NodeHealthLabellerConfig{
 			LabelPrefix: globals.GPUHealthLabelPrefix,
 		},
@@ -147,6 +235,7 @@ func NewAgent(mh *metricsutil.MetricsHandler, opts ...GPUAgentClientOptions) *GP
 	}
 
 	ga.healthState = make(map[string]*metricssvc.GPUState)
+	ga.healthTracker = make(map[string]*gpuHealthTracker)
 	ga.mockEccField = make(map[string]map[string]uint32)
 	ga.fl = NewFieldLogger()
 	ga.rocpclient = rocprofiler.NewRocProfilerClient("rocpclient")
@@ -156,8 +245,10 @@ func NewAgent(mh *metricsutil.MetricsHandler, opts ...GPUAgentClientOptions) *GP
 	} else {
 		ga.enableProfileMetrics = true
 	}
-	ga.fsysDeviceHandler = fsysdevice.GetFsysDeviceHandler()
+	ga.fsysDeviceHandler = fsysdevice.NewFsysDevice(ga.sysRoot)
 	ga.gCache = &gpuCache{}
+	ga.polling.Store(defaultPollingSettings())
+	ga.refreshReset = make(chan time.Duration, 1)
 	mh.RegisterMetricsClient(ga)
 	return ga
 }
@@ -176,12 +267,33 @@ func (ga *GPUAgentClient) Init() error {
 	ga.gpuclient = gpuclient
 	ga.evtclient = evtclient
 
-	slurmScl, err := scheduler.NewSlurmClient(ga.ctx, ga.enableZmq)
+	var batchScl scheduler.SchedulerClient
+	switch {
+	case ga.batchSchedulerName == scheduler.BatchSchedulerPBS:
+		batchScl, err = scheduler.NewPBSClient(ga.ctx)
+	case ga.slurmDiscovery == scheduler.SlurmDiscoveryCgroup:
+		batchScl, err = scheduler.NewSlurmCgroupClient(ga.ctx,
+			scheduler.WithCgroupRoot(filepath.Join(ga.sysRoot, "fs", "cgroup")),
+			scheduler.WithProcRoot(ga.procRoot))
+	default:
+		batchScl, err = scheduler.NewSlurmClient(ga.ctx, ga.enableZmq)
+	}
 	if err != nil {
 		logger.Log.Printf("gpu client init failure err :%v", err)
 		return err
 	}
-	ga.slurmScheduler = slurmScl
+	ga.batchScheduler = batchScl
+
+	if ga.containerRuntime != "" {
+		// the containers are not attributed when the runtime is not reachable
+		containerScl, err := scheduler.NewContainerClient(ga.ctx, ga.containerRuntime,
+			scheduler.WithContainerSysRoot(ga.sysRoot))
+		if err != nil {
+			logger.Log.Printf("container client init failure err :%v", err)
+		} else {
+			ga.containerScheduler = containerScl
+		}
+	}
 
 	if err := ga.populateStaticHostLabels(); err != nil {
 		return fmt.Errorf("error in populating static host labels, %v", err)
@@ -210,12 +322,14 @@ func (ga *GPUAgentClient) isActive() bool {
 func (ga *GPUAgentClient) StartMonitor() {
 	logger.Log.Printf("GPUAgent monitor started")
 	ga.initializeContext()
-	pollTimer := time.NewTicker(refreshInterval)
+	pollTimer := time.NewTicker(ga.polling.Load().refreshInterval)
 	defer pollTimer.Stop()
 
 	// nolint
 	for {
 		select {
+		case interval := <-ga.refreshReset:
+			pollTimer.Reset(interval)
 		case <-pollTimer.C:
 			if !ga.isActive() {
 				if err := ga.reconnect(); err != nil {
@@ -229,6 +343,12 @@ func (ga *GPUAgentClient) StartMonitor() {
 			if err := ga.sendNodeLabelUpdate(); err != nil {
 				logger.Log.Printf("gpuagent failed to send node label update %v", err)
 			}
+			if err := ga.applyRemediationPolicy(); err != nil {
+				logger.Log.Printf("gpuagent remediation policy failed %v", err)
+			}
+			ga.writeHealthHistory()
+			ga.sendHealthEvents()
+			ga.updateJobAccounting()
 		}
 	}
 }
@@ -244,15 +364,40 @@ func (ga *GPUAgentClient) sendNodeLabelUpdate() error {
 		return fmt.Errorf("node name not found")
 	}
 	gpuHealthStates := make(map[string]string)
+	gpuHealthReasons := make(map[string]string)
+	ignoreDegraded := ga.getDegradedHealthConfig().GetNodeLabelIgnore()
 	ga.Lock()
 	for gpuid, hs := range ga.healthState {
 		if hs.Health == strings.ToLower(metricssvc.GPUHealth_HEALTHY.String()) {
 			continue // skip healthy state
 		}
+		if ignoreDegraded && hs.Health == strings.ToLower(metricssvc.GPUHealth_DEGRADED.String()) {
+			continue
+		}
 		gpuHealthStates[gpuid] = hs.Health
+		if summary := healthReasonSummary(hs.Reasons); summary != "" {
+			gpuHealthReasons[gpuid] = summary
+		}
 	}
 	ga.Unlock()
-	_ = ga.k8sApiClient.UpdateHealthLabel(ga.nodeHealthLabellerCfg, nodeName, gpuHealthStates)
+	reporting := ga.getNodeHealthReportingConfig()
+	if !reporting.GetDisableNodeLabels() {
+		ga.nodeLabelsRemoved = false
+		_ = ga.k8sApiClient.UpdateHealthLabelWithReasons(ga.nodeHealthLabellerCfg, nodeName, gpuHealthStates, gpuHealthReasons)
+	} else if !ga.nodeLabelsRemoved {
+		// remove the labels and reasons set before the labels were disabled
+		err := ga.k8sApiClient.UpdateHealthLabelWithReasons(ga.nodeHealthLabellerCfg, nodeName,
+			map[string]string{}, map[string]string{})
+		ga.nodeLabelsRemoved = err == nil
+	}
+	if reporting.GetEnableNodeCondition() {
+		condType := reporting.GetConditionType()
+		if condType == "" {
+			condType = k8sclient.GPUHealthConditionType
+		}
+		cond := k8sclient.NewHealthCondition(condType, "GPU", gpuHealthStates, gpuHealthReasons)
+		_ = ga.k8sApiClient.UpdateHealthCondition(nodeName, cond)
+	}
 	return nil
 }
 
@@ -299,13 +444,20 @@ func (ga *GPUAgentClient) getMetricsAll() error {
 	// send the req to gpuclient
 	resp, partitionMap, err := ga.getGPUs()
 	if err != nil {
-		return err
+		return ga.updateSysfsFallbackMetrics(err)
 	}
 	if resp != nil && resp.ApiStatus != 0 {
 		logger.Log.Printf("resp status :%v", resp.ApiStatus)
-		return fmt.Errorf("%v", resp.ApiStatus)
+		return ga.updateSysfsFallbackMetrics(fmt.Errorf("%v", resp.ApiStatus))
+	}
+	ga.setSysfsFallbackActive(false)
+	wls, wlsErr := ga.ListWorkloads()
+	if wlsErr == nil {
+		ga.accountJobs(wls, resp.Response)
 	}
-	wls, _ := ga.ListWorkloads()
+	ga.updateJobMetrics()
+	ga.updateProcessMetrics(wls, resp.Response)
+	ga.updateSamplerMetrics(resp.Response)
 	pmetrics, err := ga.getProfilerMetrics()
 	if err != nil {
 		//continue as this may not be available at this time
@@ -315,8 +467,10 @@ func (ga *GPUAgentClient) getMetricsAll() error {
 	if err != nil {
 		logger.Log.Printf("FetchPodLabelsForNode failed with err : %v", err)
 	}
+	ga.fetchK8sMetadata()
 	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
 	ga.m.gpuNodesTotal.With(nonGpuLabels).Set(float64(len(resp.Response)))
+	ga.updateDataAgeMetrics(nonGpuLabels)
 	for _, gpu := range resp.Response {
 		var gpuProfMetrics map[string]float64
 		// if available use the data
@@ -331,16 +485,17 @@ func (ga *GPUAgentClient) getMetricsAll() error {
 	return nil
 }
 
-// cacheRead reads from cache if the last read was successful and within the cacheTimer
+// cacheRead reads from cache if the last read was successful and within the cache TTL
 // otherwise it reads from hardware
 // this ensures that we don't read from hardware too frequently as more clients are added
 // and the number of reads increases
 func (ga *GPUAgentClient) cacheRead() (*amdgpu.GPUGetResponse, error) {
 	now := time.Now()
+	polling := ga.polling.Load()
 
 	// First try fast path with RLock
 	ga.gCache.RLock()
-	if ga.gCache.lastResponse != nil && now.Sub(ga.gCache.lastTimestamp) < cacheTimer {
+	if ga.gCache.lastResponse != nil && now.Sub(ga.gCache.lastTimestamp) < polling.cacheTTL {
 		res := ga.gCache.lastResponse
 		ga.gCache.RUnlock()
 		logger.Log.Printf("returning metrics from cache")
@@ -353,13 +508,13 @@ func (ga *GPUAgentClient) cacheRead() (*amdgpu.GPUGetResponse, error) {
 	defer ga.gCache.Unlock()
 
 	// Check again after acquiring Lock to handle the case where another goroutine has already updated the cache
-	if ga.gCache.lastResponse != nil && time.Since(ga.gCache.lastTimestamp) < cacheTimer {
+	if ga.gCache.lastResponse != nil && time.Since(ga.gCache.lastTimestamp) < polling.cacheTTL {
 		logger.Log.Printf("returning metrics from cache (after double-check)")
 		return ga.gCache.lastResponse, nil
 	}
 
 	// Perform query and update cache
-	ctx, cancel := context.WithTimeout(ga.ctx, queryTimeout)
+	ctx, cancel := context.WithTimeout(ga.ctx, polling.queryTimeout)
 	defer cancel()
 
 	res, err := ga.gpuclient.GPUGet(ctx, &amdgpu.GPUGetRequest{})
@@ -425,34 +580,41 @@ func (ga *GPUAgentClient) getEvents(severity amdgpu.EventSeverity) (*amdgpu.Even
 
 // ListWorkloads - get all workloads from every client , lock must be taken by
 // the caller
-func (ga *GPUAgentClient) ListWorkloads() (wls map[string]scheduler.Workload, err error) {
-	wls = make(map[string]scheduler.Workload)
+func (ga *GPUAgentClient) ListWorkloads() (wls map[string][]scheduler.Workload, err error) {
+	wls = make(map[string][]scheduler.Workload)
 	if ga.isKubernetes && ga.k8sScheduler != nil {
-		var k8sWls map[string]scheduler.Workload
+		var k8sWls map[string][]scheduler.Workload
 		k8sWls, err = ga.k8sScheduler.ListWorkloads()
 		if err != nil {
 			return
 		}
-		for k, wl := range k8sWls {
-			wls[k] = wl
+		for k, list := range k8sWls {
+			for _, wl := range list {
+				scheduler.AddWorkload(wls, k, wl)
+			}
 		}
 	}
-	if ga.slurmScheduler == nil {
-		return wls, nil
-	}
-	var swls map[string]scheduler.Workload
-	swls, err = ga.slurmScheduler.ListWorkloads()
-	if err != nil {
-		return
-	}
-	// return combined list
-	for k, wl := range swls {
-		wls[k] = wl
+	for _, scl := range []scheduler.SchedulerClient{ga.batchScheduler, ga.containerScheduler, ga.registrationScheduler} {
+		if scl == nil {
+			continue
+		}
+		var swls map[string][]scheduler.Workload
+		swls, err = scl.ListWorkloads()
+		if err != nil {
+			return
+		}
+		// return combined list
+		for k, list := range swls {
+			for _, wl := range list {
+				scheduler.AddWorkload(wls, k, wl)
+			}
+		}
 	}
 	return
 }
 
 func (ga *GPUAgentClient) Close() {
+	ga.writeHealthHistory()
 	ga.Lock()
 	defer ga.Unlock()
 	if ga.conn != nil {
@@ -467,10 +629,21 @@ func (ga *GPUAgentClient) Close() {
 		ga.k8sScheduler = nil
 	}
 
-	if ga.slurmScheduler != nil {
+	if ga.batchScheduler != nil {
 		logger.Log.Printf("gpuagent slurm scheduler closing")
-		ga.slurmScheduler.Close()
-		ga.slurmScheduler = nil
+		ga.batchScheduler.Close()
+		ga.batchScheduler = nil
+	}
+
+	if ga.containerScheduler != nil {
+		logger.Log.Printf("gpuagent container scheduler closing")
+		ga.containerScheduler.Close()
+		ga.containerScheduler = nil
+	}
+
+	if sm := ga.sm.Swap(nil); sm != nil {
+		logger.Log.Printf("gpuagent sampler closing")
+		sm.sampler.Stop()
 	}
 	// cancel all context
 	ga.cancel()
@@ -483,3 +656,37 @@ func (ga *GPUAgentClient) FetchPodLabelsForNode() (map[string]map[string]string,
 	}
 	return listMap, nil
 }
+
+// FetchPodAnnotationsForNode returns the annotations of the pods on the
+// node if any pod annotation is mapped to metrics
+func (ga *GPUAgentClient) FetchPodAnnotationsForNode() (map[string]map[string]string, error) {
+	listMap := make(map[string]map[string]string)
+	if ga.enabledK8sApi && len(extraPodAnnotationsMap) > 0 {
+		return ga.k8sApiClient.GetAllPodAnnotations()
+	}
+	return listMap, nil
+}
+
+// FetchNodeLabels returns the labels of the node if any node label is
+// mapped to metrics
+func (ga *GPUAgentClient) FetchNodeLabels() (map[string]string, error) {
+	if ga.enabledK8sApi && len(nodeLabelsMap) > 0 {
+		return ga.k8sApiClient.GetNodeLabels()
+	}
+	return map[string]string{}, nil
+}
+
+// fetchK8sMetadata refreshes the pod annotations and node labels exported
+// with the metrics, the last known values are kept on failures
+func (ga *GPUAgentClient) fetchK8sMetadata() {
+	if annotations, err := ga.FetchPodAnnotationsForNode(); err != nil {
+		logger.Log.Printf("FetchPodAnnotationsForNode failed with err : %v", err)
+	} else {
+		k8PodAnnotationsMap = annotations
+	}
+	if nodeLabels, err := ga.FetchNodeLabels(); err != nil {
+		logger.Log.Printf("FetchNodeLabels failed with err : %v", err)
+	} else {
+		k8NodeLabels = nodeLabels
+	}
+}
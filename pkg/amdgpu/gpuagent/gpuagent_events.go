@@ -0,0 +1,139 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+)
+
+// healthEventRecorder posts the health transition events
+type healthEventRecorder interface {
+	NodeEvent(evtType, reason, message string) error
+	PodEvent(namespace, name, evtType, reason, message string) error
+}
+
+// healthTransition is a GPU health change pending to be reported as event
+type healthTransition struct {
+	id       string
+	device   string
+	health   string
+	previous string
+	reasons  string
+}
+
+// queueHealthEvent queues the transition for the event recorder, lock must
+// be taken by the caller
+func (ga *GPUAgentClient) queueHealthEvent(gpustate *metricssvc.GPUState, previous string) {
+	if ga.eventRecorder == nil {
+		return
+	}
+	healthy := strings.ToLower(metricssvc.GPUHealth_HEALTHY.String())
+	// a GPU discovered healthy is not a transition
+	if previous == "" && gpustate.Health == healthy {
+		return
+	}
+	ga.pendingHealthEvents = append(ga.pendingHealthEvents, healthTransition{
+		id:       gpustate.ID,
+		device:   gpustate.Device,
+		health:   gpustate.Health,
+		previous: previous,
+		reasons:  healthReasonDescriptions(gpustate.Reasons),
+	})
+}
+
+// healthReasonDescriptions returns the reasons in a human readable form
+func healthReasonDescriptions(reasons []*metricssvc.GPUHealthReason) string {
+	desc := []string{}
+	for _, r := range reasons {
+		switch {
+		case r.Type == metricssvc.GPUHealthReasonType_REASON_FIELD_THRESHOLD.String():
+			desc = append(desc, fmt.Sprintf("%v value %v above threshold %v", r.Rule, r.Value, r.Threshold))
+		case r.Description != "":
+			desc = append(desc, r.Description)
+		default:
+			desc = append(desc, r.Type)
+		}
+	}
+	return strings.Join(desc, "; ")
+}
+
+// sendHealthEvents posts the queued health transitions as events on the
+// node, unhealthy and degraded transitions are also posted on the pods
+// using the GPU
+func (ga *GPUAgentClient) sendHealthEvents() {
+	ga.Lock()
+	pending := ga.pendingHealthEvents
+	ga.pendingHealthEvents = nil
+	ga.Unlock()
+	if len(pending) == 0 || ga.getNodeHealthReportingConfig().GetDisableEvents() {
+		return
+	}
+
+	wls, err := ga.ListWorkloads()
+	if err != nil {
+		logger.Log.Printf("Error listing workloads: %v", err)
+	}
+
+	healthy := strings.ToLower(metricssvc.GPUHealth_HEALTHY.String())
+	degraded := strings.ToLower(metricssvc.GPUHealth_DEGRADED.String())
+	for _, tr := range pending {
+		evtType, reason := v1.EventTypeWarning, "AMDGPUUnhealthy"
+		switch tr.health {
+		case healthy:
+			evtType, reason = v1.EventTypeNormal, "AMDGPUHealthy"
+		case degraded:
+			reason = "AMDGPUDegraded"
+		}
+		msg := fmt.Sprintf("GPU %v (%v) is %v", tr.id, tr.device, tr.health)
+		if tr.previous != "" {
+			msg = fmt.Sprintf("%v, was %v", msg, tr.previous)
+		}
+		if tr.reasons != "" && tr.health != healthy {
+			msg = fmt.Sprintf("%v: %v", msg, tr.reasons)
+		}
+
+		if err := ga.eventRecorder.NodeEvent(evtType, reason, msg); err != nil {
+			logger.Log.Printf("gpuid[%v] node event failed, err: %v", tr.id, err)
+		}
+		if tr.health == healthy {
+			continue
+		}
+		// post once per pod sharing the GPU
+		pods := map[string]bool{}
+		for _, wl := range wls[tr.device] {
+			if wl.Type != scheduler.Kubernetes {
+				continue
+			}
+			podInfo, ok := wl.Info.(scheduler.PodResourceInfo)
+			if !ok || pods[podInfo.Namespace+"/"+podInfo.Pod] {
+				continue
+			}
+			pods[podInfo.Namespace+"/"+podInfo.Pod] = true
+			if err := ga.eventRecorder.PodEvent(podInfo.Namespace, podInfo.Pod, evtType, reason, msg); err != nil {
+				logger.Log.Printf("gpuid[%v] pod %v/%v event failed, err: %v",
+					tr.id, podInfo.Namespace, podInfo.Pod, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+)
+
+type recordedEvent struct {
+	pod     string
+	evtType string
+	reason  string
+	message string
+}
+
+type fakeEventRecorder struct {
+	events []recordedEvent
+}
+
+func (f *fakeEventRecorder) NodeEvent(evtType, reason, message string) error {
+	f.events = append(f.events, recordedEvent{evtType: evtType, reason: reason, message: message})
+	return nil
+}
+
+func (f *fakeEventRecorder) PodEvent(namespace, name, evtType, reason, message string) error {
+	f.events = append(f.events, recordedEvent{pod: namespace + "/" + name,
+		evtType: evtType, reason: reason, message: message})
+	return nil
+}
+
+type fakeWorkloadScheduler struct {
+	wls map[string][]scheduler.Workload
+}
+
+func (f *fakeWorkloadScheduler) ListWorkloads() (map[string][]scheduler.Workload, error) {
+	return f.wls, nil
+}
+func (f *fakeWorkloadScheduler) CheckExportLabels(labels map[string]bool) bool { return true }
+func (f *fakeWorkloadScheduler) Close() error                                  { return nil }
+func (f *fakeWorkloadScheduler) Type() scheduler.SchedulerType                 { return scheduler.Kubernetes }
+
+func TestGpuHealthEvents(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	recorder := &fakeEventRecorder{}
+	ga.eventRecorder = recorder
+	ga.isKubernetes = true
+	ga.k8sScheduler = &fakeWorkloadScheduler{
+		wls: map[string][]scheduler.Workload{
+			"pcie0": {
+				{
+					Type: scheduler.Kubernetes,
+					Info: scheduler.PodResourceInfo{Pod: "pod0", Namespace: "ns0", Container: "c0"},
+				},
+			},
+		},
+	}
+
+	setHealth := func(health string, reasons ...*metricssvc.GPUHealthReason) {
+		ga.Lock()
+		defer ga.Unlock()
+		gstate, ok := ga.healthState["0"]
+		if !ok {
+			gstate = &metricssvc.GPUState{ID: "0", Device: "pcie0"}
+			ga.healthState["0"] = gstate
+		}
+		gstate.Reasons = reasons
+		ga.setGPUHealth("0", gstate, health)
+	}
+
+	// discovered healthy, no event
+	setHealth(healthyStr)
+	ga.sendHealthEvents()
+	assert.Equal(t, len(recorder.events), 0)
+
+	reason := newHealthReason(metricssvc.GPUHealthReasonType_REASON_CRITICAL_EVENT, "ring timeout")
+	setHealth(unhealthyStr, reason)
+	ga.sendHealthEvents()
+	assert.Equal(t, len(recorder.events), 2)
+	assert.Equal(t, recorder.events[0].pod, "")
+	assert.Equal(t, recorder.events[0].evtType, v1.EventTypeWarning)
+	assert.Equal(t, recorder.events[0].reason, "AMDGPUUnhealthy")
+	assert.Assert(t, strings.Contains(recorder.events[0].message, "GPU 0 (pcie0) is unhealthy"))
+	assert.Assert(t, strings.Contains(recorder.events[0].message, "ring timeout"))
+	assert.Equal(t, recorder.events[1].pod, "ns0/pod0")
+	assert.Equal(t, recorder.events[1].message, recorder.events[0].message)
+
+	// no transition, no event
+	setHealth(unhealthyStr, reason)
+	ga.sendHealthEvents()
+	assert.Equal(t, len(recorder.events), 2)
+
+	// recovery is only posted on the node
+	setHealth(healthyStr)
+	ga.sendHealthEvents()
+	assert.Equal(t, len(recorder.events), 3)
+	assert.Equal(t, recorder.events[2].evtType, v1.EventTypeNormal)
+	assert.Equal(t, recorder.events[2].reason, "AMDGPUHealthy")
+}
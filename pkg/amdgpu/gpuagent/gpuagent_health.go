@@ -22,14 +22,27 @@ import (
 	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/healthhistory"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
 	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// gpuHealthTracker keeps the consecutive health evaluation results of a GPU
+// to damp the health state transitions
+type gpuHealthTracker struct {
+	unhealthyCycles uint32
+	healthyCycles   uint32
+	// unhealthy state is latched until cleared by the operator
+	latched bool
+}
+
 func (ga *GPUAgentClient) getHealthThreshholds() *exportermetrics.GPUHealthThresholds {
 	rConfig := ga.mh.GetRunConfig()
 	// config is never nil as the handler preserves default config
@@ -43,8 +56,29 @@ func (ga *GPUAgentClient) getHealthThreshholds() *exportermetrics.GPUHealthThres
 	return &exportermetrics.GPUHealthThresholds{}
 }
 
+func (ga *GPUAgentClient) getHealthHysteresis() (unhealthyCycles, healthyCycles uint32, sticky bool) {
+	// default is to transition on the first evaluation cycle
+	unhealthyCycles, healthyCycles = 1, 1
+	rConfig := ga.mh.GetRunConfig()
+	if rConfig == nil || rConfig.GetConfig() == nil {
+		return
+	}
+	hcfg := rConfig.GetConfig().GetGPUConfig().GetHealthHysteresis()
+	if hcfg == nil {
+		return
+	}
+	if hcfg.GetUnhealthyCycles() > 0 {
+		unhealthyCycles = hcfg.GetUnhealthyCycles()
+	}
+	if hcfg.GetHealthyCycles() > 0 {
+		healthyCycles = hcfg.GetHealthyCycles()
+	}
+	sticky = hcfg.GetStickyUnhealthy()
+	return
+}
+
 // returns list of
-func (ga *GPUAgentClient) processEccErrorMetrics(gpus []*amdgpu.GPU, wls map[string]scheduler.Workload) map[string]*metricssvc.GPUState {
+func (ga *GPUAgentClient) processEccErrorMetrics(gpus []*amdgpu.GPU, wls map[string][]scheduler.Workload) map[string]*metricssvc.GPUState {
 
 	gpuHealthMap := make(map[string]*metricssvc.GPUState)
 	metricErrCheck := func(gpuid string, fieldName string, threshold uint32, count float64) {
@@ -57,12 +91,21 @@ func (ga *GPUAgentClient) processEccErrorMetrics(gpus []*amdgpu.GPU, wls map[str
 		if count > float64(threshold) {
 			// set health to unhealthy
 			gpuHealthMap[gpuid].Health = strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String())
+			gpuHealthMap[gpuid].Reasons = append(gpuHealthMap[gpuid].Reasons, &metricssvc.GPUHealthReason{
+				Type:      metricssvc.GPUHealthReasonType_REASON_FIELD_THRESHOLD.String(),
+				Rule:      fieldName,
+				Value:     count,
+				Threshold: float64(threshold),
+				FirstSeen: timestamppb.Now(),
+				LastSeen:  timestamppb.Now(),
+			})
 			logger.Log.Printf("gpuid[%v] is set to unhealthy for ecc field [%v] error crossing threshold %v, current value %v", gpuid, fieldName, threshold, count)
 		}
 	}
 	// this will fetch the latest threshold as the config refresh is done
 	// through metrics handler in the main thread
 	thresholds := ga.getHealthThreshholds()
+	rules := ga.getHealthRules()
 
 	for _, gpu := range gpus {
 		uuid, _ := uuid.FromBytes(gpu.Spec.Id)
@@ -103,6 +146,7 @@ func (ga *GPUAgentClient) processEccErrorMetrics(gpus []*amdgpu.GPU, wls map[str
 		metricErrCheck(gpuid, "GPU_ECC_UNCORRECT_JPEG", thresholds.GPU_ECC_UNCORRECT_JPEG, utils.NormalizeUint64(stats.JPEGUncorrectableErrors))
 		metricErrCheck(gpuid, "GPU_ECC_UNCORRECT_IH", thresholds.GPU_ECC_UNCORRECT_IH, utils.NormalizeUint64(stats.IHUncorrectableErrors))
 		metricErrCheck(gpuid, "GPU_ECC_UNCORRECT_MPIO", thresholds.GPU_ECC_UNCORRECT_MPIO, utils.NormalizeUint64(stats.MPIOUncorrectableErrors))
+		ga.processHealthRules(gpu, gpuHealthMap[gpuid], rules)
 	}
 
 	return gpuHealthMap
@@ -113,35 +157,215 @@ func (ga *GPUAgentClient) processEccErrorMetrics(gpus []*amdgpu.GPU, wls map[str
 // to make all gpu unavailable through
 // device plugin - populate the old pcie bus entries with updated workload
 // list
-func (ga *GPUAgentClient) setUnhealthyGPU(wls map[string]scheduler.Workload) error {
+func (ga *GPUAgentClient) setUnhealthyGPU(wls map[string][]scheduler.Workload, reason *metricssvc.GPUHealthReason) error {
 	// valid only for k8s case
 	ga.Lock()
 	defer ga.Unlock()
 
-	var workloadInfo []string
 	// lookup based on device id is limited to k8s case we'll have only k8s job info
 	// this is good enough for reporting the GPU as unhealthy for slinky case as well
-	for _, gpustate := range ga.healthState {
-		if wl, ok := wls[gpustate.Device]; ok {
+	for gpuid, gpustate := range ga.healthState {
+		var workloadInfo []string
+		for _, wl := range wls[gpustate.Device] {
 			workloadInfo = append(workloadInfo, wl.String())
 		}
-		gpustate.Health = strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String())
 		gpustate.AssociatedWorkload = workloadInfo
+		gpustate.Reasons = addHealthReason(gpustate.Reasons, proto.Clone(reason).(*metricssvc.GPUHealthReason))
+		ga.setGPUHealth(gpuid, gpustate, strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String()))
 	}
 
 	return nil
 }
 
+// healthReasonKey identifies the same reason across evaluation cycles
+func healthReasonKey(r *metricssvc.GPUHealthReason) string {
+	return fmt.Sprintf("%v/%v/%v", r.Type, r.Rule, r.EventID)
+}
+
+// mergeHealthReasons returns the new reasons with the first seen time
+// carried over from the matching old reasons
+func mergeHealthReasons(oldReasons, newReasons []*metricssvc.GPUHealthReason) []*metricssvc.GPUHealthReason {
+	firstSeen := make(map[string]*timestamppb.Timestamp)
+	for _, r := range oldReasons {
+		firstSeen[healthReasonKey(r)] = r.FirstSeen
+	}
+	for _, r := range newReasons {
+		if ts, ok := firstSeen[healthReasonKey(r)]; ok && ts != nil {
+			r.FirstSeen = ts
+		}
+	}
+	return newReasons
+}
+
+// addHealthReason returns the reasons with the reason added, the prior
+// reasons are kept and a reason already present keeps its first seen time
+func addHealthReason(reasons []*metricssvc.GPUHealthReason, reason *metricssvc.GPUHealthReason) []*metricssvc.GPUHealthReason {
+	merged := make([]*metricssvc.GPUHealthReason, 0, len(reasons)+1)
+	for _, r := range reasons {
+		if healthReasonKey(r) != healthReasonKey(reason) {
+			merged = append(merged, r)
+		}
+	}
+	return append(merged, mergeHealthReasons(reasons, []*metricssvc.GPUHealthReason{reason})...)
+}
+
+// healthReasonSummary returns a compact single line summary of the reasons
+func healthReasonSummary(reasons []*metricssvc.GPUHealthReason) string {
+	summary := []string{}
+	for _, r := range reasons {
+		switch {
+		case r.Rule != "" && r.Type == metricssvc.GPUHealthReasonType_REASON_FIELD_THRESHOLD.String():
+			summary = append(summary, fmt.Sprintf("%v:%v=%v>%v", r.Type, r.Rule, r.Value, r.Threshold))
+		case r.EventID != "":
+			summary = append(summary, fmt.Sprintf("%v:%v", r.Type, r.EventID))
+		default:
+			summary = append(summary, r.Type)
+		}
+	}
+	return strings.Join(summary, ",")
+}
+
+// newHealthReason returns a health reason observed now
+func newHealthReason(reasonType metricssvc.GPUHealthReasonType, description string) *metricssvc.GPUHealthReason {
+	now := timestamppb.Now()
+	return &metricssvc.GPUHealthReason{
+		Type:        reasonType.String(),
+		Description: description,
+		FirstSeen:   now,
+		LastSeen:    now,
+	}
+}
+
+// setGPUHealth updates the health of the gpu state and records the
+// transition time and history on change, lock must be taken by the caller
+func (ga *GPUAgentClient) setGPUHealth(gpuid string, gpustate *metricssvc.GPUState, health string) {
+	if gpustate.Health == health && gpustate.LastTransitionTime != nil {
+		return
+	}
+	previous := ""
+	if gpustate.LastTransitionTime != nil {
+		previous = gpustate.Health
+	}
+	if gpustate.Health != health {
+		logger.Log.Printf("gpuid[%v] health transition from [%v] to [%v]", gpuid, gpustate.Health, health)
+	}
+	gpustate.Health = health
+	gpustate.LastTransitionTime = timestamppb.Now()
+	ga.recordHealthHistory(gpustate, previous)
+	ga.queueHealthEvent(gpustate, previous)
+}
+
+// updateNewHealthState applies the evaluated health of this cycle on top of
+// the previous state, a GPU changes health only after the configured number
+// of consecutive cycles and an unhealthy GPU stays unhealthy when sticky
+// unhealthy is enabled until cleared through ClearGPUHealth
 func (ga *GPUAgentClient) updateNewHealthState(newGPUState map[string]*metricssvc.GPUState) error {
+	unhealthyCycles, healthyCycles, sticky := ga.getHealthHysteresis()
+	healthy := strings.ToLower(metricssvc.GPUHealth_HEALTHY.String())
+	unhealthy := strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String())
+
 	ga.Lock()
 	defer ga.Unlock()
+	oldHealthState := ga.healthState
 	ga.healthState = make(map[string]*metricssvc.GPUState)
 	for gpuid, hstate := range newGPUState {
+		tracker, ok := ga.healthTracker[gpuid]
+		if !ok {
+			tracker = &gpuHealthTracker{}
+			ga.healthTracker[gpuid] = tracker
+		}
+		if !sticky {
+			tracker.latched = false
+		}
+		// degraded is a usable state and is not damped, it counts as a
+		// healthy cycle for the recovery of an unhealthy GPU
+		observed := hstate.Health
+		observedReasons := hstate.Reasons
+		if observed == unhealthy {
+			tracker.unhealthyCycles++
+			tracker.healthyCycles = 0
+		} else {
+			tracker.healthyCycles++
+			tracker.unhealthyCycles = 0
+		}
+
+		// carry over the previous state, a new GPU starts as healthy
+		current := healthy
+		if ostate, ok := oldHealthState[gpuid]; ok {
+			current = ostate.Health
+			hstate.LastTransitionTime = ostate.LastTransitionTime
+			if observed != unhealthy && current == unhealthy {
+				// keep the reasons of the unhealthy state until recovered
+				hstate.Reasons = ostate.Reasons
+			} else {
+				hstate.Reasons = mergeHealthReasons(ostate.Reasons, hstate.Reasons)
+			}
+		}
+		hstate.Health = current
+
+		switch {
+		case observed == unhealthy && tracker.unhealthyCycles >= unhealthyCycles:
+			if sticky {
+				tracker.latched = true
+			}
+			ga.setGPUHealth(gpuid, hstate, unhealthy)
+		case observed == unhealthy:
+			// not yet unhealthy for enough cycles, keep the current state
+			ga.setGPUHealth(gpuid, hstate, current)
+		case current == unhealthy:
+			if !tracker.latched && tracker.healthyCycles >= healthyCycles {
+				hstate.Reasons = observedReasons
+				ga.setGPUHealth(gpuid, hstate, observed)
+			}
+		default:
+			ga.setGPUHealth(gpuid, hstate, observed)
+		}
 		ga.healthState[gpuid] = hstate
 	}
+	// remove trackers of the GPUs that are no longer reported
+	for gpuid := range ga.healthTracker {
+		if _, ok := newGPUState[gpuid]; !ok {
+			delete(ga.healthTracker, gpuid)
+		}
+	}
 	return nil
 }
 
+// ClearGPUHealth clears the latched unhealthy state of the given GPUs, all
+// GPUs are cleared if the list is empty. A cleared GPU turns healthy once it
+// has been evaluated healthy for the configured number of cycles.
+func (ga *GPUAgentClient) ClearGPUHealth(gpuids []string) ([]string, error) {
+	_, healthyCycles, _ := ga.getHealthHysteresis()
+	healthy := strings.ToLower(metricssvc.GPUHealth_HEALTHY.String())
+
+	ga.Lock()
+	defer ga.Unlock()
+	if len(gpuids) == 0 {
+		for gpuid := range ga.healthState {
+			gpuids = append(gpuids, gpuid)
+		}
+	}
+	cleared := []string{}
+	for _, gpuid := range gpuids {
+		gstate, ok := ga.healthState[gpuid]
+		if !ok {
+			return nil, fmt.Errorf("gpuid[%v] not found", gpuid)
+		}
+		tracker, ok := ga.healthTracker[gpuid]
+		if !ok || !tracker.latched {
+			continue
+		}
+		tracker.latched = false
+		logger.Log.Printf("gpuid[%v] unhealthy state cleared", gpuid)
+		if ga.computeNodeHealthState && tracker.healthyCycles >= healthyCycles {
+			gstate.Reasons = nil
+			ga.setGPUHealth(gpuid, gstate, healthy)
+		}
+		cleared = append(cleared, gpuid)
+	}
+	return cleared, nil
+}
+
 func (ga *GPUAgentClient) processHealthValidation() error {
 	wls, err := ga.ListWorkloads()
 	if err != nil {
@@ -151,7 +375,8 @@ func (ga *GPUAgentClient) processHealthValidation() error {
 	ga.Lock()
 	if !ga.computeNodeHealthState { // unhealthy
 		ga.Unlock()
-		_ = ga.setUnhealthyGPU(wls)
+		_ = ga.setUnhealthyGPU(wls, newHealthReason(metricssvc.GPUHealthReasonType_REASON_NODE_HEALTH_OVERRIDE,
+			"compute node health set to unhealthy"))
 		err := fmt.Errorf("compute node unhealthy, cannot process metrics")
 		logger.Log.Printf("err: %+v", err)
 		return err
@@ -163,6 +388,7 @@ func (ga *GPUAgentClient) processHealthValidation() error {
 	var newGPUState map[string]*metricssvc.GPUState
 
 	errOccured := false
+	errReason := ""
 
 	gpuUUIDMap := make(map[string]string)
 
@@ -175,6 +401,13 @@ func (ga *GPUAgentClient) processHealthValidation() error {
 		if e.Severity == amdgpu.EventSeverity_EVENT_SEVERITY_CRITICAL {
 			if gpuid, ok := gpuUUIDMap[gpuuid]; ok {
 				newGPUState[gpuid].Health = strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String())
+				reason := newHealthReason(metricssvc.GPUHealthReasonType_REASON_CRITICAL_EVENT, e.Description)
+				reason.Rule = e.Category.String()
+				reason.EventID = e.Id.String()
+				if e.Time != nil {
+					reason.FirstSeen = e.Time
+				}
+				newGPUState[gpuid].Reasons = append(newGPUState[gpuid].Reasons, reason)
 				logger.Log.Printf("gpuid[%v] is set to unhealthy for evt[%+v]", gpuid, e)
 			} else {
 				logger.Log.Printf("ignoring invalid gpuid[%v] is set to unhealthy for evt[%+v]", gpuuid, e)
@@ -185,12 +418,14 @@ func (ga *GPUAgentClient) processHealthValidation() error {
 	gpumetrics, _, err = ga.getGPUs()
 	if err != nil || (gpumetrics != nil && gpumetrics.ApiStatus != 0) {
 		errOccured = true
+		errReason = fmt.Sprintf("gpuagent get metrics failed %v", err)
 		logger.Log.Printf("gpuagent get metrics failed %v", err)
 		goto ret
 	} else if len(gpumetrics.Response) == 0 {
 		// on driver crash gpuagent will return 0 gpus, handle such cases
 		// if we have old state, mark all of the gpu as unhealthy
-		return ga.setUnhealthyGPU(wls)
+		return ga.setUnhealthyGPU(wls, newHealthReason(metricssvc.GPUHealthReasonType_REASON_AGENT_UNAVAILABLE,
+			"gpuagent reported no GPUs"))
 	} else {
 		newGPUState = ga.processEccErrorMetrics(gpumetrics.Response, wls)
 	}
@@ -207,6 +442,7 @@ func (ga *GPUAgentClient) processHealthValidation() error {
 		evtData, err = ga.getEvents(amdgpu.EventSeverity_EVENT_SEVERITY_CRITICAL)
 		if err != nil || (evtData != nil && evtData.ApiStatus != 0) {
 			errOccured = true
+			errReason = fmt.Sprintf("gpuagent get events failed %v", err)
 			logger.Log.Printf("gpuagent get events failed %v", err)
 		} else {
 			// business logic for health detection
@@ -221,7 +457,7 @@ ret:
 	if errOccured {
 		ga.Close()
 		// set state to unhealthy with updated workload list
-		_ = ga.setUnhealthyGPU(wls)
+		_ = ga.setUnhealthyGPU(wls, newHealthReason(metricssvc.GPUHealthReasonType_REASON_AGENT_UNAVAILABLE, errReason))
 		return fmt.Errorf("data pull error occured")
 	}
 
@@ -262,8 +498,15 @@ func (ga *GPUAgentClient) GetGPUHealthStates() (map[string]interface{}, error) {
 	if len(ga.healthState) == 0 {
 		return nil, fmt.Errorf("health status not available")
 	}
+	degraded := strings.ToLower(metricssvc.GPUHealth_DEGRADED.String())
+	ignoreDegraded := ga.getDegradedHealthConfig().GetHealthServiceIgnore()
 	healthMap := make(map[string]interface{})
 	for id, gstate := range ga.healthState {
+		if ignoreDegraded && gstate.Health == degraded {
+			// report as healthy without changing the tracked state
+			gstate = proto.Clone(gstate).(*metricssvc.GPUState)
+			gstate.Health = strings.ToLower(metricssvc.GPUHealth_HEALTHY.String())
+		}
 		healthMap[id] = gstate
 	}
 
@@ -292,11 +535,19 @@ func (ga *GPUAgentClient) SetComputeNodeHealthState(state bool) {
 }
 
 func (ga *GPUAgentClient) updateAllGPUsHealthState(healthStr string) {
+	var reasons []*metricssvc.GPUHealthReason
+	if healthStr != strings.ToLower(metricssvc.GPUHealth_HEALTHY.String()) {
+		reasons = []*metricssvc.GPUHealthReason{
+			newHealthReason(metricssvc.GPUHealthReasonType_REASON_NODE_HEALTH_OVERRIDE,
+				"compute node health set to unhealthy"),
+		}
+	}
 	// If health state is already set, mark all GPUs as unhealthy
 	if len(ga.healthState) > 0 {
 		logger.Log.Printf("GPUs are already fetched, setting health state")
-		for gpuid := range ga.healthState {
-			ga.healthState[gpuid].Health = healthStr
+		for gpuid, gstate := range ga.healthState {
+			gstate.Reasons = reasons
+			ga.setGPUHealth(gpuid, gstate, healthStr)
 		}
 		return
 	}
@@ -321,12 +572,87 @@ func (ga *GPUAgentClient) updateAllGPUsHealthState(healthStr string) {
 
 		workloadInfo := ga.getWorkloadsListString(wls, gpu)
 
-		ga.healthState[gpuid] = &metricssvc.GPUState{
+		gstate := &metricssvc.GPUState{
 			ID:                 gpuid,
 			UUID:               gpuuid,
-			Health:             healthStr,
 			Device:             deviceid,
 			AssociatedWorkload: workloadInfo,
+			Reasons:            reasons,
 		}
+		ga.setGPUHealth(gpuid, gstate, healthStr)
+		ga.healthState[gpuid] = gstate
+	}
+}
+
+// initHealthHistory opens the health history store of the config, the
+// store is kept when the file and size are unchanged
+func (ga *GPUAgentClient) initHealthHistory(config *exportermetrics.GPUMetricConfig) {
+	if !ga.enableHealthHistory {
+		return
+	}
+	cfg := config.GetHealthHistory()
+	filePath := cfg.GetFilePath()
+	if filePath == "" {
+		filePath = globals.GPUHealthHistoryFile
+	}
+	maxEntries := int(cfg.GetMaxEntries())
+	if maxEntries == 0 {
+		maxEntries = globals.GPUHealthHistoryMaxEntries
+	}
+	if store := ga.healthHistory.Load(); store != nil &&
+		store.FilePath() == filePath && store.MaxEntries() == maxEntries {
+		return
+	}
+	// entries queued for the previous store are written first
+	ga.writeHealthHistory()
+	ga.healthHistory.Store(healthhistory.NewStore(filePath, maxEntries))
+	logger.Log.Printf("health history in %v, keeping %v", filePath, maxEntries)
+}
+
+// recordHealthHistory queues the health transition of the gpu state for the
+// health history, lock must be taken by the caller
+func (ga *GPUAgentClient) recordHealthHistory(gpustate *metricssvc.GPUState, previous string) {
+	if ga.healthHistory.Load() == nil {
+		return
+	}
+	entry := &metricssvc.GPUHealthHistoryEntry{
+		ID:             gpustate.ID,
+		UUID:           gpustate.UUID,
+		Device:         gpustate.Device,
+		Health:         gpustate.Health,
+		PreviousHealth: previous,
+		Time:           gpustate.LastTransitionTime,
+	}
+	for _, r := range gpustate.Reasons {
+		entry.Reasons = append(entry.Reasons, proto.Clone(r).(*metricssvc.GPUHealthReason))
+	}
+	ga.pendingHistory = append(ga.pendingHistory, entry)
+}
+
+// writeHealthHistory appends the queued health transitions to the history
+// store, the file is written outside of the agent lock
+func (ga *GPUAgentClient) writeHealthHistory() {
+	ga.Lock()
+	pending := ga.pendingHistory
+	ga.pendingHistory = nil
+	ga.Unlock()
+	store := ga.healthHistory.Load()
+	if store == nil {
+		return
+	}
+	for _, entry := range pending {
+		if err := store.Append(entry); err != nil {
+			logger.Log.Printf("gpuid[%v] health history update failed, err: %v", entry.ID, err)
+		}
+	}
+}
+
+// GetHealthHistory returns the recorded health transitions matching the request
+func (ga *GPUAgentClient) GetHealthHistory(req *metricssvc.GPUHealthHistoryRequest) ([]*metricssvc.GPUHealthHistoryEntry, error) {
+	store := ga.healthHistory.Load()
+	if store == nil {
+		return nil, fmt.Errorf("health history not enabled")
 	}
+	ga.writeHealthHistory()
+	return store.Query(req), nil
 }
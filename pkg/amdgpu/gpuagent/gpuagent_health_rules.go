@@ -0,0 +1,207 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PCIe link downtraining fields, difference of the current link from the max
+const (
+	pcieWidthDegradationField = "GPU_PCIE_WIDTH_DEGRADATION"
+	pcieSpeedDegradationField = "GPU_PCIE_SPEED_DEGRADATION"
+)
+
+type healthRuleFieldFn func(gpu *amdgpu.GPU) float64
+
+// healthRuleFields are the GPU metric fields supported by the health rules
+var healthRuleFields = map[string]healthRuleFieldFn{
+	"GPU_ECC_CORRECT_TOTAL":     func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetTotalCorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_TOTAL":   func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetTotalUncorrectableErrors()) },
+	"GPU_ECC_CORRECT_SDMA":      func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetSDMACorrectableErrors()) },
+	"GPU_ECC_CORRECT_GFX":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetGFXCorrectableErrors()) },
+	"GPU_ECC_CORRECT_MMHUB":     func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMMHUBCorrectableErrors()) },
+	"GPU_ECC_CORRECT_ATHUB":     func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetATHUBCorrectableErrors()) },
+	"GPU_ECC_CORRECT_BIF":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetBIFCorrectableErrors()) },
+	"GPU_ECC_CORRECT_HDP":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetHDPCorrectableErrors()) },
+	"GPU_ECC_CORRECT_XGMI_WAFL": func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetXGMIWAFLCorrectableErrors()) },
+	"GPU_ECC_CORRECT_DF":        func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetDFCorrectableErrors()) },
+	"GPU_ECC_CORRECT_SMN":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetSMNCorrectableErrors()) },
+	"GPU_ECC_CORRECT_SEM":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetSEMCorrectableErrors()) },
+	"GPU_ECC_CORRECT_MP0":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMP0CorrectableErrors()) },
+	"GPU_ECC_CORRECT_MP1":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMP1CorrectableErrors()) },
+	"GPU_ECC_CORRECT_FUSE":      func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetFUSECorrectableErrors()) },
+	"GPU_ECC_CORRECT_UMC":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetUMCCorrectableErrors()) },
+	"GPU_ECC_CORRECT_MCA":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMCACorrectableErrors()) },
+	"GPU_ECC_CORRECT_VCN":       func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetVCNCorrectableErrors()) },
+	"GPU_ECC_CORRECT_JPEG":      func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetJPEGCorrectableErrors()) },
+	"GPU_ECC_CORRECT_IH":        func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetIHCorrectableErrors()) },
+	"GPU_ECC_CORRECT_MPIO":      func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMPIOCorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_SDMA":    func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetSDMAUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_GFX":     func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetGFXUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_MMHUB":   func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMMHUBUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_ATHUB":   func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetATHUBUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_BIF":     func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetBIFUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_HDP":     func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetHDPUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_XGMI_WAFL": func(g *amdgpu.GPU) float64 {
+		return utils.NormalizeUint64(g.GetStats().GetXGMIWAFLUncorrectableErrors())
+	},
+	"GPU_ECC_UNCORRECT_DF":   func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetDFUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_SMN":  func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetSMNUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_SEM":  func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetSEMUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_MP0":  func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMP0UncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_MP1":  func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMP1UncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_FUSE": func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetFUSEUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_UMC":  func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetUMCUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_MCA":  func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMCAUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_VCN":  func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetVCNUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_JPEG": func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetJPEGUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_IH":   func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetIHUncorrectableErrors()) },
+	"GPU_ECC_UNCORRECT_MPIO": func(g *amdgpu.GPU) float64 { return utils.NormalizeUint64(g.GetStats().GetMPIOUncorrectableErrors()) },
+	"PCIE_REPLAY_COUNT": func(g *amdgpu.GPU) float64 {
+		return utils.NormalizeUint64(g.GetStats().GetPCIeStats().GetReplayCount())
+	},
+	"PCIE_RECOVERY_COUNT": func(g *amdgpu.GPU) float64 {
+		return utils.NormalizeUint64(g.GetStats().GetPCIeStats().GetRecoveryCount())
+	},
+	"GPU_VIOLATION_PROCESSOR_HOT_RESIDENCY_ACCUMULATED": func(g *amdgpu.GPU) float64 {
+		return utils.NormalizeUint64(g.GetStats().GetViolationStats().GetProcessorHotResidencyAccumulated())
+	},
+	"GPU_VIOLATION_PPT_RESIDENCY_ACCUMULATED": func(g *amdgpu.GPU) float64 {
+		return utils.NormalizeUint64(g.GetStats().GetViolationStats().GetPPTResidencyAccumulated())
+	},
+	"GPU_VIOLATION_SOCKET_THERMAL_RESIDENCY_ACCUMULATED": func(g *amdgpu.GPU) float64 {
+		return utils.NormalizeUint64(g.GetStats().GetViolationStats().GetSocketThermalResidencyAccumulated())
+	},
+	"GPU_VIOLATION_VR_THERMAL_RESIDENCY_ACCUMULATED": func(g *amdgpu.GPU) float64 {
+		return utils.NormalizeUint64(g.GetStats().GetViolationStats().GetVRThermalResidencyAccumulated())
+	},
+	"GPU_VIOLATION_HBM_THERMAL_RESIDENCY_ACCUMULATED": func(g *amdgpu.GPU) float64 {
+		return utils.NormalizeUint64(g.GetStats().GetViolationStats().GetHBMThermalResidencyAccumulated())
+	},
+	pcieWidthDegradationField: func(g *amdgpu.GPU) float64 {
+		pcie := g.GetStatus().GetPCIeStatus()
+		if pcie == nil || pcie.MaxWidth <= pcie.Width {
+			return 0
+		}
+		return float64(pcie.MaxWidth - pcie.Width)
+	},
+	pcieSpeedDegradationField: func(g *amdgpu.GPU) float64 {
+		pcie := g.GetStatus().GetPCIeStatus()
+		if pcie == nil || pcie.MaxSpeed <= pcie.Speed {
+			return 0
+		}
+		return float64(pcie.MaxSpeed - pcie.Speed)
+	},
+}
+
+func (ga *GPUAgentClient) getHealthRules() []*exportermetrics.GPUHealthRule {
+	rConfig := ga.mh.GetRunConfig()
+	if rConfig == nil || rConfig.GetConfig() == nil {
+		return nil
+	}
+	return rConfig.GetConfig().GetGPUConfig().GetHealthRules()
+}
+
+func (ga *GPUAgentClient) getDegradedHealthConfig() *exportermetrics.GPUDegradedHealthConfig {
+	rConfig := ga.mh.GetRunConfig()
+	if rConfig == nil || rConfig.GetConfig() == nil ||
+		rConfig.GetConfig().GetGPUConfig().GetDegradedHealth() == nil {
+		// default is to report DEGRADED to all consumers
+		return &exportermetrics.GPUDegradedHealthConfig{}
+	}
+	return rConfig.GetConfig().GetGPUConfig().GetDegradedHealth()
+}
+
+// getNodeHealthReportingConfig returns the node health reporting settings,
+// node labels only by default
+func (ga *GPUAgentClient) getNodeHealthReportingConfig() *exportermetrics.NodeHealthReportingConfig {
+	rConfig := ga.mh.GetRunConfig()
+	if rConfig == nil || rConfig.GetConfig() == nil ||
+		rConfig.GetConfig().GetGPUConfig().GetNodeHealthReporting() == nil {
+		return &exportermetrics.NodeHealthReportingConfig{}
+	}
+	return rConfig.GetConfig().GetGPUConfig().GetNodeHealthReporting()
+}
+
+// healthRuleLevel returns the health level of the rule, empty on invalid level
+func healthRuleLevel(rule *exportermetrics.GPUHealthRule) string {
+	switch strings.ToUpper(rule.GetLevel()) {
+	case "", metricssvc.GPUHealth_DEGRADED.String():
+		return strings.ToLower(metricssvc.GPUHealth_DEGRADED.String())
+	case metricssvc.GPUHealth_UNHEALTHY.String():
+		return strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String())
+	}
+	return ""
+}
+
+// healthLevelSeverity orders the health levels, higher is worse
+func healthLevelSeverity(health string) int {
+	switch health {
+	case strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String()):
+		return 2
+	case strings.ToLower(metricssvc.GPUHealth_DEGRADED.String()):
+		return 1
+	}
+	return 0
+}
+
+// processHealthRules evaluates the configured health rules of a GPU and
+// updates the GPU state with the worst level triggered
+func (ga *GPUAgentClient) processHealthRules(gpu *amdgpu.GPU, gstate *metricssvc.GPUState, rules []*exportermetrics.GPUHealthRule) {
+	for _, rule := range rules {
+		fieldFn, ok := healthRuleFields[strings.ToUpper(rule.GetField())]
+		if !ok {
+			logger.Log.Printf("unsupported health rule field %v, ignored", rule.GetField())
+			continue
+		}
+		level := healthRuleLevel(rule)
+		if level == "" {
+			logger.Log.Printf("invalid health rule level %v for field %v, ignored", rule.GetLevel(), rule.GetField())
+			continue
+		}
+		value := fieldFn(gpu)
+		if mockVal := ga.getMockError(gstate.ID, strings.ToUpper(rule.GetField())); mockVal > 0 {
+			value = float64(mockVal)
+		}
+		if value <= rule.GetThreshold() {
+			continue
+		}
+		if healthLevelSeverity(level) > healthLevelSeverity(gstate.Health) {
+			gstate.Health = level
+		}
+		now := timestamppb.Now()
+		gstate.Reasons = append(gstate.Reasons, &metricssvc.GPUHealthReason{
+			Type:        metricssvc.GPUHealthReasonType_REASON_FIELD_THRESHOLD.String(),
+			Rule:        strings.ToUpper(rule.GetField()),
+			Value:       value,
+			Threshold:   rule.GetThreshold(),
+			Description: level,
+			FirstSeen:   now,
+			LastSeen:    now,
+		})
+		logger.Log.Printf("gpuid[%v] health rule on field [%v] crossed threshold %v, current value %v, level %v",
+			gstate.ID, rule.GetField(), rule.GetThreshold(), value, level)
+	}
+}
@@ -0,0 +1,304 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/healthhistory"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"gotest.tools/assert"
+)
+
+var (
+	healthyStr   = strings.ToLower(metricssvc.GPUHealth_HEALTHY.String())
+	unhealthyStr = strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String())
+)
+
+func evalHealth(t *testing.T, ga *GPUAgentClient, health string) string {
+	err := ga.updateNewHealthState(map[string]*metricssvc.GPUState{
+		"0": {ID: "0", Health: health},
+	})
+	assert.Assert(t, err == nil, "expecting success health update")
+	ga.Lock()
+	defer ga.Unlock()
+	return ga.healthState["0"].Health
+}
+
+func setHealthHysteresis(hcfg *exportermetrics.GPUHealthHysteresis) {
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		HealthHysteresis: hcfg,
+	}
+}
+
+func TestGpuHealthNoHysteresis(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	setHealthHysteresis(nil)
+
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+	assert.Assert(t, ga.healthState["0"].LastTransitionTime != nil, "expecting transition time")
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), unhealthyStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+}
+
+func TestGpuHealthHysteresis(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	setHealthHysteresis(&exportermetrics.GPUHealthHysteresis{
+		UnhealthyCycles: 3,
+		HealthyCycles:   2,
+	})
+	defer setHealthHysteresis(nil)
+
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+	firstTransition := ga.healthState["0"].LastTransitionTime.AsTime()
+
+	// flapping doesn't change the state
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), healthyStr)
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), healthyStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+	assert.Equal(t, ga.healthState["0"].LastTransitionTime.AsTime(), firstTransition)
+
+	// consecutive unhealthy cycles
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), healthyStr)
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), healthyStr)
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), unhealthyStr)
+	assert.Assert(t, ga.healthState["0"].LastTransitionTime.AsTime().After(firstTransition) ||
+		ga.healthState["0"].LastTransitionTime.AsTime().Equal(firstTransition))
+
+	// recover after consecutive healthy cycles
+	assert.Equal(t, evalHealth(t, ga, healthyStr), unhealthyStr)
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), unhealthyStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), unhealthyStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+}
+
+func TestGpuHealthSticky(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	setHealthHysteresis(&exportermetrics.GPUHealthHysteresis{
+		StickyUnhealthy: true,
+	})
+	defer setHealthHysteresis(nil)
+
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), unhealthyStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), unhealthyStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), unhealthyStr)
+
+	// unknown gpu
+	_, err := ga.ClearGPUHealth([]string{"10"})
+	assert.Assert(t, err != nil, "expecting failure on invalid gpu")
+
+	cleared, err := ga.ClearGPUHealth([]string{})
+	assert.Assert(t, err == nil, "expecting success clear")
+	assert.DeepEqual(t, cleared, []string{"0"})
+	assert.Equal(t, ga.healthState["0"].Health, healthyStr)
+
+	// nothing latched
+	cleared, err = ga.ClearGPUHealth([]string{"0"})
+	assert.Assert(t, err == nil, "expecting success clear")
+	assert.Equal(t, len(cleared), 0)
+
+	// latched again and cleared while still unhealthy
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), unhealthyStr)
+	_, err = ga.ClearGPUHealth([]string{"0"})
+	assert.Assert(t, err == nil, "expecting success clear")
+	assert.Equal(t, ga.healthState["0"].Health, unhealthyStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+}
+
+func TestGpuHealthReasons(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	setHealthHysteresis(&exportermetrics.GPUHealthHysteresis{
+		HealthyCycles: 2,
+	})
+	defer setHealthHysteresis(nil)
+
+	newReason := func() *metricssvc.GPUHealthReason {
+		r := newHealthReason(metricssvc.GPUHealthReasonType_REASON_FIELD_THRESHOLD, "")
+		r.Rule = "GPU_ECC_UNCORRECT_UMC"
+		r.Value = 5
+		r.Threshold = 1
+		return r
+	}
+	evalReasons := func(health string, reasons ...*metricssvc.GPUHealthReason) []*metricssvc.GPUHealthReason {
+		err := ga.updateNewHealthState(map[string]*metricssvc.GPUState{
+			"0": {ID: "0", Health: health, Reasons: reasons},
+		})
+		assert.Assert(t, err == nil, "expecting success health update")
+		return ga.healthState["0"].Reasons
+	}
+
+	reasons := evalReasons(unhealthyStr, newReason())
+	assert.Equal(t, len(reasons), 1)
+	firstSeen := reasons[0].FirstSeen.AsTime()
+
+	// first seen is carried over for the same rule
+	reasons = evalReasons(unhealthyStr, newReason())
+	assert.Equal(t, len(reasons), 1)
+	assert.Equal(t, reasons[0].FirstSeen.AsTime(), firstSeen)
+
+	// reasons are kept until the gpu recovers
+	reasons = evalReasons(healthyStr)
+	assert.Equal(t, len(reasons), 1)
+	assert.Equal(t, ga.healthState["0"].Health, unhealthyStr)
+	reasons = evalReasons(healthyStr)
+	assert.Equal(t, len(reasons), 0)
+	assert.Equal(t, ga.healthState["0"].Health, healthyStr)
+
+	// an agent outage adds its reason to the reasons of the gpu
+	evalReasons(unhealthyStr, newReason())
+	outage := func() []*metricssvc.GPUHealthReason {
+		assert.NilError(t, ga.setUnhealthyGPU(nil, newHealthReason(
+			metricssvc.GPUHealthReasonType_REASON_AGENT_UNAVAILABLE, "gpuagent unavailable")))
+		return ga.healthState["0"].Reasons
+	}
+	reasons = outage()
+	assert.Equal(t, len(reasons), 2)
+	assert.Equal(t, reasons[0].Rule, "GPU_ECC_UNCORRECT_UMC")
+	assert.Equal(t, reasons[1].Type, metricssvc.GPUHealthReasonType_REASON_AGENT_UNAVAILABLE.String())
+	outageSeen := reasons[1].FirstSeen.AsTime()
+	reasons = outage()
+	assert.Equal(t, len(reasons), 2)
+	assert.Equal(t, reasons[1].FirstSeen.AsTime(), outageSeen)
+
+	assert.Equal(t, healthReasonSummary([]*metricssvc.GPUHealthReason{newReason()}),
+		"REASON_FIELD_THRESHOLD:GPU_ECC_UNCORRECT_UMC=5>1")
+}
+
+func TestGpuHealthDegraded(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	degradedStr := strings.ToLower(metricssvc.GPUHealth_DEGRADED.String())
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		HealthRules: []*exportermetrics.GPUHealthRule{
+			{Field: "GPU_ECC_CORRECT_UMC", Threshold: 10},
+			{Field: "GPU_PCIE_WIDTH_DEGRADATION", Threshold: 0, Level: "unhealthy"},
+			{Field: "GPU_INVALID_FIELD", Threshold: 0},
+			{Field: "GPU_ECC_CORRECT_GFX", Threshold: 0, Level: "invalid"},
+		},
+		HealthHysteresis: &exportermetrics.GPUHealthHysteresis{
+			UnhealthyCycles: 2,
+		},
+	}
+	defer setHealthHysteresis(nil)
+
+	gpu := &amdgpu.GPU{
+		Status: &amdgpu.GPUStatus{
+			PCIeStatus: &amdgpu.GPUPCIeStatus{Width: 16, MaxWidth: 16},
+		},
+		Stats: &amdgpu.GPUStats{UMCCorrectableErrors: 20, GFXCorrectableErrors: 5},
+	}
+	gstate := &metricssvc.GPUState{ID: "0", Health: healthyStr}
+	ga.processHealthRules(gpu, gstate, ga.getHealthRules())
+	assert.Equal(t, gstate.Health, degradedStr)
+	assert.Equal(t, len(gstate.Reasons), 1)
+	assert.Equal(t, gstate.Reasons[0].Rule, "GPU_ECC_CORRECT_UMC")
+
+	// downtrained link is unhealthy
+	gpu.Status.PCIeStatus.Width = 8
+	gstate = &metricssvc.GPUState{ID: "0", Health: healthyStr}
+	ga.processHealthRules(gpu, gstate, ga.getHealthRules())
+	assert.Equal(t, gstate.Health, unhealthyStr)
+	assert.Equal(t, len(gstate.Reasons), 2)
+
+	// degraded is not damped, unhealthy recovers to degraded
+	assert.Equal(t, evalHealth(t, ga, degradedStr), degradedStr)
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), degradedStr)
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), unhealthyStr)
+	assert.Equal(t, evalHealth(t, ga, degradedStr), degradedStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+
+	// health service ignores degraded
+	assert.Equal(t, evalHealth(t, ga, degradedStr), degradedStr)
+	mConfig.GetConfig().GPUConfig.DegradedHealth = &exportermetrics.GPUDegradedHealthConfig{
+		HealthServiceIgnore: true,
+	}
+	states, err := ga.GetGPUHealthStates()
+	assert.Assert(t, err == nil, "expecting success health states")
+	assert.Equal(t, states["0"].(*metricssvc.GPUState).Health, healthyStr)
+	assert.Equal(t, ga.healthState["0"].Health, degradedStr)
+}
+
+func TestGpuHealthHistory(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	setHealthHysteresis(nil)
+
+	_, err := ga.GetHealthHistory(&metricssvc.GPUHealthHistoryRequest{})
+	assert.Assert(t, err != nil, "expecting failure without history store")
+
+	ga.healthHistory.Store(healthhistory.NewStore(filepath.Join(t.TempDir(), "history.log"), 100))
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), unhealthyStr)
+	assert.Equal(t, evalHealth(t, ga, healthyStr), healthyStr)
+
+	entries, err := ga.GetHealthHistory(&metricssvc.GPUHealthHistoryRequest{})
+	assert.Assert(t, err == nil, "expecting success history query")
+	assert.Equal(t, len(entries), 3)
+	assert.Equal(t, entries[0].PreviousHealth, "")
+	assert.Equal(t, entries[1].PreviousHealth, healthyStr)
+	assert.Equal(t, entries[1].Health, unhealthyStr)
+
+	entries, err = ga.GetHealthHistory(&metricssvc.GPUHealthHistoryRequest{Health: []string{unhealthyStr}})
+	assert.Assert(t, err == nil, "expecting success history query")
+	assert.Equal(t, len(entries), 1)
+
+	// store from the config, the transitions are queued under the agent
+	// lock and written by the monitor
+	filePath := filepath.Join(t.TempDir(), "history", "gpu_health_history.log")
+	ga.enableHealthHistory = true
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		HealthHistory: &exportermetrics.GPUHealthHistoryConfig{FilePath: filePath, MaxEntries: 5},
+	}
+	assert.NilError(t, ga.InitConfigs())
+	store := ga.healthHistory.Load()
+	assert.Equal(t, store.FilePath(), filePath)
+	assert.Equal(t, store.MaxEntries(), 5)
+	assert.Equal(t, evalHealth(t, ga, unhealthyStr), unhealthyStr)
+	assert.Equal(t, len(ga.pendingHistory), 1)
+	assert.Equal(t, len(store.Query(&metricssvc.GPUHealthHistoryRequest{})), 0)
+	ga.writeHealthHistory()
+	assert.Equal(t, len(ga.pendingHistory), 0)
+	assert.Equal(t, len(store.Query(&metricssvc.GPUHealthHistoryRequest{})), 1)
+	_, err = os.Stat(filePath)
+	assert.NilError(t, err)
+
+	// unchanged config keeps the store
+	assert.NilError(t, ga.InitConfigs())
+	assert.Assert(t, ga.healthHistory.Load() == store)
+}
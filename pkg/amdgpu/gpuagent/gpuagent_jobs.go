@@ -0,0 +1,323 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/jobaccounting"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// throttle reasons reported in the job summaries
+const (
+	throttleProcessorHot  = "processor_hot"
+	throttlePPT           = "ppt"
+	throttleSocketThermal = "socket_thermal"
+	throttleVRThermal     = "vr_thermal"
+	throttleHBMThermal    = "hbm_thermal"
+)
+
+// job summary metrics, exported when enabled in the job accounting config
+type jobMetrics struct {
+	gpuHours            prometheus.GaugeVec
+	durationSeconds     prometheus.GaugeVec
+	energyConsumed      prometheus.GaugeVec
+	peakVRAMUsed        prometheus.GaugeVec
+	averageVRAMUsed     prometheus.GaugeVec
+	averageGFXActivity  prometheus.GaugeVec
+	averageUMCActivity  prometheus.GaugeVec
+	correctableErrors   prometheus.GaugeVec
+	uncorrectableErrors prometheus.GaugeVec
+	throttleResidency   prometheus.GaugeVec
+}
+
+func (jm *jobMetrics) gauges() []*prometheus.GaugeVec {
+	return []*prometheus.GaugeVec{
+		&jm.gpuHours, &jm.durationSeconds, &jm.energyConsumed,
+		&jm.peakVRAMUsed, &jm.averageVRAMUsed, &jm.averageGFXActivity,
+		&jm.averageUMCActivity, &jm.correctableErrors, &jm.uncorrectableErrors,
+		&jm.throttleResidency,
+	}
+}
+
+func jobMetricLabels() []string {
+	return []string{
+		strings.ToLower(exportermetrics.MetricLabel_JOB_ID.String()),
+		strings.ToLower(exportermetrics.MetricLabel_JOB_USER.String()),
+		strings.ToLower(exportermetrics.MetricLabel_JOB_PARTITION.String()),
+		strings.ToLower(exportermetrics.MetricLabel_CLUSTER_NAME.String()),
+	}
+}
+
+func newJobMetrics(nonGpuLabels []string) *jobMetrics {
+	labels := jobMetricLabels()
+	for _, label := range nonGpuLabels {
+		// cluster name may also be a custom label
+		if !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	gauge := func(name, help string, labels []string) prometheus.GaugeVec {
+		return *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: name,
+			Help: help,
+		}, labels)
+	}
+	return &jobMetrics{
+		gpuHours:            gauge("job_gpu_hours", "GPU hours used by the ended job", labels),
+		durationSeconds:     gauge("job_duration_seconds", "Duration of the ended job in seconds", labels),
+		energyConsumed:      gauge("job_energy_consumed", "Energy consumed by the GPUs of the ended job in Joules", labels),
+		peakVRAMUsed:        gauge("job_peak_vram_used", "Peak VRAM used on a GPU of the ended job in MB", labels),
+		averageVRAMUsed:     gauge("job_average_vram_used", "Average VRAM used per GPU of the ended job in MB", labels),
+		averageGFXActivity:  gauge("job_average_gfx_activity", "Average GFX activity of the GPUs of the ended job in percent", labels),
+		averageUMCActivity:  gauge("job_average_umc_activity", "Average UMC activity of the GPUs of the ended job in percent", labels),
+		correctableErrors:   gauge("job_correctable_errors", "Correctable ECC errors seen during the ended job", labels),
+		uncorrectableErrors: gauge("job_uncorrectable_errors", "Uncorrectable ECC errors seen during the ended job", labels),
+		throttleResidency: gauge("job_throttle_residency", "Throttle residency of the GPUs of the ended job in percent",
+			append([]string{"reason"}, labels...)),
+	}
+}
+
+// initJobAccounting starts, reconfigures or stops the job accounting from
+// the config
+func (ga *GPUAgentClient) initJobAccounting(config *exportermetrics.GPUMetricConfig) {
+	cfg := config.GetJobAccounting()
+	ga.jm.Store(nil)
+	if !cfg.GetEnable() {
+		if ga.jobAccounting.Swap(nil) != nil {
+			logger.Log.Printf("job accounting disabled")
+		}
+		return
+	}
+	dir := cfg.GetDirectory()
+	if dir == "" {
+		dir = globals.GPUJobSummaryDir
+	}
+	maxSummaries := int(cfg.GetMaxSummaries())
+	if maxSummaries == 0 {
+		maxSummaries = globals.GPUJobSummaryMaxEntries
+	}
+	if acct := ga.jobAccounting.Load(); acct == nil {
+		hostname := ga.staticHostLabels[exportermetrics.MetricLabel_HOSTNAME.String()]
+		ga.jobAccounting.Store(jobaccounting.NewAccountant(dir, maxSummaries, hostname))
+	} else {
+		acct.Configure(dir, maxSummaries)
+	}
+	logger.Log.Printf("job accounting enabled, summaries in %v, keeping %v", dir, maxSummaries)
+
+	if !cfg.GetExportMetrics() {
+		return
+	}
+	jm := newJobMetrics(ga.GetExporterNonGPULabels())
+	for _, gauge := range jm.gauges() {
+		if err := ga.mh.RegisterMetric(gauge); err != nil {
+			logger.Log.Printf("job metric registration failed with err : %v", err)
+		}
+	}
+	ga.jm.Store(jm)
+}
+
+// GetJobSummaries returns the kept summaries of the ended jobs, filtered by
+// the job ids if any and limited to the latest limit summaries if set
+func (ga *GPUAgentClient) GetJobSummaries(ids []string, limit int) ([]*jobaccounting.Summary, error) {
+	acct := ga.jobAccounting.Load()
+	if acct == nil {
+		return nil, fmt.Errorf("job accounting not enabled")
+	}
+	return acct.Summaries(ids, limit), nil
+}
+
+// updateJobAccounting samples the GPUs for the job accounting from the poll
+// loop, so that the jobs are accounted without scrapes
+func (ga *GPUAgentClient) updateJobAccounting() {
+	if ga.jobAccounting.Load() == nil {
+		return
+	}
+	resp, _, err := ga.getGPUs()
+	if err != nil || resp == nil || resp.ApiStatus != 0 {
+		return
+	}
+	wls, err := ga.ListWorkloads()
+	if err != nil {
+		return
+	}
+	ga.accountJobs(wls, resp.Response)
+}
+
+// accountJobs accounts the GPU samples of the running batch jobs, the
+// workloads must be a successful listing as the jobs missing from it for a
+// few updates or reported ended by the scheduler are ended
+func (ga *GPUAgentClient) accountJobs(wls map[string][]scheduler.Workload, gpus []*amdgpu.GPU) {
+	acct := ga.jobAccounting.Load()
+	if acct == nil {
+		return
+	}
+	now := time.Now()
+	jobs := map[string]*jobaccounting.RunningJob{}
+	order := []string{}
+	for _, gpu := range gpus {
+		if gpu == nil || gpu.Status == nil {
+			continue
+		}
+		// the jobs of a GPU without stats are still running, only the
+		// sample is skipped
+		var sample *jobaccounting.Sample
+		if gpu.Stats != nil {
+			s := newJobSample(gpu, now)
+			sample = &s
+		}
+		for _, wl := range ga.getWorkloadInfo(wls, gpu) {
+			if wl.Type != scheduler.Slurm && wl.Type != scheduler.PBS {
+				continue
+			}
+			info, ok := wl.Info.(scheduler.JobInfo)
+			if !ok || info.Id == "" {
+				continue
+			}
+			rj, ok := jobs[info.Id]
+			if !ok {
+				rj = &jobaccounting.RunningJob{
+					Job: jobaccounting.Job{
+						ID:          info.Id,
+						User:        info.User,
+						Partition:   info.Partition,
+						Cluster:     info.Cluster,
+						ArrayJobID:  info.ArrayJobId,
+						ArrayTaskID: info.ArrayTaskId,
+					},
+				}
+				jobs[info.Id] = rj
+				order = append(order, info.Id)
+			}
+			// several steps of a job may share the gpu
+			if sample == nil || len(rj.Samples) > 0 && rj.Samples[len(rj.Samples)-1].GPU == sample.GPU {
+				continue
+			}
+			rj.Samples = append(rj.Samples, *sample)
+		}
+	}
+	running := []jobaccounting.RunningJob{}
+	for _, id := range order {
+		running = append(running, *jobs[id])
+	}
+	var endedIDs []string
+	if reporter, ok := ga.batchScheduler.(scheduler.JobEndReporter); ok {
+		endedIDs = reporter.EndedJobs()
+	}
+	for _, summary := range acct.Update(running, endedIDs) {
+		logger.Log.Printf("job %v ended, gpus %v, gpu hours %.3f, energy %.1fJ",
+			summary.JobID, summary.GPUs, summary.GPUHours, summary.EnergyConsumed)
+	}
+}
+
+func newJobSample(gpu *amdgpu.GPU, now time.Time) jobaccounting.Sample {
+	stats := gpu.Stats
+	sample := jobaccounting.Sample{
+		GPU:  fmt.Sprintf("%v", getGPUInstanceID(gpu)),
+		Time: now,
+	}
+	if utils.IsValueApplicable(stats.EnergyConsumed) {
+		sample.Energy = stats.EnergyConsumed
+		sample.HasEnergy = true
+	}
+	if stats.VRAMUsage != nil && utils.IsValueApplicable(stats.VRAMUsage.UsedVRAM) {
+		sample.UsedVRAM = float64(stats.VRAMUsage.UsedVRAM)
+		sample.HasVRAM = true
+	}
+	if stats.Usage != nil && utils.IsValueApplicable(stats.Usage.GFXActivity) {
+		sample.GFXActivity = float64(stats.Usage.GFXActivity)
+		if utils.IsValueApplicable(stats.Usage.UMCActivity) {
+			sample.UMCActivity = float64(stats.Usage.UMCActivity)
+		}
+		sample.HasActivity = true
+	}
+	if utils.IsValueApplicable(stats.TotalCorrectableErrors) && utils.IsValueApplicable(stats.TotalUncorrectableErrors) {
+		sample.CorrectableErrors = stats.TotalCorrectableErrors
+		sample.UncorrectableErrors = stats.TotalUncorrectableErrors
+		sample.HasECC = true
+	}
+	vs := stats.ViolationStats
+	if vs != nil && utils.IsValueApplicable(vs.CurrentAccumulatedCounter) {
+		sample.ViolationCounter = vs.CurrentAccumulatedCounter
+		sample.Residency = map[string]uint64{}
+		for reason, value := range map[string]uint64{
+			throttleProcessorHot:  vs.ProcessorHotResidencyAccumulated,
+			throttlePPT:           vs.PPTResidencyAccumulated,
+			throttleSocketThermal: vs.SocketThermalResidencyAccumulated,
+			throttleVRThermal:     vs.VRThermalResidencyAccumulated,
+			throttleHBMThermal:    vs.HBMThermalResidencyAccumulated,
+		} {
+			if utils.IsValueApplicable(value) {
+				sample.Residency[reason] = value
+			}
+		}
+	}
+	return sample
+}
+
+// updateJobMetrics exports the kept job summaries
+func (ga *GPUAgentClient) updateJobMetrics() {
+	jm := ga.jm.Load()
+	acct := ga.jobAccounting.Load()
+	if jm == nil || acct == nil {
+		return
+	}
+	for _, gauge := range jm.gauges() {
+		gauge.Reset()
+	}
+	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
+	for _, s := range acct.Summaries(nil, 0) {
+		labels := map[string]string{}
+		for k, v := range nonGpuLabels {
+			labels[k] = v
+		}
+		for k, v := range map[string]string{
+			strings.ToLower(exportermetrics.MetricLabel_JOB_ID.String()):        s.JobID,
+			strings.ToLower(exportermetrics.MetricLabel_JOB_USER.String()):      s.User,
+			strings.ToLower(exportermetrics.MetricLabel_JOB_PARTITION.String()): s.Partition,
+			strings.ToLower(exportermetrics.MetricLabel_CLUSTER_NAME.String()):  s.Cluster,
+		} {
+			// the custom cluster name is kept for the jobs without one
+			if v != "" || labels[k] == "" {
+				labels[k] = v
+			}
+		}
+		jm.gpuHours.With(labels).Set(s.GPUHours)
+		jm.durationSeconds.With(labels).Set(s.DurationSeconds)
+		jm.energyConsumed.With(labels).Set(s.EnergyConsumed)
+		jm.peakVRAMUsed.With(labels).Set(s.PeakVRAMUsed)
+		jm.averageVRAMUsed.With(labels).Set(s.AverageVRAMUsed)
+		jm.averageGFXActivity.With(labels).Set(s.AverageGFXActivity)
+		jm.averageUMCActivity.With(labels).Set(s.AverageUMCActivity)
+		jm.correctableErrors.With(labels).Set(float64(s.CorrectableErrors))
+		jm.uncorrectableErrors.With(labels).Set(float64(s.UncorrectableErrors))
+		for reason, value := range s.ThrottleResidency {
+			labels["reason"] = reason
+			jm.throttleResidency.With(labels).Set(value)
+		}
+	}
+}
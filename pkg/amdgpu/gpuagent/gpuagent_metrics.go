@@ -18,6 +18,7 @@ package gpuagent
 
 import (
 	"fmt"
+	"maps"
 	"math"
 	"sort"
 	"strings"
@@ -70,6 +71,22 @@ var (
 	customLabelMap    map[string]string
 	extraPodLabelsMap map[string]string
 	k8PodLabelsMap    map[string]map[string]string
+	workloadLabelMode string
+	// pod annotations and node labels mapped to prometheus labels
+	extraPodAnnotationsMap map[string]string
+	k8PodAnnotationsMap    map[string]map[string]string
+	nodeLabelsMap          map[string]string
+	k8NodeLabels           map[string]string
+	// registered workload labels mapped to prometheus labels
+	registeredLabelsMap map[string]string
+)
+
+const (
+	// workloadLabelModePair exports the metrics once per GPU and workload
+	workloadLabelModePair = "pair"
+	// workloadLabelModeJoin exports the metrics once with the workload
+	// label values comma separated
+	workloadLabelModeJoin = "join"
 )
 
 const (
@@ -199,6 +216,10 @@ type metrics struct {
 	gpuPcieTx             prometheus.GaugeVec
 	gpuPcieBidirBandwidth prometheus.GaugeVec
 
+	gpuFanSpeed prometheus.GaugeVec
+
+	gpuDataAge prometheus.GaugeVec
+
 	// profiler metrics
 	gpuGrbmGuiActivity               prometheus.GaugeVec
 	gpuSqWaves                       prometheus.GaugeVec
@@ -267,6 +288,10 @@ func (ga *GPUAgentClient) GetExporterNonGPULabels() []string {
 	labelList := []string{
 		strings.ToLower(exportermetrics.MetricLabel_HOSTNAME.String()),
 	}
+	// Add node labels
+	for label := range nodeLabelsMap {
+		labelList = append(labelList, label)
+	}
 	// Add custom labels
 	for label, _ := range customLabelMap {
 		labelList = append(labelList, strings.ToLower(label))
@@ -283,16 +308,19 @@ func (ga *GPUAgentClient) GetExportLabels() []string {
 		labelList = append(labelList, strings.ToLower(key))
 	}
 
-	for key := range extraPodLabelsMap {
-		exists := false
-		for _, label := range labelList {
-			if key == label {
-				exists = true
-				break
+	// kubernetes pod labels, pod annotations, node labels and registered workload labels
+	for _, k8sLabelsMap := range []map[string]string{extraPodLabelsMap, extraPodAnnotationsMap, nodeLabelsMap, registeredLabelsMap} {
+		for key := range k8sLabelsMap {
+			exists := false
+			for _, label := range labelList {
+				if key == label {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				labelList = append(labelList, key)
 			}
-		}
-		if !exists {
-			labelList = append(labelList, key)
 		}
 	}
 
@@ -331,6 +359,11 @@ func (ga *GPUAgentClient) initLabelConfigs(config *exportermetrics.GPUMetricConf
 		exportLabels[name] = true
 	}
 
+	// the metrics read from sysfs are told apart by their source
+	if config.GetSysfsFallback().GetEnable() {
+		exportLabels[exportermetrics.GPUMetricLabel_SOURCE.String()] = true
+	}
+
 	if config != nil {
 		for _, name := range config.GetLabels() {
 			name = strings.ToUpper(name)
@@ -369,6 +402,61 @@ func initPodExtraLabels(config *exportermetrics.GPUMetricConfig) {
 	logger.Log.Printf("export-labels updated to %v", extraPodLabelsMap)
 }
 
+// reservedMetadataLabels returns the label names the pod annotation and node
+// label mappings cannot use, the exporter labels, the custom labels and the
+// given mappings
+func reservedMetadataLabels(mappings ...map[string]string) map[string]bool {
+	reserved := map[string]bool{}
+	for _, name := range exportermetrics.MetricLabel_name {
+		reserved[strings.ToLower(name)] = true
+	}
+	for _, name := range exportermetrics.GPUMetricLabel_name {
+		reserved[strings.ToLower(name)] = true
+	}
+	for _, mapping := range append(mappings, customLabelMap) {
+		for label := range mapping {
+			reserved[label] = true
+		}
+	}
+	return reserved
+}
+
+func initPodExtraAnnotations(config *exportermetrics.GPUMetricConfig) {
+	// initialize pod annotations maps
+	k8PodAnnotationsMap = make(map[string]map[string]string)
+	extraPodAnnotationsMap = utils.FilterLabelMapping(
+		utils.NormalizeLabelMapping(config.GetExtraPodAnnotations(), globals.MaxSupportedPodAnnotations, "pod annotations"),
+		reservedMetadataLabels(extraPodLabelsMap), "pod annotations")
+	logger.Log.Printf("export-annotations updated to %v", extraPodAnnotationsMap)
+}
+
+func initNodeLabels(config *exportermetrics.GPUMetricConfig) {
+	k8NodeLabels = make(map[string]string)
+	nodeLabelsMap = utils.FilterLabelMapping(
+		utils.NormalizeLabelMapping(config.GetNodeLabels(), globals.MaxSupportedNodeLabels, "node labels"),
+		reservedMetadataLabels(extraPodLabelsMap, extraPodAnnotationsMap), "node labels")
+	logger.Log.Printf("export-node-labels updated to %v", nodeLabelsMap)
+}
+
+func initRegisteredLabels(config *exportermetrics.GPUMetricConfig) {
+	registeredLabelsMap = utils.NormalizeLabelMapping(config.GetWorkloadLabels(),
+		globals.MaxSupportedWorkloadLabels, "workload labels")
+	logger.Log.Printf("export-workload-labels updated to %v", registeredLabelsMap)
+}
+
+func initWorkloadLabelMode(config *exportermetrics.GPUMetricConfig) {
+	workloadLabelMode = workloadLabelModePair
+	mode := strings.ToLower(config.GetWorkloadLabelMode())
+	switch mode {
+	case "", workloadLabelModePair:
+	case workloadLabelModeJoin:
+		workloadLabelMode = mode
+	default:
+		logger.Log.Printf("invalid workload label mode %v, using %v", mode, workloadLabelMode)
+	}
+	logger.Log.Printf("workload label mode set to %v", workloadLabelMode)
+}
+
 func initCustomLabels(config *exportermetrics.GPUMetricConfig) {
 	customLabelMap = make(map[string]string)
 	disallowedLabels := []string{}
@@ -566,6 +654,8 @@ func (ga *GPUAgentClient) initFieldMetricsMap() {
 		exportermetrics.GPUMetricField_PCIE_RX.String():                                            FieldMeta{Metric: ga.m.gpuPcieRx},
 		exportermetrics.GPUMetricField_PCIE_TX.String():                                            FieldMeta{Metric: ga.m.gpuPcieTx},
 		exportermetrics.GPUMetricField_PCIE_BIDIRECTIONAL_BANDWIDTH.String():                       FieldMeta{Metric: ga.m.gpuPcieBidirBandwidth},
+		exportermetrics.GPUMetricField_GPU_FAN_SPEED.String():                                      FieldMeta{Metric: ga.m.gpuFanSpeed},
+		exportermetrics.GPUMetricField_GPU_DATA_AGE.String():                                       FieldMeta{Metric: ga.m.gpuDataAge},
 		// profiler entries
 		exportermetrics.GPUMetricField_GPU_PROF_GRBM_GUI_ACTIVE.String():                    FieldMeta{Metric: ga.m.gpuGrbmGuiActivity, Alias: "GRBM_GUI_ACTIVE"},
 		exportermetrics.GPUMetricField_GPU_PROF_SQ_WAVES.String():                           FieldMeta{Metric: ga.m.gpuSqWaves, Alias: "SQ_WAVES"},
@@ -1438,6 +1528,16 @@ func (ga *GPUAgentClient) initPrometheusMetrics() {
 			Help: "Accumulated bandwidth on PCIe link in GB/sec",
 		},
 			labels),
+		gpuFanSpeed: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_fan_speed",
+			Help: "Current fan speed in RPM",
+		},
+			labels),
+		gpuDataAge: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_data_age_seconds",
+			Help: "Age of the exported data per field group in seconds",
+		},
+			append([]string{labelFieldGroup}, nonGpuLabels...)),
 	}
 	ga.initFieldMetricsMap()
 
@@ -1464,13 +1564,23 @@ func (ga *GPUAgentClient) initFieldRegistration() error {
 func (ga *GPUAgentClient) InitConfigs() error {
 	filedConfigs := ga.mh.GetMetricsConfig()
 
-	initPodExtraLabels(filedConfigs)
 	initCustomLabels(filedConfigs)
+	initPodExtraLabels(filedConfigs)
+	initPodExtraAnnotations(filedConfigs)
+	initNodeLabels(filedConfigs)
+	initRegisteredLabels(filedConfigs)
+	initWorkloadLabelMode(filedConfigs)
 	ga.initLabelConfigs(filedConfigs)
 	initFieldConfig(filedConfigs)
 	ga.initProfilerMetrics(filedConfigs)
 	initGPUSelectorConfig(filedConfigs)
 	ga.initPrometheusMetrics()
+	ga.initHealthHistory(filedConfigs)
+	ga.initJobAccounting(filedConfigs)
+	ga.initProcessMetrics(filedConfigs)
+	ga.initSysfsFallback(filedConfigs)
+	ga.initSampler(filedConfigs)
+	ga.initPolling(filedConfigs)
 	ga.initProfilerMetricsField()
 	return ga.initFieldRegistration()
 }
@@ -1509,6 +1619,7 @@ func (ga *GPUAgentClient) UpdateStaticMetrics() error {
 	}
 
 	k8PodLabelsMap, err = ga.FetchPodLabelsForNode()
+	ga.fetchK8sMetadata()
 	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
 	ga.m.gpuNodesTotal.With(nonGpuLabels).Set(float64(len(resp.Response)))
 	// do this only once as the health monitoring thread will
@@ -1546,7 +1657,7 @@ func (ga *GPUAgentClient) GetDeviceType() globals.DeviceType {
 // kubernetes job  - "pod:pod_name, namespace: pod_namespace,container: container_name"
 // slurm job       - "id: job_id, user: job_user, partition: job_partition", cluster: job_cluster
 
-func (ga *GPUAgentClient) getWorkloadsListString(wls map[string]scheduler.Workload, gpu *amdgpu.GPU) []string {
+func (ga *GPUAgentClient) getWorkloadsListString(wls map[string][]scheduler.Workload, gpu *amdgpu.GPU) []string {
 	associatedWorkloads := []string{}
 	if gpu == nil || gpu.Status == nil {
 		return nil
@@ -1562,7 +1673,9 @@ func (ga *GPUAgentClient) getWorkloadsListString(wls map[string]scheduler.Worklo
 	return associatedWorkloads
 }
 
-func (ga *GPUAgentClient) getWorkloadInfo(wls map[string]scheduler.Workload, gpu *amdgpu.GPU) []*scheduler.Workload {
+// getWorkloadInfo returns all the workloads using the GPU sorted by their
+// string representation, a GPU shared by time slicing has several workloads
+func (ga *GPUAgentClient) getWorkloadInfo(wls map[string][]scheduler.Workload, gpu *amdgpu.GPU) []*scheduler.Workload {
 	associatedWorkloads := []*scheduler.Workload{}
 	if gpu == nil || gpu.Status == nil {
 		return nil
@@ -1570,44 +1683,121 @@ func (ga *GPUAgentClient) getWorkloadInfo(wls map[string]scheduler.Workload, gpu
 	gpuId := fmt.Sprintf("%v", getGPUInstanceID(gpu))
 	gpuRenderId := getGPURenderId(gpu)
 	deviceName, _ := ga.fsysDeviceHandler.GetDeviceNameFromRenderID(gpuRenderId)
-	// populate with workload info
+	// populate with workload info from every device id of the gpu
+	keys := []string{}
 	if gpu.Status.PCIeStatus != nil {
-		if workload, ok := wls[strings.ToLower(gpu.Status.PCIeStatus.PCIeBusId)]; ok {
-			associatedWorkloads = append(associatedWorkloads, &workload)
-		}
-	}
-	if workload, ok := wls[deviceName]; ok {
-		associatedWorkloads = append(associatedWorkloads, &workload)
+		keys = append(keys, strings.ToLower(gpu.Status.PCIeStatus.PCIeBusId))
 	}
 	// ignore errors as we always expect slurm deployment as default
-	if workload, ok := wls[gpuRenderId]; ok {
-		associatedWorkloads = append(associatedWorkloads, &workload)
+	keys = append(keys, deviceName, gpuRenderId, gpuId)
+	if gpuRenderId != "" {
+		// slurm gpus allocated by render node
+		keys = append(keys, scheduler.SlurmRenderKeyPrefix+gpuRenderId)
 	}
 
-	if workload, ok := wls[gpuId]; ok {
-		associatedWorkloads = append(associatedWorkloads, &workload)
+	found := make(map[string][]scheduler.Workload)
+	for _, key := range keys {
+		for _, workload := range wls[key] {
+			scheduler.AddWorkload(found, gpuId, workload)
+		}
 	}
+	for i := range found[gpuId] {
+		associatedWorkloads = append(associatedWorkloads, &found[gpuId][i])
+	}
+	sort.SliceStable(associatedWorkloads, func(i, j int) bool {
+		return associatedWorkloads[i].String() < associatedWorkloads[j].String()
+	})
 	return associatedWorkloads
 }
 
+// populateLabelsFromGPU returns the labels of the GPU, the workload label
+// values of a GPU shared by several workloads are comma separated
 func (ga *GPUAgentClient) populateLabelsFromGPU(
-	wls map[string]scheduler.Workload,
+	wls map[string][]scheduler.Workload,
 	gpu *amdgpu.GPU,
 	partitionMap map[string]*amdgpu.GPU) map[string]string {
-	var podInfo scheduler.PodResourceInfo
-	var jobInfo scheduler.JobInfo
+	return joinWorkloadLabels(ga.populateWorkloadLabelsFromGPU(wls, gpu, partitionMap))
+}
 
-	if jobInfos := ga.getWorkloadInfo(wls, gpu); jobInfos != nil {
-		for _, wl := range jobInfos {
-			if wl == nil {
-				continue
+// populateWorkloadLabelsFromGPU returns the labels of the GPU for every
+// workload using it, a single set of labels is returned without workloads
+func (ga *GPUAgentClient) populateWorkloadLabelsFromGPU(
+	wls map[string][]scheduler.Workload,
+	gpu *amdgpu.GPU,
+	partitionMap map[string]*amdgpu.GPU) []map[string]string {
+	workloads := ga.getWorkloadInfo(wls, gpu)
+	if len(workloads) == 0 {
+		return []map[string]string{ga.populateLabelsFromWorkload(nil, gpu, partitionMap)}
+	}
+	labelSets := make([]map[string]string, 0, len(workloads))
+	for _, wl := range workloads {
+		labelSets = append(labelSets, ga.populateLabelsFromWorkload(wl, gpu, partitionMap))
+	}
+	return labelSets
+}
+
+// gpuMetricLabelSets returns the sets of labels the GPU metrics are
+// exported with depending on the workload label mode
+func (ga *GPUAgentClient) gpuMetricLabelSets(
+	wls map[string][]scheduler.Workload,
+	gpu *amdgpu.GPU,
+	partitionMap map[string]*amdgpu.GPU) []map[string]string {
+	labelSets := ga.populateWorkloadLabelsFromGPU(wls, gpu, partitionMap)
+	if workloadLabelMode == workloadLabelModeJoin {
+		return []map[string]string{joinWorkloadLabels(labelSets)}
+	}
+	return labelSets
+}
+
+// joinWorkloadLabels merges the label sets of the workloads sharing a GPU,
+// the distinct non empty values of a label are comma separated
+func joinWorkloadLabels(labelSets []map[string]string) map[string]string {
+	if len(labelSets) == 0 {
+		return map[string]string{}
+	}
+	if len(labelSets) == 1 {
+		return labelSets[0]
+	}
+	labels := make(map[string]string, len(labelSets[0]))
+	for key := range labelSets[0] {
+		values := []string{}
+		seen := map[string]bool{}
+		for _, set := range labelSets {
+			if v := set[key]; v != "" && !seen[v] {
+				seen[v] = true
+				values = append(values, v)
 			}
-			switch wl.Type {
-			case scheduler.Kubernetes:
-				podInfo = wl.Info.(scheduler.PodResourceInfo)
-			case scheduler.Slurm:
-				jobInfo = wl.Info.(scheduler.JobInfo)
+		}
+		labels[key] = strings.Join(values, ",")
+	}
+	return labels
+}
+
+// populateLabelsFromWorkload returns the labels of the GPU used by the
+// workload, wl is nil for a GPU without workload
+func (ga *GPUAgentClient) populateLabelsFromWorkload(
+	wl *scheduler.Workload,
+	gpu *amdgpu.GPU,
+	partitionMap map[string]*amdgpu.GPU) map[string]string {
+	var podInfo scheduler.PodResourceInfo
+	var jobInfo scheduler.JobInfo
+	var registeredLabels map[string]string
+	var containerInfo scheduler.ContainerInfo
+
+	if wl != nil {
+		switch wl.Type {
+		case scheduler.Kubernetes:
+			podInfo = wl.Info.(scheduler.PodResourceInfo)
+		case scheduler.Slurm, scheduler.PBS:
+			jobInfo = wl.Info.(scheduler.JobInfo)
+		case scheduler.External:
+			if reg, ok := wl.Info.(*scheduler.RegisteredWorkload); ok {
+				jobInfo.Id = reg.Id
+				registeredLabels = reg.Labels
 			}
+		case scheduler.Container:
+			containerInfo = wl.Info.(scheduler.ContainerInfo)
+			podInfo.Container = containerInfo.Name
 		}
 	}
 
@@ -1697,6 +1887,30 @@ func (ga *GPUAgentClient) populateLabelsFromGPU(
 			if gpu != nil {
 				labels[key] = jobInfo.Cluster
 			}
+		case exportermetrics.GPUMetricLabel_JOB_STEP_ID.String():
+			if gpu != nil {
+				labels[key] = jobInfo.StepId
+			}
+		case exportermetrics.GPUMetricLabel_JOB_ARRAY_JOB_ID.String():
+			if gpu != nil {
+				labels[key] = jobInfo.ArrayJobId
+			}
+		case exportermetrics.GPUMetricLabel_JOB_ARRAY_TASK_ID.String():
+			if gpu != nil {
+				labels[key] = jobInfo.ArrayTaskId
+			}
+		case exportermetrics.GPUMetricLabel_CONTAINER_IMAGE.String():
+			if gpu != nil {
+				labels[key] = containerInfo.Image
+			}
+		case exportermetrics.GPUMetricLabel_COMPOSE_PROJECT.String():
+			if gpu != nil {
+				labels[key] = containerInfo.ComposeProject
+			}
+		case exportermetrics.GPUMetricLabel_SOURCE.String():
+			if gpu != nil {
+				labels[key] = metricSourceGPUAgent
+			}
 		case exportermetrics.MetricLabel_SERIAL_NUMBER.String():
 			if gpu != nil {
 				if parentPartition != nil {
@@ -1768,6 +1982,17 @@ func (ga *GPUAgentClient) populateLabelsFromGPU(
 		}
 	}
 
+	// Add extra pod annotations only if config has mapped any
+	if gpu != nil && len(extraPodAnnotationsMap) > 0 {
+		utils.MapLabels(labels, extraPodAnnotationsMap, utils.GetPodAnnotations(&podInfo, k8PodAnnotationsMap))
+	}
+
+	// Add node labels
+	utils.MapLabels(labels, nodeLabelsMap, k8NodeLabels)
+
+	// Add registered workload labels, empty without a registered workload
+	utils.MapLabels(labels, registeredLabelsMap, registeredLabels)
+
 	// Add custom labels
 	for label, value := range customLabelMap {
 		labels[label] = value
@@ -1785,7 +2010,7 @@ func (ga *GPUAgentClient) exporterEnabledGPU(instance int) bool {
 }
 
 func (ga *GPUAgentClient) updateGPUInfoToMetrics(
-	wls map[string]scheduler.Workload,
+	wls map[string][]scheduler.Workload,
 	gpu *amdgpu.GPU,
 	partitionMap map[string]*amdgpu.GPU,
 	profMetrics map[string]float64,
@@ -1794,8 +2019,20 @@ func (ga *GPUAgentClient) updateGPUInfoToMetrics(
 		return
 	}
 
-	labels := ga.populateLabelsFromGPU(wls, gpu, partitionMap)
-	labelsWithIndex := ga.populateLabelsFromGPU(wls, gpu, partitionMap)
+	// a GPU shared by several workloads is exported once per workload
+	// unless the workload labels are joined
+	for _, labels := range ga.gpuMetricLabelSets(wls, gpu, partitionMap) {
+		ga.exportGPUMetrics(gpu, labels, profMetrics)
+	}
+}
+
+// exportGPUMetrics updates the metrics of the GPU with the labels
+func (ga *GPUAgentClient) exportGPUMetrics(
+	gpu *amdgpu.GPU,
+	labels map[string]string,
+	profMetrics map[string]float64,
+) {
+	labelsWithIndex := maps.Clone(labels)
 	status := gpu.Status
 	stats := gpu.Stats
 
@@ -1937,6 +2174,8 @@ func (ga *GPUAgentClient) updateGPUInfoToMetrics(
 
 	ga.fl.logWithValidateAndExport(ga.m.gpuPowerUsage, exportermetrics.GPUMetricField_GPU_POWER_USAGE.String(), labels, stats.PowerUsage)
 
+	ga.fl.logWithValidateAndExport(ga.m.gpuFanSpeed, exportermetrics.GPUMetricField_GPU_FAN_SPEED.String(), labels, stats.FanSpeed)
+
 	ga.fl.logWithValidateAndExport(ga.m.gpuEccCorrectTotal, exportermetrics.GPUMetricField_GPU_ECC_CORRECT_TOTAL.String(),
 		labels, stats.TotalCorrectableErrors)
 	ga.fl.logWithValidateAndExport(ga.m.gpuEccUncorrectTotal, exportermetrics.GPUMetricField_GPU_ECC_UNCORRECT_TOTAL.String(),
@@ -0,0 +1,107 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"maps"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/rocprofiler"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// data age of the gpuagent response and of the rocprofiler metrics
+const (
+	labelFieldGroup   = "field_group"
+	fieldGroupDefault = "default"
+	fieldGroupProfile = "profiler"
+)
+
+// pollingSettings are the polling intervals, cache and timeouts of the
+// gpuagent queries, replaced on config reload
+type pollingSettings struct {
+	refreshInterval time.Duration
+	queryTimeout    time.Duration
+	cacheTTL        time.Duration
+}
+
+func defaultPollingSettings() *pollingSettings {
+	return &pollingSettings{
+		refreshInterval: defaultRefreshInterval,
+		queryTimeout:    defaultQueryTimeout,
+		cacheTTL:        defaultCacheTTL,
+	}
+}
+
+func durationMs(ms uint32, defaultValue time.Duration) time.Duration {
+	if ms == 0 {
+		return defaultValue
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// initPolling applies the polling config, the refresh of the monitor is
+// reset when its interval changed
+func (ga *GPUAgentClient) initPolling(config *exportermetrics.GPUMetricConfig) {
+	cfg := config.GetPolling()
+	settings := &pollingSettings{
+		refreshInterval: durationMs(cfg.GetRefreshIntervalMs(), defaultRefreshInterval),
+		queryTimeout:    durationMs(cfg.GetQueryTimeoutMs(), defaultQueryTimeout),
+		cacheTTL:        durationMs(cfg.GetCacheTTLMs(), defaultCacheTTL),
+	}
+	ga.rocpclient.SetPolling(durationMs(cfg.GetProfilerCacheTTLMs(), rocprofiler.DefaultCacheTTL),
+		durationMs(cfg.GetProfilerTimeoutMs(), rocprofiler.DefaultTimeout))
+
+	previous := ga.polling.Swap(settings)
+	if previous.refreshInterval != settings.refreshInterval {
+		// drop a pending reset not yet applied by the monitor
+		select {
+		case <-ga.refreshReset:
+		default:
+		}
+		ga.refreshReset <- settings.refreshInterval
+	}
+	logger.Log.Printf("gpuagent polling refresh %v, query timeout %v, cache %v",
+		settings.refreshInterval, settings.queryTimeout, settings.cacheTTL)
+}
+
+// dataAges returns the age of the gpuagent response and of the profiler
+// metrics keyed by their field group
+func (ga *GPUAgentClient) dataAges(now time.Time) map[string]time.Duration {
+	ages := map[string]time.Duration{}
+	ga.gCache.RLock()
+	if !ga.gCache.lastTimestamp.IsZero() {
+		ages[fieldGroupDefault] = now.Sub(ga.gCache.lastTimestamp)
+	}
+	ga.gCache.RUnlock()
+	if ga.isProfilerEnabled() {
+		if ts := ga.rocpclient.CacheTimestamp(); !ts.IsZero() {
+			ages[fieldGroupProfile] = now.Sub(ts)
+		}
+	}
+	return ages
+}
+
+// updateDataAgeMetrics exports the age of the data per field group
+func (ga *GPUAgentClient) updateDataAgeMetrics(nonGpuLabels map[string]string) {
+	for group, age := range ga.dataAges(time.Now()) {
+		labels := maps.Clone(nonGpuLabels)
+		labels[labelFieldGroup] = group
+		ga.m.gpuDataAge.With(labels).Set(age.Seconds())
+	}
+}
@@ -0,0 +1,310 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/kfdprocess"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// labels of the process metrics
+const (
+	labelPid         = "pid"
+	labelProcessName = "process_name"
+	labelContainerId = "container_id"
+	// containerIdLength is the length of the exported container ids
+	containerIdLength = 12
+)
+
+// process metrics, exported when enabled in the process metrics config
+type processMetrics struct {
+	usedVRAM     prometheus.GaugeVec
+	sdmaUsage    prometheus.GaugeVec
+	cuOccupancy  prometheus.GaugeVec
+	processTotal prometheus.GaugeVec
+	topN         int
+
+	// accumulated SDMA usage of every process and GPU at the last update,
+	// the SDMA usage is exported as the rate between two updates
+	sync.Mutex
+	sdmaLast map[string]sdmaSample
+}
+
+// sdmaSample is the accumulated SDMA usage read at a point in time
+type sdmaSample struct {
+	usage uint64
+	time  time.Time
+}
+
+func (pm *processMetrics) gauges() []*prometheus.GaugeVec {
+	return []*prometheus.GaugeVec{
+		&pm.usedVRAM, &pm.sdmaUsage, &pm.cuOccupancy, &pm.processTotal,
+	}
+}
+
+// processWorkloadLabels are the workload labels of the process metrics
+func processWorkloadLabels() []string {
+	return []string{
+		strings.ToLower(exportermetrics.MetricLabel_POD.String()),
+		strings.ToLower(exportermetrics.MetricLabel_NAMESPACE.String()),
+		strings.ToLower(exportermetrics.MetricLabel_CONTAINER.String()),
+		strings.ToLower(exportermetrics.MetricLabel_JOB_ID.String()),
+	}
+}
+
+func newProcessMetrics(nonGpuLabels []string, topN int) *processMetrics {
+	gpuLabels := []string{strings.ToLower(exportermetrics.GPUMetricLabel_GPU_ID.String())}
+	for _, label := range nonGpuLabels {
+		if !slices.Contains(gpuLabels, label) {
+			gpuLabels = append(gpuLabels, label)
+		}
+	}
+	labels := slices.Clone(gpuLabels)
+	for _, label := range append([]string{labelPid, labelProcessName, labelContainerId}, processWorkloadLabels()...) {
+		// workload labels may also be custom labels
+		if !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	gauge := func(name, help string, labels []string) prometheus.GaugeVec {
+		return *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: name,
+			Help: help,
+		}, labels)
+	}
+	return &processMetrics{
+		usedVRAM:     gauge("gpu_process_used_vram", "VRAM used by the process on the GPU in MB", labels),
+		sdmaUsage:    gauge("gpu_process_sdma_usage", "SDMA usage of the process on the GPU in percent of the time since the previous update", labels),
+		cuOccupancy:  gauge("gpu_process_cu_occupancy", "Number of compute units of the GPU occupied by the process", labels),
+		processTotal: gauge("gpu_process_total", "Number of processes using the GPU", gpuLabels),
+		topN:         topN,
+		sdmaLast:     map[string]sdmaSample{},
+	}
+}
+
+// initProcessMetrics registers the process metrics when enabled in the
+// config
+func (ga *GPUAgentClient) initProcessMetrics(config *exportermetrics.GPUMetricConfig) {
+	cfg := config.GetProcessMetrics()
+	ga.pm.Store(nil)
+	if !cfg.GetEnable() {
+		return
+	}
+	topN := int(cfg.GetTopN())
+	if topN == 0 {
+		topN = globals.KFDProcessTopN
+	}
+	if ga.kfdReader == nil {
+		ga.kfdReader = kfdprocess.NewReader(kfdprocess.WithSysRoot(ga.sysRoot),
+			kfdprocess.WithProcRoot(ga.procRoot))
+	}
+	pm := newProcessMetrics(ga.GetExporterNonGPULabels(), topN)
+	for _, gauge := range pm.gauges() {
+		if err := ga.mh.RegisterMetric(gauge); err != nil {
+			logger.Log.Printf("process metric registration failed with err : %v", err)
+		}
+	}
+	ga.pm.Store(pm)
+	logger.Log.Printf("process metrics enabled, exporting the top %v processes per GPU", topN)
+}
+
+// gpuProcess is the usage of a GPU by a process
+type gpuProcess struct {
+	process *kfdprocess.Process
+	usage   kfdprocess.Usage
+}
+
+// updateProcessMetrics exports the processes using the most VRAM on every
+// GPU, the GPUs are matched by their KFD gpu id
+func (ga *GPUAgentClient) updateProcessMetrics(wls map[string][]scheduler.Workload, gpus []*amdgpu.GPU) {
+	pm := ga.pm.Load()
+	if pm == nil {
+		return
+	}
+	pm.Lock()
+	defer pm.Unlock()
+	for _, gauge := range pm.gauges() {
+		gauge.Reset()
+	}
+	processes, err := ga.kfdReader.Processes()
+	if err != nil {
+		logger.Log.Printf("kfd processes read failed: %v", err)
+		return
+	}
+	now := time.Now()
+	sdmaRates := pm.updateSDMA(processes, now)
+	gpuProcesses := map[string][]gpuProcess{}
+	for _, p := range processes {
+		for _, u := range p.GPUs {
+			gpuProcesses[u.GPUID] = append(gpuProcesses[u.GPUID], gpuProcess{process: p, usage: u})
+		}
+	}
+	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
+	for _, gpu := range gpus {
+		if gpu == nil || gpu.Status == nil || !ga.exporterEnabledGPU(getGPUInstanceID(gpu)) {
+			continue
+		}
+		gpuLabels := map[string]string{
+			strings.ToLower(exportermetrics.GPUMetricLabel_GPU_ID.String()): fmt.Sprintf("%v", getGPUInstanceID(gpu)),
+		}
+		for k, v := range nonGpuLabels {
+			gpuLabels[k] = v
+		}
+		list := gpuProcesses[fmt.Sprintf("%v", gpu.Status.KFDId)]
+		pm.processTotal.With(gpuLabels).Set(float64(len(list)))
+		sort.SliceStable(list, func(i, j int) bool {
+			if list[i].usage.VRAM != list[j].usage.VRAM {
+				return list[i].usage.VRAM > list[j].usage.VRAM
+			}
+			return list[i].process.Pid < list[j].process.Pid
+		})
+		if len(list) > pm.topN {
+			list = list[:pm.topN]
+		}
+		workloads := ga.getWorkloadInfo(wls, gpu)
+		for _, gp := range list {
+			labels := ga.processLabels(gpuLabels, gp.process, workloads)
+			pm.usedVRAM.With(labels).Set(float64(gp.usage.VRAM) / (1024 * 1024))
+			if rate, ok := sdmaRates[sdmaKey(gp.process.Pid, gp.usage.GPUID)]; ok {
+				pm.sdmaUsage.With(labels).Set(rate)
+			}
+			if gp.usage.HasCUOccupancy {
+				pm.cuOccupancy.With(labels).Set(float64(gp.usage.CUOccupancy))
+			}
+		}
+	}
+}
+
+func sdmaKey(pid int, gpuID string) string {
+	return fmt.Sprintf("%v/%v", pid, gpuID)
+}
+
+// updateSDMA stores the accumulated SDMA usage of the processes and returns
+// the usage since the previous update in percent of the elapsed time, the
+// processes seen for the first time or whose usage decreased, a reused
+// pid, have no rate
+func (pm *processMetrics) updateSDMA(processes []*kfdprocess.Process, now time.Time) map[string]float64 {
+	rates := map[string]float64{}
+	current := make(map[string]sdmaSample, len(pm.sdmaLast))
+	for _, p := range processes {
+		for _, u := range p.GPUs {
+			key := sdmaKey(p.Pid, u.GPUID)
+			current[key] = sdmaSample{usage: u.SDMA, time: now}
+			prev, ok := pm.sdmaLast[key]
+			if !ok || u.SDMA < prev.usage || !now.After(prev.time) {
+				continue
+			}
+			elapsed := float64(now.Sub(prev.time)) / float64(time.Microsecond)
+			rates[key] = float64(u.SDMA-prev.usage) / elapsed * 100
+		}
+	}
+	pm.sdmaLast = current
+	return rates
+}
+
+// processLabels returns the labels of a process using the GPU, the
+// workload labels are set from the workload of the GPU the process cgroup
+// belongs to and left empty when the process matches none of them
+func (ga *GPUAgentClient) processLabels(gpuLabels map[string]string, p *kfdprocess.Process,
+	workloads []*scheduler.Workload) map[string]string {
+	labels := make(map[string]string, len(gpuLabels)+7)
+	for _, label := range processWorkloadLabels() {
+		labels[label] = ""
+	}
+	for k, v := range gpuLabels {
+		labels[k] = v
+	}
+	labels[labelPid] = fmt.Sprintf("%v", p.Pid)
+	labels[labelProcessName] = p.Command
+	labels[labelContainerId] = p.ContainerId
+	if len(p.ContainerId) > containerIdLength {
+		labels[labelContainerId] = p.ContainerId[:containerIdLength]
+	}
+
+	containerLabel := strings.ToLower(exportermetrics.MetricLabel_CONTAINER.String())
+	for _, wl := range workloads {
+		switch info := wl.Info.(type) {
+		case scheduler.ContainerInfo:
+			if p.ContainerId != "" && info.Id == p.ContainerId {
+				labels[containerLabel] = info.Name
+				return labels
+			}
+		case scheduler.PodResourceInfo:
+			if container, ok := ga.podProcess(info, p); ok {
+				labels[strings.ToLower(exportermetrics.MetricLabel_POD.String())] = info.Pod
+				labels[strings.ToLower(exportermetrics.MetricLabel_NAMESPACE.String())] = info.Namespace
+				labels[containerLabel] = container
+				return labels
+			}
+		case scheduler.JobInfo:
+			if jobProcess(info.Id, p) {
+				labels[strings.ToLower(exportermetrics.MetricLabel_JOB_ID.String())] = info.Id
+				return labels
+			}
+		}
+	}
+	return labels
+}
+
+// podProcess returns the container of the process and true when the
+// process runs in the pod, matched by the container ids of the pod or by
+// the pod uid in the kubepods cgroup path
+func (ga *GPUAgentClient) podProcess(info scheduler.PodResourceInfo, p *kfdprocess.Process) (string, bool) {
+	if ga.k8sApiClient == nil || p.Cgroup == "" {
+		return "", false
+	}
+	pod, err := ga.k8sApiClient.GetPod(info.Namespace, info.Pod)
+	if err != nil {
+		logger.Log.Printf("pod %v/%v lookup failed: %v", info.Namespace, info.Pod, err)
+		return "", false
+	}
+	if p.ContainerId != "" {
+		for _, status := range pod.Status.ContainerStatuses {
+			// container ids are reported as <runtime>://<id>
+			if _, id, _ := strings.Cut(status.ContainerID, "://"); id == p.ContainerId {
+				return status.Name, true
+			}
+		}
+	}
+	if pod.UID == "" || !strings.Contains(p.Cgroup, "kubepods") {
+		return "", false
+	}
+	// the systemd cgroup driver replaces the dashes of the uid
+	cgroup := strings.ReplaceAll(p.Cgroup, "_", "-")
+	return "", strings.Contains(cgroup, "pod"+string(pod.UID))
+}
+
+// jobProcess returns true when the process runs in the job_<id> cgroup of
+// the job
+func jobProcess(id string, p *kfdprocess.Process) bool {
+	if id == "" {
+		return false
+	}
+	return slices.Contains(strings.Split(p.Cgroup, "/"), "job_"+id)
+}
@@ -0,0 +1,235 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	k8sclient "github.com/ROCm/device-metrics-exporter/pkg/client"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+const (
+	remediationActionTaint  = "taint"
+	remediationActionCordon = "cordon"
+
+	defaultRemediationTaintKey = "amd.com/gpu-unhealthy"
+	defaultRemediationInterval = 300 * time.Second
+
+	// set on the node cordoned or tainted by the exporter, a node cordoned
+	// or tainted by someone else is never uncordoned or untainted
+	remediationCordonAnnotation = "amd.com/gpu-remediation-cordon"
+	remediationTaintAnnotation  = "amd.com/gpu-remediation-taint"
+)
+
+// nodeRemediator is the kubernetes node access used by the remediation policy
+type nodeRemediator interface {
+	GetNode() (*v1.Node, error)
+	AddNodeTaint(nodeName string, taint v1.Taint, ownerAnnotation string) (bool, error)
+	RemoveNodeTaint(nodeName string, taint v1.Taint, ownerAnnotation string) (bool, error)
+	SetNodeUnschedulable(nodeName string, unschedulable bool, ownerAnnotation string) (bool, error)
+	CreateEvent(evtObj *v1.Event) error
+}
+
+// remediationState tracks the policy actions across the health cycles
+type remediationState struct {
+	lastAction time.Time
+	// node state in dry run mode as the node is not modified
+	dryRunApplied bool
+}
+
+func (ga *GPUAgentClient) getRemediationConfig() *exportermetrics.GPURemediationConfig {
+	rConfig := ga.mh.GetRunConfig()
+	if rConfig == nil || rConfig.GetConfig() == nil ||
+		rConfig.GetConfig().GetGPUConfig().GetRemediation() == nil {
+		// disabled by default
+		return &exportermetrics.GPURemediationConfig{}
+	}
+	return rConfig.GetConfig().GetGPUConfig().GetRemediation()
+}
+
+// remediationAction returns the configured action, empty on invalid action
+func remediationAction(cfg *exportermetrics.GPURemediationConfig) string {
+	switch strings.ToLower(cfg.GetAction()) {
+	case "", remediationActionTaint:
+		return remediationActionTaint
+	case remediationActionCordon:
+		return remediationActionCordon
+	}
+	return ""
+}
+
+// remediationTaint returns the taint applied to the node
+func remediationTaint(cfg *exportermetrics.GPURemediationConfig) (v1.Taint, error) {
+	taint := v1.Taint{
+		Key:    defaultRemediationTaintKey,
+		Value:  "true",
+		Effect: v1.TaintEffectNoSchedule,
+	}
+	if cfg.GetTaintKey() != "" {
+		taint.Key = cfg.GetTaintKey()
+	}
+	switch v1.TaintEffect(cfg.GetTaintEffect()) {
+	case "":
+	case v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute:
+		taint.Effect = v1.TaintEffect(cfg.GetTaintEffect())
+	default:
+		return taint, fmt.Errorf("invalid taint effect %v", cfg.GetTaintEffect())
+	}
+	return taint, nil
+}
+
+// remediationNodeState returns if the node carries the policy action and if
+// the action was applied by the exporter
+func remediationNodeState(node *v1.Node, cfg *exportermetrics.GPURemediationConfig) (applied, owned bool) {
+	if remediationAction(cfg) == remediationActionCordon {
+		_, owned = node.Annotations[remediationCordonAnnotation]
+		return node.Spec.Unschedulable, owned
+	}
+	_, owned = node.Annotations[remediationTaintAnnotation]
+	taint, _ := remediationTaint(cfg)
+	for _, t := range node.Spec.Taints {
+		if t.MatchTaint(&taint) && t.Value == taint.Value {
+			return true, owned
+		}
+	}
+	return false, owned
+}
+
+// unhealthyGPUCount returns the number of GPUs reported unhealthy,
+// degraded GPUs are not counted
+func (ga *GPUAgentClient) unhealthyGPUCount() int {
+	ga.Lock()
+	defer ga.Unlock()
+	count := 0
+	for _, hs := range ga.healthState {
+		if hs.Health == strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String()) {
+			count++
+		}
+	}
+	return count
+}
+
+// applyRemediationPolicy taints or cordons the node when the number of
+// unhealthy GPUs reaches the threshold and reverts it once all the GPUs
+// are healthy, actions are rate limited and reported as node events
+func (ga *GPUAgentClient) applyRemediationPolicy() error {
+	cfg := ga.getRemediationConfig()
+	if !cfg.GetEnable() || ga.remediator == nil {
+		return nil
+	}
+	nodeName := utils.GetNodeName()
+	if nodeName == "" {
+		return fmt.Errorf("node name not found")
+	}
+	action := remediationAction(cfg)
+	if action == "" {
+		return fmt.Errorf("invalid remediation action %v", cfg.GetAction())
+	}
+	taint, err := remediationTaint(cfg)
+	if err != nil {
+		return err
+	}
+
+	threshold := int(cfg.GetUnhealthyThreshold())
+	if threshold == 0 {
+		threshold = 1
+	}
+	unhealthy := ga.unhealthyGPUCount()
+
+	var applied, owned bool
+	if cfg.GetDryRun() {
+		applied, owned = ga.remediation.dryRunApplied, ga.remediation.dryRunApplied
+	} else {
+		node, err := ga.remediator.GetNode()
+		if err != nil {
+			return err
+		}
+		applied, owned = remediationNodeState(node, cfg)
+	}
+
+	// a node already cordoned or tainted by someone else is left as is and
+	// only the action of the exporter is reverted
+	apply := !applied && unhealthy >= threshold
+	revert := owned && unhealthy == 0
+	if !apply && !revert {
+		return nil
+	}
+
+	interval := defaultRemediationInterval
+	if cfg.GetMinActionInterval() != 0 {
+		interval = time.Duration(cfg.GetMinActionInterval()) * time.Second
+	}
+	if !ga.remediation.lastAction.IsZero() && time.Since(ga.remediation.lastAction) < interval {
+		logger.Log.Printf("remediation %v rate limited, last action at %v",
+			action, ga.remediation.lastAction.Format(time.RFC3339))
+		return nil
+	}
+
+	var reason, msg, evtType string
+	changed := true
+	switch {
+	case apply && action == remediationActionTaint:
+		reason, evtType = "AMDGPUNodeTainted", v1.EventTypeWarning
+		msg = fmt.Sprintf("taint %v=%v:%v applied", taint.Key, taint.Value, taint.Effect)
+		if !cfg.GetDryRun() {
+			changed, err = ga.remediator.AddNodeTaint(nodeName, taint, remediationTaintAnnotation)
+		}
+	case revert && action == remediationActionTaint:
+		reason, evtType = "AMDGPUNodeTaintRemoved", v1.EventTypeNormal
+		msg = fmt.Sprintf("taint %v=%v:%v removed", taint.Key, taint.Value, taint.Effect)
+		if !cfg.GetDryRun() {
+			changed, err = ga.remediator.RemoveNodeTaint(nodeName, taint, remediationTaintAnnotation)
+		}
+	case apply:
+		reason, evtType = "AMDGPUNodeCordoned", v1.EventTypeWarning
+		msg = "node cordoned"
+		if !cfg.GetDryRun() {
+			changed, err = ga.remediator.SetNodeUnschedulable(nodeName, true, remediationCordonAnnotation)
+		}
+	default:
+		reason, evtType = "AMDGPUNodeUncordoned", v1.EventTypeNormal
+		msg = "node uncordoned"
+		if !cfg.GetDryRun() {
+			changed, err = ga.remediator.SetNodeUnschedulable(nodeName, false, remediationCordonAnnotation)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("remediation %v failed: %v", action, err)
+	}
+	if !changed {
+		// the node changed since it was read, owned by someone else
+		return nil
+	}
+
+	ga.remediation.lastAction = time.Now()
+	if cfg.GetDryRun() {
+		ga.remediation.dryRunApplied = apply
+		msg = "dry-run: " + msg
+	}
+	msg = fmt.Sprintf("%s, %d unhealthy GPUs, threshold %d", msg, unhealthy, threshold)
+	logger.Log.Printf("remediation on node %v: %v", nodeName, msg)
+	_ = ga.remediator.CreateEvent(k8sclient.NewNodeEvent(nodeName, evtType, reason, msg))
+	return nil
+}
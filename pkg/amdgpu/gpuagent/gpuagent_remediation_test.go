@@ -0,0 +1,216 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+)
+
+type fakeRemediator struct {
+	node   *v1.Node
+	events []*v1.Event
+}
+
+func (f *fakeRemediator) GetNode() (*v1.Node, error) {
+	return f.node.DeepCopy(), nil
+}
+
+func (f *fakeRemediator) AddNodeTaint(nodeName string, taint v1.Taint, ownerAnnotation string) (bool, error) {
+	for _, t := range f.node.Spec.Taints {
+		if t.MatchTaint(&taint) {
+			return false, nil
+		}
+	}
+	f.node.Spec.Taints = append(f.node.Spec.Taints, taint)
+	f.node.Annotations[ownerAnnotation] = "now"
+	return true, nil
+}
+
+func (f *fakeRemediator) RemoveNodeTaint(nodeName string, taint v1.Taint, ownerAnnotation string) (bool, error) {
+	if _, ok := f.node.Annotations[ownerAnnotation]; !ok {
+		return false, nil
+	}
+	taints := []v1.Taint{}
+	for _, t := range f.node.Spec.Taints {
+		if !t.MatchTaint(&taint) || t.Value != taint.Value {
+			taints = append(taints, t)
+		}
+	}
+	f.node.Spec.Taints = taints
+	delete(f.node.Annotations, ownerAnnotation)
+	return true, nil
+}
+
+func (f *fakeRemediator) SetNodeUnschedulable(nodeName string, unschedulable bool, ownerAnnotation string) (bool, error) {
+	if unschedulable {
+		if f.node.Spec.Unschedulable {
+			return false, nil
+		}
+		f.node.Annotations[ownerAnnotation] = "now"
+	} else {
+		if _, ok := f.node.Annotations[ownerAnnotation]; !ok {
+			return false, nil
+		}
+		delete(f.node.Annotations, ownerAnnotation)
+	}
+	f.node.Spec.Unschedulable = unschedulable
+	return true, nil
+}
+
+func (f *fakeRemediator) CreateEvent(evtObj *v1.Event) error {
+	f.events = append(f.events, evtObj)
+	return nil
+}
+
+func setGPUHealthStates(ga *GPUAgentClient, health ...string) {
+	ga.Lock()
+	defer ga.Unlock()
+	ga.healthState = map[string]*metricssvc.GPUState{}
+	for i, h := range health {
+		id := string(rune('0' + i))
+		ga.healthState[id] = &metricssvc.GPUState{ID: id, Health: h}
+	}
+}
+
+func TestRemediationPolicy(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	os.Setenv("NODE_NAME", "node1")
+	defer os.Unsetenv("NODE_NAME")
+
+	ga := getNewAgent(t)
+	fake := &fakeRemediator{node: &v1.Node{}}
+	fake.node.Annotations = map[string]string{}
+	ga.remediator = fake
+
+	cfg := &exportermetrics.GPURemediationConfig{
+		Enable:             true,
+		UnhealthyThreshold: 2,
+	}
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{Remediation: cfg}
+
+	// below threshold, degraded is not counted
+	setGPUHealthStates(ga, unhealthyStr, "degraded", healthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Equal(t, len(fake.node.Spec.Taints), 0)
+
+	// threshold reached
+	setGPUHealthStates(ga, unhealthyStr, unhealthyStr, healthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Equal(t, len(fake.node.Spec.Taints), 1)
+	assert.Equal(t, fake.node.Spec.Taints[0].Key, defaultRemediationTaintKey)
+	assert.Equal(t, fake.node.Spec.Taints[0].Effect, v1.TaintEffectNoSchedule)
+	assert.Equal(t, len(fake.events), 1)
+	assert.Equal(t, fake.events[0].Reason, "AMDGPUNodeTainted")
+	assert.Equal(t, fake.events[0].InvolvedObject.Name, "node1")
+
+	// not removed while a GPU is unhealthy
+	setGPUHealthStates(ga, unhealthyStr, healthyStr, healthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Equal(t, len(fake.node.Spec.Taints), 1)
+
+	// all healthy, rate limited
+	setGPUHealthStates(ga, healthyStr, healthyStr, healthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Equal(t, len(fake.node.Spec.Taints), 1)
+
+	ga.remediation.lastAction = time.Now().Add(-defaultRemediationInterval)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Equal(t, len(fake.node.Spec.Taints), 0)
+	assert.Equal(t, len(fake.events), 2)
+	assert.Equal(t, fake.events[1].Reason, "AMDGPUNodeTaintRemoved")
+
+	_, ok := fake.node.Annotations[remediationTaintAnnotation]
+	assert.Assert(t, !ok)
+
+	// taint with the same key but another value is not the policy taint
+	fake.node.Spec.Taints = []v1.Taint{{Key: defaultRemediationTaintKey, Value: "maintenance", Effect: v1.TaintEffectNoSchedule}}
+	applied, _ := remediationNodeState(fake.node, cfg)
+	assert.Assert(t, !applied)
+	ga.remediation.lastAction = time.Time{}
+	setGPUHealthStates(ga, healthyStr, healthyStr, healthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Equal(t, len(fake.node.Spec.Taints), 1)
+	assert.Equal(t, len(fake.events), 2)
+
+	// policy taint set by someone else is neither owned nor removed
+	fake.node.Spec.Taints = []v1.Taint{{Key: defaultRemediationTaintKey, Value: "true", Effect: v1.TaintEffectNoSchedule}}
+	setGPUHealthStates(ga, unhealthyStr, unhealthyStr, healthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	_, ok = fake.node.Annotations[remediationTaintAnnotation]
+	assert.Assert(t, !ok)
+	setGPUHealthStates(ga, healthyStr, healthyStr, healthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Equal(t, len(fake.node.Spec.Taints), 1)
+	assert.Equal(t, len(fake.events), 2)
+	fake.node.Spec.Taints = nil
+
+	// cordon in dry run does not modify the node
+	cfg.Action = "cordon"
+	cfg.DryRun = true
+	cfg.MinActionInterval = 1
+	ga.remediation.lastAction = time.Time{}
+	setGPUHealthStates(ga, unhealthyStr, unhealthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Assert(t, !fake.node.Spec.Unschedulable)
+	assert.Assert(t, ga.remediation.dryRunApplied)
+	assert.Equal(t, fake.events[2].Reason, "AMDGPUNodeCordoned")
+	assert.Assert(t, len(fake.events[2].Message) > 0 && fake.events[2].Message[:8] == "dry-run:")
+
+	// cordon
+	cfg.DryRun = false
+	ga.remediation.lastAction = time.Time{}
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Assert(t, fake.node.Spec.Unschedulable)
+	_, ok = fake.node.Annotations[remediationCordonAnnotation]
+	assert.Assert(t, ok)
+
+	// uncordon
+	ga.remediation.lastAction = time.Time{}
+	setGPUHealthStates(ga, healthyStr, healthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Assert(t, !fake.node.Spec.Unschedulable)
+	events := len(fake.events)
+
+	// node cordoned by someone else is neither annotated nor uncordoned
+	fake.node.Spec.Unschedulable = true
+	ga.remediation.lastAction = time.Time{}
+	setGPUHealthStates(ga, unhealthyStr, unhealthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	_, ok = fake.node.Annotations[remediationCordonAnnotation]
+	assert.Assert(t, !ok)
+	setGPUHealthStates(ga, healthyStr, healthyStr)
+	assert.NilError(t, ga.applyRemediationPolicy())
+	assert.Assert(t, fake.node.Spec.Unschedulable)
+	assert.Equal(t, len(fake.events), events)
+
+	// invalid config
+	cfg.Action = "drain"
+	assert.Assert(t, ga.applyRemediationPolicy() != nil)
+	cfg.Action = ""
+	cfg.TaintEffect = "Invalid"
+	assert.Assert(t, ga.applyRemediationPolicy() != nil)
+}
@@ -0,0 +1,292 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gpumetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/sampler"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const labelQuantile = "quantile"
+
+// default sampled fields, quantiles and histogram buckets of the high
+// frequency sampling
+var (
+	defaultSampledFields = []string{
+		exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String(),
+		exportermetrics.GPUMetricField_GPU_GFX_ACTIVITY.String(),
+		exportermetrics.GPUMetricField_GPU_JUNCTION_TEMPERATURE.String(),
+	}
+	defaultSampledQuantiles = []float64{0.5, 0.9, 0.99}
+	defaultSampledBuckets   = map[string][]float64{
+		exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String():         prometheus.LinearBuckets(100, 100, 10),
+		exportermetrics.GPUMetricField_GPU_AVERAGE_PACKAGE_POWER.String(): prometheus.LinearBuckets(100, 100, 10),
+		exportermetrics.GPUMetricField_GPU_GFX_ACTIVITY.String():          prometheus.LinearBuckets(10, 10, 10),
+		exportermetrics.GPUMetricField_GPU_UMC_ACTIVITY.String():          prometheus.LinearBuckets(10, 10, 10),
+		exportermetrics.GPUMetricField_GPU_JUNCTION_TEMPERATURE.String():  prometheus.LinearBuckets(40, 10, 8),
+		exportermetrics.GPUMetricField_GPU_EDGE_TEMPERATURE.String():      prometheus.LinearBuckets(40, 10, 8),
+		exportermetrics.GPUMetricField_GPU_MEMORY_TEMPERATURE.String():    prometheus.LinearBuckets(40, 10, 8),
+	}
+)
+
+// sampledFieldMetrics are the metrics of a sampled field, the gauges are
+// set with the latest samples while the histogram accumulates every sample
+type sampledFieldMetrics struct {
+	min       prometheus.GaugeVec
+	max       prometheus.GaugeVec
+	avg       prometheus.GaugeVec
+	quantile  prometheus.GaugeVec
+	histogram *prometheus.HistogramVec
+}
+
+// samplerMetrics are the metrics of the high frequency sampling, exported
+// when enabled in the sampling config
+type samplerMetrics struct {
+	sampler   *sampler.Sampler
+	fields    map[string]*sampledFieldMetrics
+	quantiles []float64
+
+	// labels of the GPUs keyed by the sampled device, learnt on the metrics
+	// updates
+	sync.Mutex
+	deviceLabels map[string]map[string]string
+}
+
+func (sm *samplerMetrics) gauges() []*prometheus.GaugeVec {
+	gauges := []*prometheus.GaugeVec{}
+	for _, fm := range sm.fields {
+		gauges = append(gauges, &fm.min, &fm.max, &fm.avg, &fm.quantile)
+	}
+	return gauges
+}
+
+func newSampledFieldMetrics(field string, labels []string, buckets []float64,
+	nativeHistograms bool) *sampledFieldMetrics {
+	name := strings.ToLower(field) + "_sampled"
+	gauge := func(suffix, help string, labels []string) prometheus.GaugeVec {
+		return *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: name + suffix,
+			Help: fmt.Sprintf("%v of the latest %v samples", help, field),
+		}, labels)
+	}
+	fm := &sampledFieldMetrics{
+		min:      gauge("_min", "Minimum", labels),
+		max:      gauge("_max", "Maximum", labels),
+		avg:      gauge("_avg", "Average", labels),
+		quantile: gauge("_quantile", "Quantiles", append(slices.Clone(labels), labelQuantile)),
+	}
+	if len(buckets) != 0 || nativeHistograms {
+		opts := prometheus.HistogramOpts{
+			Name:    name + "_histogram",
+			Help:    fmt.Sprintf("Histogram of the %v samples", field),
+			Buckets: buckets,
+		}
+		if nativeHistograms {
+			opts.NativeHistogramBucketFactor = 1.1
+		}
+		fm.histogram = prometheus.NewHistogramVec(opts, labels)
+	}
+	return fm
+}
+
+// initSampler starts the high frequency sampling of the fields set in the
+// config, the sampling of the previous config is stopped
+func (ga *GPUAgentClient) initSampler(config *exportermetrics.GPUMetricConfig) {
+	if sm := ga.sm.Swap(nil); sm != nil {
+		sm.sampler.Stop()
+	}
+	cfg := config.GetHighFrequencySampling()
+	if !cfg.GetEnable() {
+		return
+	}
+	fields := []string{}
+	for _, field := range cfg.GetFields() {
+		field = strings.ToUpper(field)
+		if _, ok := exportermetrics.GPUMetricField_value[field]; !ok {
+			logger.Log.Printf("unsupported sampled field %v ignored", field)
+			continue
+		}
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	if len(cfg.GetFields()) == 0 {
+		fields = defaultSampledFields
+	}
+	quantiles := []float64{}
+	for _, q := range cfg.GetQuantiles() {
+		if q < 0 || q > 1 {
+			logger.Log.Printf("invalid sampled quantile %v ignored", q)
+			continue
+		}
+		quantiles = append(quantiles, q)
+	}
+	if len(cfg.GetQuantiles()) == 0 {
+		quantiles = defaultSampledQuantiles
+	}
+	interval := time.Duration(cfg.GetIntervalMs()) * time.Millisecond
+	if interval == 0 {
+		interval = globals.SamplingIntervalMs * time.Millisecond
+	}
+	maxSamples := int(cfg.GetMaxSamples())
+	if maxSamples == 0 {
+		maxSamples = globals.SamplingMaxSamples
+	}
+
+	labels := []string{strings.ToLower(exportermetrics.GPUMetricLabel_GPU_ID.String())}
+	for _, label := range ga.GetExporterNonGPULabels() {
+		if !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	sm := &samplerMetrics{
+		fields:       make(map[string]*sampledFieldMetrics),
+		quantiles:    quantiles,
+		deviceLabels: make(map[string]map[string]string),
+	}
+	for _, field := range fields {
+		buckets := defaultSampledBuckets[field]
+		if b, ok := cfg.GetBuckets()[field]; ok {
+			buckets = slices.Clone(b.GetBounds())
+			slices.Sort(buckets)
+		}
+		fm := newSampledFieldMetrics(field, labels, buckets, cfg.GetNativeHistograms())
+		sm.fields[field] = fm
+		if fm.histogram != nil {
+			if err := ga.mh.RegisterMetric(fm.histogram); err != nil {
+				logger.Log.Printf("sampled metric registration failed with err : %v", err)
+			}
+		}
+	}
+	for _, gauge := range sm.gauges() {
+		if err := ga.mh.RegisterMetric(gauge); err != nil {
+			logger.Log.Printf("sampled metric registration failed with err : %v", err)
+		}
+	}
+	sm.sampler = sampler.New(ga.readGPUMetricsTables, fields,
+		sampler.WithInterval(interval), sampler.WithMaxSamples(maxSamples),
+		sampler.WithObserver(sm.observe))
+	sm.sampler.Start()
+	ga.sm.Store(sm)
+	logger.Log.Printf("high frequency sampling of %v every %v", fields, interval)
+}
+
+// readGPUMetricsTables returns the values of the gpu_metrics tables keyed
+// by the PCIe address of the GPUs, the fields with instances are skipped
+func (ga *GPUAgentClient) readGPUMetricsTables() (map[string]map[string]float64, error) {
+	devices, err := ga.fsysDeviceHandler.GetGPUDevices()
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]map[string]float64, len(devices))
+	for _, dev := range devices {
+		table, err := gpumetrics.Read(dev.DevDir)
+		if err != nil {
+			continue
+		}
+		fields := make(map[string]float64)
+		for _, v := range table.Values() {
+			if v.Index < 0 {
+				fields[v.Field] = v.Value
+			}
+		}
+		values[dev.PCIeBusId] = fields
+	}
+	return values, nil
+}
+
+// observe adds the sampled value to the histogram of the field, the samples
+// of a device are observed once its GPU is known from a metrics update
+func (sm *samplerMetrics) observe(device, field string, value float64) {
+	fm, ok := sm.fields[field]
+	if !ok || fm.histogram == nil {
+		return
+	}
+	sm.Lock()
+	labels, ok := sm.deviceLabels[device]
+	sm.Unlock()
+	if !ok {
+		return
+	}
+	fm.histogram.With(labels).Observe(value)
+}
+
+// updateSamplerMetrics exports the statistics of the latest samples, the
+// GPUs are matched by their PCIe address
+func (ga *GPUAgentClient) updateSamplerMetrics(gpus []*amdgpu.GPU) {
+	sm := ga.sm.Load()
+	if sm == nil {
+		return
+	}
+	for _, gauge := range sm.gauges() {
+		gauge.Reset()
+	}
+	stats := sm.sampler.Stats()
+	deviceLabels := make(map[string]map[string]string)
+	defer func() {
+		sm.Lock()
+		sm.deviceLabels = deviceLabels
+		sm.Unlock()
+	}()
+	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
+	for _, gpu := range gpus {
+		if gpu == nil || gpu.Status == nil || gpu.Status.PCIeStatus == nil ||
+			!ga.exporterEnabledGPU(getGPUInstanceID(gpu)) {
+			continue
+		}
+		labels := maps.Clone(nonGpuLabels)
+		labels[strings.ToLower(exportermetrics.GPUMetricLabel_GPU_ID.String())] = fmt.Sprintf("%v", getGPUInstanceID(gpu))
+		busId := strings.ToLower(gpu.Status.PCIeStatus.PCIeBusId)
+		deviceLabels[busId] = labels
+		gpuStats, ok := stats[busId]
+		if !ok && gpu.Status.GetPartitionId() == 0 {
+			// the table is reported by the pci device of the first partition
+			baseId := utils.GetPCIeBaseAddress(busId)
+			gpuStats = stats[baseId]
+			if _, ok := deviceLabels[baseId]; !ok {
+				deviceLabels[baseId] = labels
+			}
+		}
+		for field, st := range gpuStats {
+			fm, ok := sm.fields[field]
+			if !ok || st.Count == 0 {
+				continue
+			}
+			fm.min.With(labels).Set(st.Min)
+			fm.max.With(labels).Set(st.Max)
+			fm.avg.With(labels).Set(st.Avg())
+			for _, q := range sm.quantiles {
+				qLabels := maps.Clone(labels)
+				qLabels[labelQuantile] = fmt.Sprintf("%v", q)
+				fm.quantile.With(qLabels).Set(st.Quantile(q))
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/fsysdevice"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// source label values of the GPU metrics
+const (
+	metricSourceGPUAgent = "gpuagent"
+	metricSourceSysfs    = "sysfs"
+)
+
+// initSysfsFallback enables the sysfs fallback collector when set in the
+// config, the source label is enabled with it
+func (ga *GPUAgentClient) initSysfsFallback(config *exportermetrics.GPUMetricConfig) {
+	ga.sysfsFallback = config.GetSysfsFallback().GetEnable()
+	logger.Log.Printf("sysfs fallback enable %v", ga.sysfsFallback)
+}
+
+func (ga *GPUAgentClient) setSysfsFallbackActive(active bool) {
+	if ga.sysfsFallbackActive != active {
+		logger.Log.Printf("sysfs fallback active %v", active)
+	}
+	ga.sysfsFallbackActive = active
+}
+
+// updateSysfsFallbackMetrics exports the GPU metrics read from sysfs when
+// gpuagent failed with err, err is returned when the fallback is disabled
+func (ga *GPUAgentClient) updateSysfsFallbackMetrics(err error) error {
+	if !ga.sysfsFallback {
+		return err
+	}
+	gpus, sysErr := ga.fsysDeviceHandler.GetGPUMetrics()
+	if sysErr != nil {
+		return fmt.Errorf("gpuagent: %v, sysfs fallback: %v", err, sysErr)
+	}
+	ga.setSysfsFallbackActive(true)
+
+	wls, wlsErr := ga.ListWorkloads()
+	if wlsErr != nil {
+		logger.Log.Printf("Error listing workloads: %v", wlsErr)
+	}
+	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
+	ga.m.gpuNodesTotal.With(nonGpuLabels).Set(float64(len(gpus)))
+	sourceLabel := strings.ToLower(exportermetrics.GPUMetricLabel_SOURCE.String())
+	sysGPUs := []*amdgpu.GPU{}
+	for _, m := range gpus {
+		if !ga.exporterEnabledGPU(m.Index) {
+			continue
+		}
+		gpu := sysfsGPU(m)
+		sysGPUs = append(sysGPUs, gpu)
+		for _, labels := range ga.gpuMetricLabelSets(wls, gpu, nil) {
+			labels[sourceLabel] = metricSourceSysfs
+			for field, value := range m.Values {
+				if !exportFieldMap[field] {
+					continue
+				}
+				if meta, ok := fieldMetricsMap[field]; ok {
+					meta.Metric.With(labels).Set(value)
+				}
+			}
+		}
+	}
+	ga.updateSamplerMetrics(sysGPUs)
+	return nil
+}
+
+// sysfsGPU returns the GPU of the sysfs device, only the fields of the
+// labels and of the workload lookup are set
+func sysfsGPU(m *fsysdevice.GPUMetrics) *amdgpu.GPU {
+	return &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{},
+		Status: &amdgpu.GPUStatus{
+			Index:       uint32(m.Index),
+			SerialNum:   m.SerialNum,
+			CardModel:   m.CardModel,
+			DRMRenderId: uint32(m.RenderId),
+			DRMCardId:   uint32(m.CardId),
+			PCIeStatus: &amdgpu.GPUPCIeStatus{
+				PCIeBusId: m.PCIeBusId,
+			},
+		},
+	}
+}
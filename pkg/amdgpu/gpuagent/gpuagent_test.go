@@ -17,9 +17,25 @@
 package gpuagent
 
 import (
+	"encoding/binary"
+	"errors"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/fsysdevice"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/kfdprocess"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 )
 
 func TestGpuAgent(t *testing.T) {
@@ -66,7 +82,7 @@ func TestGpuAgentSlurm(t *testing.T) {
 	ga := getNewAgent(t)
 	t.Logf("gpuagent : %+v", ga)
 
-	req, err := ga.getGPUs()
+	req, _, err := ga.getGPUs()
 	assert.Assert(t, err == nil, "expecting nil response")
 
 	t.Logf("req :%+v", req)
@@ -83,7 +99,7 @@ func TestGpuAgentSlurm(t *testing.T) {
 	err = ga.processHealthValidation()
 	assert.Assert(t, err == nil, "expecting success health validation")
 
-	ga.slurmScheduler = newSlurmMockClient()
+	ga.batchScheduler = newSlurmMockClient()
 	wls, err := ga.ListWorkloads()
 	assert.Assert(t, err == nil, "expecting success workload list")
 	assert.Assert(t, len(wls) == 2, "expecting success 2 workloads on slurm")
@@ -97,7 +113,7 @@ func TestGpuAgentK8s(t *testing.T) {
 	ga := getNewAgent(t)
 	t.Logf("gpuagent : %+v", ga)
 
-	req, err := ga.getGPUs()
+	req, _, err := ga.getGPUs()
 	assert.Assert(t, err == nil, "expecting nil response")
 
 	t.Logf("req :%+v", req)
@@ -121,3 +137,653 @@ func TestGpuAgentK8s(t *testing.T) {
 	assert.Assert(t, len(wls) == 2, "expecting success 2 workloads on slurm")
 	ga.Close()
 }
+
+func TestGpuAgentSharedGPU(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+
+	pod := func(name string) scheduler.Workload {
+		return scheduler.Workload{
+			Type: scheduler.Kubernetes,
+			Info: scheduler.PodResourceInfo{Pod: name, Namespace: "ns", Container: "c"},
+		}
+	}
+	wls := map[string][]scheduler.Workload{
+		"0000:05:00.0": {pod("pod1"), pod("pod0")},
+	}
+	gpu := &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{},
+		Status: &amdgpu.GPUStatus{
+			Index:      0,
+			PCIeStatus: &amdgpu.GPUPCIeStatus{PCIeBusId: "0000:05:00.0"},
+		},
+	}
+
+	// all the sharers are associated with the GPU in a stable order
+	assert.DeepEqual(t, ga.getWorkloadsListString(wls, gpu), []string{
+		"Pod: pod0, Namespace: ns, Container: c",
+		"Pod: pod1, Namespace: ns, Container: c",
+	})
+
+	// default mode exports one set of labels per workload
+	labelSets := ga.gpuMetricLabelSets(wls, gpu, nil)
+	assert.Equal(t, len(labelSets), 2)
+	assert.Equal(t, labelSets[0]["pod"], "pod0")
+	assert.Equal(t, labelSets[1]["pod"], "pod1")
+	assert.Equal(t, labelSets[1]["namespace"], "ns")
+
+	// join mode exports a single set of labels with comma separated values
+	workloadLabelMode = workloadLabelModeJoin
+	defer func() { workloadLabelMode = workloadLabelModePair }()
+	labelSets = ga.gpuMetricLabelSets(wls, gpu, nil)
+	assert.Equal(t, len(labelSets), 1)
+	assert.Equal(t, labelSets[0]["pod"], "pod0,pod1")
+	assert.Equal(t, labelSets[0]["namespace"], "ns")
+	assert.Equal(t, labelSets[0]["gpu_id"], "0")
+
+	// a GPU without workload has empty workload labels
+	labelSets = ga.gpuMetricLabelSets(nil, gpu, nil)
+	assert.Equal(t, len(labelSets), 1)
+	assert.Equal(t, labelSets[0]["pod"], "")
+}
+
+func TestGpuAgentK8sMetadataLabels(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		NodeLabels: map[string]string{
+			"ZONE":     "topology.kubernetes.io/zone",
+			"HOSTNAME": "kubernetes.io/hostname",
+			"RACK":     "example.com/rack",
+			"TEAM":     "example.com/node-team",
+			"BAD-KEY":  "example.com/bad",
+		},
+		ExtraPodAnnotations: map[string]string{
+			"TEAM":   "example.com/team",
+			"GPU_ID": "example.com/gpu",
+		},
+		CustomLabels: map[string]string{"RACK": "r1"},
+	}
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+
+	// invalid names and the names of the exporter, custom and pod annotation
+	// labels are dropped
+	assert.DeepEqual(t, nodeLabelsMap, map[string]string{"zone": "topology.kubernetes.io/zone"})
+	assert.DeepEqual(t, extraPodAnnotationsMap, map[string]string{"team": "example.com/team"})
+
+	assert.Assert(t, slices.Contains(ga.GetExportLabels(), "zone"))
+	assert.Assert(t, slices.Contains(ga.GetExportLabels(), "team"))
+	assert.Assert(t, slices.Contains(ga.GetExporterNonGPULabels(), "zone"))
+
+	k8NodeLabels = map[string]string{"topology.kubernetes.io/zone": "zone-a"}
+	k8PodAnnotationsMap = map[string]map[string]string{"ns-pod0": {"example.com/team": "ml"}}
+	wls := map[string][]scheduler.Workload{
+		"0000:05:00.0": {
+			{
+				Type: scheduler.Kubernetes,
+				Info: scheduler.PodResourceInfo{Pod: "pod0", Namespace: "ns", Container: "c"},
+			},
+		},
+	}
+	gpu := &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{},
+		Status: &amdgpu.GPUStatus{
+			PCIeStatus: &amdgpu.GPUPCIeStatus{PCIeBusId: "0000:05:00.0"},
+		},
+	}
+	labels := ga.populateLabelsFromGPU(wls, gpu, nil)
+	assert.Equal(t, labels["zone"], "zone-a")
+	assert.Equal(t, labels["team"], "ml")
+
+	// node labels are set on the non GPU metrics, annotations only with a workload
+	labels = ga.populateLabelsFromGPU(nil, nil, nil)
+	assert.Equal(t, labels["zone"], "zone-a")
+	_, ok := labels["team"]
+	assert.Assert(t, !ok)
+}
+
+func TestGpuAgentSlurmSteps(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		Labels: []string{"JOB_STEP_ID", "JOB_ARRAY_JOB_ID", "JOB_ARRAY_TASK_ID"},
+	}
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+
+	step := func(id string) scheduler.Workload {
+		return scheduler.Workload{
+			Type: scheduler.Slurm,
+			Info: scheduler.JobInfo{Id: "7", User: "alice", StepId: id, ArrayJobId: "5", ArrayTaskId: "1"},
+		}
+	}
+	// steps reported by render node and gpu index
+	wls := map[string][]scheduler.Workload{
+		"renderD129": {step("1")},
+		"1":          {step("0")},
+	}
+	gpu := &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{},
+		Status: &amdgpu.GPUStatus{
+			Index:       1,
+			DRMRenderId: 129,
+		},
+	}
+	labelSets := ga.gpuMetricLabelSets(wls, gpu, nil)
+	assert.Equal(t, len(labelSets), 2)
+	assert.Equal(t, labelSets[0]["job_id"], "7")
+	assert.Equal(t, labelSets[0]["job_step_id"], "0")
+	assert.Equal(t, labelSets[1]["job_step_id"], "1")
+	assert.Equal(t, labelSets[1]["job_array_job_id"], "5")
+	assert.Equal(t, labelSets[1]["job_array_task_id"], "1")
+}
+
+func TestGpuAgentRegisteredWorkloads(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		WorkloadLabels: map[string]string{"TEAM": "team", "RAY_CLUSTER": "ray.io/cluster"},
+	}
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Assert(t, slices.Contains(ga.GetExportLabels(), "team"))
+	assert.Assert(t, slices.Contains(ga.GetExportLabels(), "ray_cluster"))
+
+	wls := map[string][]scheduler.Workload{
+		"renderD129": {
+			{
+				Type: scheduler.External,
+				Info: &scheduler.RegisteredWorkload{
+					Id:        "train-42",
+					Scheduler: "ray",
+					Labels:    map[string]string{"team": "llm", "ray.io/cluster": "ray-a"},
+					Devices:   []string{"renderD129"},
+				},
+			},
+		},
+	}
+	gpu := &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{},
+		Status: &amdgpu.GPUStatus{
+			Index:       1,
+			DRMRenderId: 129,
+		},
+	}
+	labels := ga.populateLabelsFromGPU(wls, gpu, nil)
+	assert.Equal(t, labels["job_id"], "train-42")
+	assert.Equal(t, labels["team"], "llm")
+	assert.Equal(t, labels["ray_cluster"], "ray-a")
+	assert.DeepEqual(t, ga.getWorkloadsListString(wls, gpu), []string{"Workload: train-42, Scheduler: ray"})
+
+	// registered labels are empty without a registered workload
+	labels = ga.populateLabelsFromGPU(nil, gpu, nil)
+	assert.Equal(t, labels["team"], "")
+	assert.Equal(t, labels["ray_cluster"], "")
+}
+
+func TestGpuAgentContainers(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		Labels: []string{"CONTAINER_IMAGE", "COMPOSE_PROJECT"},
+	}
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+
+	wls := map[string][]scheduler.Workload{
+		"renderD128": {
+			{
+				Type: scheduler.Container,
+				Info: scheduler.ContainerInfo{Id: "c1", Name: "train", Image: "rocm/pytorch", ComposeProject: "llm"},
+			},
+		},
+	}
+	gpu := &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{},
+		Status: &amdgpu.GPUStatus{
+			DRMRenderId: 128,
+		},
+	}
+	labels := ga.populateLabelsFromGPU(wls, gpu, nil)
+	assert.Equal(t, labels["container"], "train")
+	assert.Equal(t, labels["container_image"], "rocm/pytorch")
+	assert.Equal(t, labels["compose_project"], "llm")
+	assert.Equal(t, labels["pod"], "")
+}
+
+func TestGpuAgentProcessMetrics(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	root := t.TempDir()
+	writeFile := func(path, data string) {
+		path = filepath.Join(root, path)
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NilError(t, os.WriteFile(path, []byte(data), 0644))
+	}
+	containerId := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	writeFile("sys/class/kfd/kfd/proc/100/vram_12345", "2097152\n")
+	writeFile("sys/class/kfd/kfd/proc/100/sdma_12345", "500\n")
+	writeFile("sys/class/kfd/kfd/proc/100/stats_12345/cu_occupancy", "40\n")
+	writeFile("proc/100/comm", "python3\n")
+	writeFile("proc/100/cgroup", "0::/system.slice/docker-"+containerId+".scope\n")
+	writeFile("sys/class/kfd/kfd/proc/200/vram_12345", "1048576\n")
+	writeFile("proc/200/comm", "rccl-test\n")
+	writeFile("proc/200/cgroup", "0::/user.slice\n")
+
+	ga := getNewAgent(t)
+	ga.kfdReader = kfdprocess.NewReader(
+		kfdprocess.WithSysRoot(filepath.Join(root, "sys")),
+		kfdprocess.WithProcRoot(filepath.Join(root, "proc")))
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		ProcessMetrics: &exportermetrics.ProcessMetricsConfig{
+			Enable: true,
+			TopN:   1,
+		},
+	}
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Assert(t, ga.pm.Load() != nil)
+
+	wls := map[string][]scheduler.Workload{
+		"renderD128": {
+			{
+				Type: scheduler.Container,
+				Info: scheduler.ContainerInfo{Id: containerId, Name: "train"},
+			},
+		},
+	}
+	gpu := &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{},
+		Status: &amdgpu.GPUStatus{
+			NodeId:      1,
+			KFDId:       12345,
+			DRMRenderId: 128,
+		},
+	}
+	ga.updateProcessMetrics(wls, []*amdgpu.GPU{gpu})
+	assert.Equal(t, testutil.CollectAndCount(&ga.pm.Load().processTotal), 1)
+	// only the top process by vram is exported
+	assert.Equal(t, testutil.CollectAndCount(&ga.pm.Load().usedVRAM), 1)
+	labels := map[string]string{}
+	for k, v := range ga.populateLabelsFromGPU(nil, nil, nil) {
+		labels[k] = v
+	}
+	labels["gpu_id"] = "0"
+	labels["pid"] = "100"
+	labels["process_name"] = "python3"
+	labels["container_id"] = containerId[:12]
+	labels["container"] = "train"
+	labels["pod"] = ""
+	labels["namespace"] = ""
+	labels["job_id"] = ""
+	assert.Equal(t, testutil.ToFloat64(ga.pm.Load().usedVRAM.With(labels)), 2.0)
+	assert.Equal(t, testutil.ToFloat64(ga.pm.Load().cuOccupancy.With(labels)), 40.0)
+	// no sdma rate before the second update
+	assert.Equal(t, testutil.CollectAndCount(&ga.pm.Load().sdmaUsage), 0)
+
+	// 250ms of sdma usage in the second since the previous update
+	writeFile("sys/class/kfd/kfd/proc/100/sdma_12345", "250500\n")
+	pm := ga.pm.Load()
+	pm.sdmaLast["100/12345"] = sdmaSample{usage: 500, time: time.Now().Add(-time.Second)}
+	ga.updateProcessMetrics(wls, []*amdgpu.GPU{gpu})
+	rate := testutil.ToFloat64(pm.sdmaUsage.With(labels))
+	assert.Assert(t, rate > 24 && rate <= 25, "unexpected sdma rate %v", rate)
+
+	// the processes of another GPU are not matched by the topology node
+	gpu.Status.KFDId = 23456
+	ga.updateProcessMetrics(wls, []*amdgpu.GPU{gpu})
+	gpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
+	gpuLabels["gpu_id"] = "0"
+	assert.Equal(t, testutil.ToFloat64(pm.processTotal.With(gpuLabels)), 0.0)
+	gpu.Status.KFDId = 12345
+
+	// disabled from the config
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{}
+	err = ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Assert(t, ga.pm.Load() == nil)
+}
+
+func TestGpuAgentProcessLabels(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	containerId := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	workloads := []*scheduler.Workload{
+		{
+			Type: scheduler.Container,
+			Info: scheduler.ContainerInfo{Id: containerId, Name: "train"},
+		},
+		{
+			Type: scheduler.Slurm,
+			Info: scheduler.JobInfo{Id: "7"},
+		},
+	}
+	gpuLabels := map[string]string{"gpu_id": "0"}
+
+	labels := ga.processLabels(gpuLabels, &kfdprocess.Process{Pid: 100,
+		Cgroup: "/system.slice/docker-" + containerId + ".scope", ContainerId: containerId}, workloads)
+	assert.Equal(t, labels["container"], "train")
+	assert.Equal(t, labels["job_id"], "")
+
+	labels = ga.processLabels(gpuLabels, &kfdprocess.Process{Pid: 300,
+		Cgroup: "/slurm/uid_1000/job_7/step_0"}, workloads)
+	assert.Equal(t, labels["container"], "")
+	assert.Equal(t, labels["job_id"], "7")
+
+	// processes outside of the workloads are not labelled, even when the
+	// GPU is used by a single workload
+	for _, wls := range [][]*scheduler.Workload{workloads, workloads[:1], workloads[1:]} {
+		labels = ga.processLabels(gpuLabels, &kfdprocess.Process{Pid: 200,
+			Cgroup: "/user.slice"}, wls)
+		assert.Equal(t, labels["container"], "")
+		assert.Equal(t, labels["job_id"], "")
+		assert.Equal(t, labels["pid"], "200")
+	}
+	labels = ga.processLabels(gpuLabels, &kfdprocess.Process{Pid: 400,
+		Cgroup: "/slurm/uid_1000/job_70/step_0"}, workloads)
+	assert.Equal(t, labels["job_id"], "")
+
+	// pods are not matched without the kubernetes client
+	labels = ga.processLabels(gpuLabels, &kfdprocess.Process{Pid: 500,
+		Cgroup: "/kubepods/burstable/pod1234/" + containerId, ContainerId: containerId},
+		[]*scheduler.Workload{{Type: scheduler.Kubernetes,
+			Info: scheduler.PodResourceInfo{Pod: "p1", Namespace: "ns1", Container: "c1"}}})
+	assert.Equal(t, labels["pod"], "")
+	assert.Equal(t, labels["container"], "")
+}
+
+func TestGpuAgentSysfsFallback(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	root := t.TempDir()
+	for path, data := range map[string]string{
+		"class/drm/card0/device/vendor":                   "0x1002",
+		"class/drm/card0/device/uevent":                   "PCI_SLOT_NAME=0000:03:00.0",
+		"class/drm/card0/device/drm/renderD128/dev":       "226:128",
+		"class/drm/card0/device/gpu_busy_percent":         "30",
+		"class/drm/card0/device/hwmon/hwmon0/fan1_input":  "900",
+		"class/drm/card0/device/hwmon/hwmon0/temp1_label": "edge",
+		"class/drm/card0/device/hwmon/hwmon0/temp1_input": "40000",
+	} {
+		path = filepath.Join(root, path)
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NilError(t, os.WriteFile(path, []byte(data+"\n"), 0644))
+	}
+
+	ga := getNewAgent(t)
+	ga.fsysDeviceHandler = fsysdevice.NewFsysDevice(root)
+	gpuagentErr := errors.New("gpuagent unavailable")
+
+	// disabled by default
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Equal(t, ga.updateSysfsFallbackMetrics(gpuagentErr), gpuagentErr)
+	assert.Assert(t, !slices.Contains(ga.GetExportLabels(), "source"))
+
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		SysfsFallback: &exportermetrics.SysfsFallbackConfig{
+			Enable: true,
+		},
+	}
+	err = ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Assert(t, slices.Contains(ga.GetExportLabels(), "source"))
+
+	gpu := &amdgpu.GPU{
+		Spec:   &amdgpu.GPUSpec{},
+		Status: &amdgpu.GPUStatus{},
+	}
+	labels := ga.populateLabelsFromGPU(nil, gpu, nil)
+	assert.Equal(t, labels["source"], "gpuagent")
+
+	assert.NilError(t, ga.updateSysfsFallbackMetrics(gpuagentErr))
+	assert.Assert(t, ga.sysfsFallbackActive)
+	assert.Equal(t, testutil.CollectAndCount(&ga.m.gpuGFXActivity), 1)
+	assert.Equal(t, testutil.CollectAndCount(&ga.m.gpuPackagePower), 0)
+	labels = ga.populateLabelsFromGPU(nil, sysfsGPU(&fsysdevice.GPUMetrics{PCIeBusId: "0000:03:00.0"}), nil)
+	labels["source"] = "sysfs"
+	assert.Equal(t, labels["gpu_id"], "0")
+	assert.Equal(t, testutil.ToFloat64(ga.m.gpuGFXActivity.With(labels)), 30.0)
+	assert.Equal(t, testutil.ToFloat64(ga.m.gpuFanSpeed.With(labels)), 900.0)
+	assert.Equal(t, testutil.ToFloat64(ga.m.gpuEdgeTemp.With(labels)), 40.0)
+
+	// sysfs unavailable
+	ga.fsysDeviceHandler = fsysdevice.NewFsysDevice(t.TempDir())
+	assert.Assert(t, ga.updateSysfsFallbackMetrics(gpuagentErr) != nil)
+}
+
+func TestGpuAgentJobAccounting(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	dir := t.TempDir()
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		JobAccounting: &exportermetrics.JobAccountingConfig{
+			Enable:        true,
+			Directory:     dir,
+			ExportMetrics: true,
+		},
+	}
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Assert(t, ga.jobAccounting.Load() != nil)
+	assert.Assert(t, ga.jm.Load() != nil)
+
+	job := scheduler.Workload{
+		Type: scheduler.Slurm,
+		Info: scheduler.JobInfo{Id: "42", User: "alice", Partition: "gpu"},
+	}
+	newGPU := func(energy float64) *amdgpu.GPU {
+		return &amdgpu.GPU{
+			Spec: &amdgpu.GPUSpec{},
+			Status: &amdgpu.GPUStatus{
+				Index:       0,
+				DRMRenderId: 128,
+			},
+			Stats: &amdgpu.GPUStats{
+				EnergyConsumed: energy,
+				VRAMUsage:      &amdgpu.GPUVRAMUsage{UsedVRAM: 1024},
+				Usage:          &amdgpu.GPUUsage{GFXActivity: 80, UMCActivity: 20},
+			},
+		}
+	}
+	ga.accountJobs(map[string][]scheduler.Workload{"0": {job}}, []*amdgpu.GPU{newGPU(1e6)})
+	time.Sleep(10 * time.Millisecond)
+	ga.accountJobs(map[string][]scheduler.Workload{"0": {job}}, []*amdgpu.GPU{newGPU(3e6)})
+	_, err = ga.GetJobSummaries(nil, 0)
+	assert.NilError(t, err)
+
+	// a GPU without stats keeps the job running
+	noStats := newGPU(0)
+	noStats.Stats = nil
+	for i := 0; i < 3; i++ {
+		ga.accountJobs(map[string][]scheduler.Workload{"0": {job}}, []*amdgpu.GPU{noStats})
+	}
+	summaries, err := ga.GetJobSummaries([]string{"42"}, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, len(summaries), 0)
+
+	// job ended after missing from a few updates
+	for i := 0; i < 2; i++ {
+		ga.accountJobs(map[string][]scheduler.Workload{}, []*amdgpu.GPU{newGPU(4e6)})
+		summaries, err = ga.GetJobSummaries([]string{"42"}, 0)
+		assert.NilError(t, err)
+		assert.Equal(t, len(summaries), 0)
+	}
+	ga.accountJobs(map[string][]scheduler.Workload{}, []*amdgpu.GPU{newGPU(4e6)})
+	summaries, err = ga.GetJobSummaries([]string{"42"}, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, len(summaries), 1)
+	assert.Equal(t, summaries[0].User, "alice")
+	assert.Equal(t, summaries[0].EnergyConsumed, 2.0)
+	assert.Equal(t, summaries[0].PeakVRAMUsed, 1024.0)
+	assert.Equal(t, summaries[0].AverageGFXActivity, 80.0)
+
+	ga.updateJobMetrics()
+	assert.Equal(t, testutil.CollectAndCount(&ga.jm.Load().energyConsumed), 1)
+
+	// disabled from the config
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{}
+	err = ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	_, err = ga.GetJobSummaries(nil, 0)
+	assert.Assert(t, err != nil)
+}
+
+func TestGpuAgentSampler(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	root := t.TempDir()
+	devDir := filepath.Join(root, "class/drm/card0/device")
+	assert.NilError(t, os.MkdirAll(devDir, 0755))
+	assert.NilError(t, os.WriteFile(filepath.Join(devDir, "vendor"), []byte("0x1002\n"), 0644))
+	assert.NilError(t, os.WriteFile(filepath.Join(devDir, "uevent"), []byte("PCI_SLOT_NAME=0000:05:00.0\n"), 0644))
+	// gpu_metrics v1_3 table with the hotspot temperature and socket power
+	writeTable := func(hotspot, power uint16) {
+		table := make([]byte, 120)
+		binary.LittleEndian.PutUint16(table, 120)
+		table[2], table[3] = 1, 3
+		binary.LittleEndian.PutUint16(table[6:], hotspot)
+		binary.LittleEndian.PutUint16(table[22:], power)
+		assert.NilError(t, os.WriteFile(filepath.Join(devDir, "gpu_metrics"), table, 0644))
+	}
+
+	ga := getNewAgent(t)
+	ga.fsysDeviceHandler = fsysdevice.NewFsysDevice(root)
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		HighFrequencySampling: &exportermetrics.HighFrequencySamplingConfig{
+			Enable: true,
+			Fields: []string{"GPU_AVERAGE_PACKAGE_POWER", "gpu_junction_temperature", "INVALID_FIELD"},
+			// samples are taken by the test
+			IntervalMs: 3600 * 1000,
+			Quantiles:  []float64{0.5, 2},
+			Buckets: map[string]*exportermetrics.HistogramBuckets{
+				"GPU_JUNCTION_TEMPERATURE": {Bounds: []float64{90, 60}},
+			},
+		},
+	}
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Assert(t, ga.sm.Load() != nil)
+	defer ga.sm.Load().sampler.Stop()
+	assert.Equal(t, len(ga.sm.Load().fields), 2)
+	assert.DeepEqual(t, ga.sm.Load().quantiles, []float64{0.5})
+
+	gpu := &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{},
+		Status: &amdgpu.GPUStatus{
+			PCIeStatus: &amdgpu.GPUPCIeStatus{PCIeBusId: "0000:05:00.0"},
+		},
+	}
+	// the GPU of the samples is learnt from the first update
+	ga.updateSamplerMetrics([]*amdgpu.GPU{gpu})
+	for _, sample := range [][2]uint16{{50, 300}, {80, 750}, {65, 450}} {
+		writeTable(sample[0], sample[1])
+		ga.sm.Load().sampler.Sample()
+	}
+	ga.updateSamplerMetrics([]*amdgpu.GPU{gpu})
+
+	labels := ga.populateLabelsFromGPU(nil, nil, nil)
+	labels["gpu_id"] = "0"
+	power := ga.sm.Load().fields["GPU_AVERAGE_PACKAGE_POWER"]
+	assert.Equal(t, testutil.ToFloat64(power.min.With(labels)), 300.0)
+	assert.Equal(t, testutil.ToFloat64(power.max.With(labels)), 750.0)
+	assert.Equal(t, testutil.ToFloat64(power.avg.With(labels)), 500.0)
+	qLabels := maps.Clone(labels)
+	qLabels["quantile"] = "0.5"
+	assert.Equal(t, testutil.ToFloat64(power.quantile.With(qLabels)), 450.0)
+
+	temp := ga.sm.Load().fields["GPU_JUNCTION_TEMPERATURE"]
+	assert.Equal(t, testutil.ToFloat64(temp.max.With(labels)), 80.0)
+	m := &dto.Metric{}
+	assert.NilError(t, temp.histogram.With(labels).(prometheus.Metric).Write(m))
+	assert.Equal(t, m.GetHistogram().GetSampleCount(), uint64(3))
+	assert.Equal(t, m.GetHistogram().GetBucket()[0].GetUpperBound(), 60.0)
+	assert.Equal(t, m.GetHistogram().GetBucket()[0].GetCumulativeCount(), uint64(1))
+
+	// the statistics don't depend on the scrapes, the histogram is only
+	// updated by the samples
+	ga.updateSamplerMetrics([]*amdgpu.GPU{gpu})
+	assert.Equal(t, testutil.ToFloat64(power.max.With(labels)), 750.0)
+	m = &dto.Metric{}
+	assert.NilError(t, temp.histogram.With(labels).(prometheus.Metric).Write(m))
+	assert.Equal(t, m.GetHistogram().GetSampleCount(), uint64(3))
+
+	// disabled on reload
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{}
+	err = ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Assert(t, ga.sm.Load() == nil)
+
+	// stopped on close
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		HighFrequencySampling: &exportermetrics.HighFrequencySamplingConfig{Enable: true},
+	}
+	err = ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Assert(t, ga.sm.Load() != nil)
+	ga.Close()
+	assert.Assert(t, ga.sm.Load() == nil)
+}
+
+func TestGpuAgentPolling(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{
+		Polling: &exportermetrics.GPUAgentPollingConfig{
+			RefreshIntervalMs: 5000,
+			CacheTTLMs:        1000,
+		},
+	}
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	polling := ga.polling.Load()
+	assert.Equal(t, polling.refreshInterval, 5*time.Second)
+	assert.Equal(t, polling.queryTimeout, defaultQueryTimeout)
+	assert.Equal(t, polling.cacheTTL, time.Second)
+	assert.Equal(t, <-ga.refreshReset, 5*time.Second)
+
+	// the response is reused within the cache TTL
+	first, _, err := ga.getGPUs()
+	assert.NilError(t, err)
+	ts := ga.gCache.lastTimestamp
+	second, _, err := ga.getGPUs()
+	assert.NilError(t, err)
+	assert.Equal(t, ga.gCache.lastTimestamp, ts)
+	assert.Equal(t, len(first.Response), len(second.Response))
+	ages := ga.dataAges(ts.Add(3 * time.Second))
+	assert.Equal(t, ages["default"], 3*time.Second)
+
+	// defaults restored on reload
+	mConfig.GetConfig().GPUConfig = &exportermetrics.GPUMetricConfig{}
+	err = ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+	assert.Equal(t, <-ga.refreshReset, defaultRefreshInterval)
+	assert.Equal(t, ga.polling.Load().cacheTTL, defaultCacheTTL)
+
+	assert.NilError(t, ga.getMetricsAll())
+	labels := ga.populateLabelsFromGPU(nil, nil, nil)
+	labels["field_group"] = "default"
+	assert.Assert(t, testutil.ToFloat64(ga.m.gpuDataAge.With(labels)) < 60)
+}
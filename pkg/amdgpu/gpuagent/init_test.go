@@ -130,23 +130,27 @@ func setupTest(t *testing.T) func(t *testing.T) {
 }
 
 func newSlurmMockClient() scheduler.SchedulerClient {
-	workload := map[string]scheduler.Workload{
-		"0": scheduler.Workload{
-			Type: scheduler.Slurm,
-			Info: scheduler.JobInfo{
-				Id:        "SLURM_JOB_ID0",
-				User:      "SLURM_JOB_USER0",
-				Partition: "SLURM_JOB_PARTITION0",
-				Cluster:   "SLURM_CLUSTER_NAME0",
+	workload := map[string][]scheduler.Workload{
+		"0": {
+			{
+				Type: scheduler.Slurm,
+				Info: scheduler.JobInfo{
+					Id:        "SLURM_JOB_ID0",
+					User:      "SLURM_JOB_USER0",
+					Partition: "SLURM_JOB_PARTITION0",
+					Cluster:   "SLURM_CLUSTER_NAME0",
+				},
 			},
 		},
-		"1": scheduler.Workload{
-			Type: scheduler.Slurm,
-			Info: scheduler.JobInfo{
-				Id:        "SLURM_JOB_ID1",
-				User:      "SLURM_JOB_USER1",
-				Partition: "SLURM_JOB_PARTITION1",
-				Cluster:   "SLURM_CLUSTER_NAME",
+		"1": {
+			{
+				Type: scheduler.Slurm,
+				Info: scheduler.JobInfo{
+					Id:        "SLURM_JOB_ID1",
+					User:      "SLURM_JOB_USER1",
+					Partition: "SLURM_JOB_PARTITION1",
+					Cluster:   "SLURM_CLUSTER_NAME",
+				},
 			},
 		},
 	}
@@ -158,21 +162,25 @@ func newSlurmMockClient() scheduler.SchedulerClient {
 }
 
 func newK8sSchedulerMock() scheduler.SchedulerClient {
-	workload := map[string]scheduler.Workload{
-		"pcie0": scheduler.Workload{
-			Type: scheduler.Kubernetes,
-			Info: scheduler.PodResourceInfo{
-				Pod:       "pod0",
-				Namespace: "Namespace0",
-				Container: "ContainerName0",
+	workload := map[string][]scheduler.Workload{
+		"pcie0": {
+			{
+				Type: scheduler.Kubernetes,
+				Info: scheduler.PodResourceInfo{
+					Pod:       "pod0",
+					Namespace: "Namespace0",
+					Container: "ContainerName0",
+				},
 			},
 		},
-		"pcie1": scheduler.Workload{
-			Type: scheduler.Kubernetes,
-			Info: scheduler.PodResourceInfo{
-				Pod:       "pod1",
-				Namespace: "Namespace1",
-				Container: "ContainerName1",
+		"pcie1": {
+			{
+				Type: scheduler.Kubernetes,
+				Info: scheduler.PodResourceInfo{
+					Pod:       "pod1",
+					Namespace: "Namespace1",
+					Container: "ContainerName1",
+				},
 			},
 		},
 	}
@@ -0,0 +1,236 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpumetrics
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+// headerSize is the size of the metrics_table_header
+const headerSize = 4
+
+// energyUnit is the resolution of the energy accumulator in uJ
+const energyUnit = 15.259
+
+// Table is a decoded gpu_metrics table of the amdgpu driver
+type Table struct {
+	Size            uint16
+	FormatRevision  uint8
+	ContentRevision uint8
+	values          map[string][]uint64
+	sizes           map[string]int
+	arrays          map[string]bool
+}
+
+// Value is a sample of a gpu metric field, Index is the instance of the
+// field or -1 for the fields without instances
+type Value struct {
+	Field string
+	Index int
+	Value float64
+}
+
+// Read decodes the gpu_metrics table of the device directory
+// (/sys/class/drm/cardN/device)
+func Read(devDir string) (*Table, error) {
+	data, err := os.ReadFile(filepath.Join(devDir, "gpu_metrics"))
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
+
+// Decode decodes the gpu_metrics binary table, the layout is selected by
+// the format and content revision of the header
+func Decode(data []byte) (*Table, error) {
+	if len(data) < headerSize {
+		return nil, fmt.Errorf("gpu_metrics table too short, %v bytes", len(data))
+	}
+	t := &Table{
+		Size:            uint16(readUint(data, 2)),
+		FormatRevision:  data[2],
+		ContentRevision: data[3],
+		values:          map[string][]uint64{},
+		sizes:           map[string]int{},
+		arrays:          map[string]bool{},
+	}
+	layout, ok := layouts[[2]uint8{t.FormatRevision, t.ContentRevision}]
+	if !ok {
+		return nil, fmt.Errorf("unsupported gpu_metrics revision %v", t.Version())
+	}
+	if size := structSize(layout); int(t.Size) != size {
+		return nil, fmt.Errorf("gpu_metrics %v size mismatch, header %v expected %v",
+			t.Version(), t.Size, size)
+	}
+	if len(data) < int(t.Size) {
+		return nil, fmt.Errorf("gpu_metrics %v table truncated, %v of %v bytes",
+			t.Version(), len(data), t.Size)
+	}
+	decodeStruct(data, "", layout, t)
+	return t, nil
+}
+
+// Version returns the revision of the table as in the kernel struct name
+func (t *Table) Version() string {
+	return fmt.Sprintf("v%v_%v", t.FormatRevision, t.ContentRevision)
+}
+
+// Field returns the raw values of the kernel struct member, the members of
+// the nested structs are named parent.member
+func (t *Table) Field(name string) ([]uint64, bool) {
+	v, ok := t.values[name]
+	return v, ok
+}
+
+// mapping converts a struct member to a metric field
+type mapping struct {
+	member string
+	field  exportermetrics.GPUMetricField
+	scale  float64
+}
+
+// v1Mappings converts the members of the dGPU tables, temperatures are in
+// C and power in W
+var v1Mappings = []mapping{
+	{"temperature_edge", exportermetrics.GPUMetricField_GPU_EDGE_TEMPERATURE, 1},
+	{"temperature_hotspot", exportermetrics.GPUMetricField_GPU_JUNCTION_TEMPERATURE, 1},
+	{"temperature_mem", exportermetrics.GPUMetricField_GPU_MEMORY_TEMPERATURE, 1},
+	{"temperature_hbm", exportermetrics.GPUMetricField_GPU_HBM_TEMPERATURE, 1},
+	{"curr_socket_power", exportermetrics.GPUMetricField_GPU_PACKAGE_POWER, 1},
+	{"average_socket_power", exportermetrics.GPUMetricField_GPU_AVERAGE_PACKAGE_POWER, 1},
+	{"energy_accumulator", exportermetrics.GPUMetricField_GPU_ENERGY_CONSUMED, energyUnit},
+	{"average_gfx_activity", exportermetrics.GPUMetricField_GPU_GFX_ACTIVITY, 1},
+	{"average_umc_activity", exportermetrics.GPUMetricField_GPU_UMC_ACTIVITY, 1},
+	{"average_mm_activity", exportermetrics.GPUMetricField_GPU_MMA_ACTIVITY, 1},
+	{"vcn_activity", exportermetrics.GPUMetricField_GPU_VCN_ACTIVITY, 1},
+	{"jpeg_activity", exportermetrics.GPUMetricField_GPU_JPEG_ACTIVITY, 1},
+	{"voltage_soc", exportermetrics.GPUMetricField_GPU_VOLTAGE, 1},
+	{"voltage_gfx", exportermetrics.GPUMetricField_GPU_GFX_VOLTAGE, 1},
+	{"voltage_mem", exportermetrics.GPUMetricField_GPU_MEMORY_VOLTAGE, 1},
+	{"current_fan_speed", exportermetrics.GPUMetricField_GPU_FAN_SPEED, 1},
+	// link speed in 0.1 GT/s
+	{"pcie_link_speed", exportermetrics.GPUMetricField_PCIE_SPEED, 0.1},
+	// instantaneous bandwidth in GB/s, exported in Mb/s
+	{"pcie_bandwidth_inst", exportermetrics.GPUMetricField_PCIE_BANDWIDTH, 8000},
+	{"pcie_bandwidth_acc", exportermetrics.GPUMetricField_PCIE_BIDIRECTIONAL_BANDWIDTH, 1},
+	{"pcie_l0_to_recov_count_acc", exportermetrics.GPUMetricField_PCIE_RECOVERY_COUNT, 1},
+	{"pcie_replay_count_acc", exportermetrics.GPUMetricField_PCIE_REPLAY_COUNT, 1},
+	{"pcie_replay_rover_count_acc", exportermetrics.GPUMetricField_PCIE_REPLAY_ROLLOVER_COUNT, 1},
+	{"pcie_nak_sent_count_acc", exportermetrics.GPUMetricField_PCIE_NACK_SENT_COUNT, 1},
+	{"pcie_nak_rcvd_count_acc", exportermetrics.GPUMetricField_PCIE_NACK_RECEIVED_COUNT, 1},
+	{"xgmi_read_data_acc", exportermetrics.GPUMetricField_GPU_XGMI_LINK_RX, 1},
+	{"xgmi_write_data_acc", exportermetrics.GPUMetricField_GPU_XGMI_LINK_TX, 1},
+	{"accumulation_counter", exportermetrics.GPUMetricField_GPU_VIOLATION_CURRENT_ACCUMULATED_COUNTER, 1},
+	{"prochot_residency_acc", exportermetrics.GPUMetricField_GPU_VIOLATION_PROCESSOR_HOT_RESIDENCY_ACCUMULATED, 1},
+	{"ppt_residency_acc", exportermetrics.GPUMetricField_GPU_VIOLATION_PPT_RESIDENCY_ACCUMULATED, 1},
+	{"socket_thm_residency_acc", exportermetrics.GPUMetricField_GPU_VIOLATION_SOCKET_THERMAL_RESIDENCY_ACCUMULATED, 1},
+	{"vr_thm_residency_acc", exportermetrics.GPUMetricField_GPU_VIOLATION_VR_THERMAL_RESIDENCY_ACCUMULATED, 1},
+	{"hbm_thm_residency_acc", exportermetrics.GPUMetricField_GPU_VIOLATION_HBM_THERMAL_RESIDENCY_ACCUMULATED, 1},
+	{"xcp_stats.gfx_busy_inst", exportermetrics.GPUMetricField_GPU_GFX_BUSY_INSTANTANEOUS, 1},
+	{"xcp_stats.vcn_busy", exportermetrics.GPUMetricField_GPU_VCN_BUSY_INSTANTANEOUS, 1},
+	{"xcp_stats.jpeg_busy", exportermetrics.GPUMetricField_GPU_JPEG_BUSY_INSTANTANEOUS, 1},
+}
+
+// v2Mappings converts the members of the APU tables, temperatures are in
+// centi C, power in mW and voltages in mV
+var v2Mappings = []mapping{
+	{"temperature_gfx", exportermetrics.GPUMetricField_GPU_EDGE_TEMPERATURE, 0.01},
+	{"average_socket_power", exportermetrics.GPUMetricField_GPU_AVERAGE_PACKAGE_POWER, 0.001},
+	{"average_gfx_activity", exportermetrics.GPUMetricField_GPU_GFX_ACTIVITY, 1},
+	{"average_mm_activity", exportermetrics.GPUMetricField_GPU_MMA_ACTIVITY, 1},
+	{"average_vcn_activity", exportermetrics.GPUMetricField_GPU_VCN_ACTIVITY, 1},
+	{"average_soc_voltage", exportermetrics.GPUMetricField_GPU_VOLTAGE, 1},
+	{"average_gfx_voltage", exportermetrics.GPUMetricField_GPU_GFX_VOLTAGE, 1},
+}
+
+// Values returns the members of the table as metric fields in the units
+// exported by the gpuagent, members not supported by the firmware are set
+// to all ones and skipped. The package power is the average socket power
+// on the tables without the current socket power.
+func (t *Table) Values() []Value {
+	mappings := v1Mappings
+	if t.FormatRevision > 1 {
+		mappings = v2Mappings
+	}
+	values := []Value{}
+	for _, m := range mappings {
+		raw, ok := t.values[m.member]
+		if !ok {
+			continue
+		}
+		raw = t.activeInstances(m.member, raw)
+		for i, v := range raw {
+			if v == allOnes(t.sizes[m.member]) {
+				continue
+			}
+			index := i
+			if !t.arrays[m.member] {
+				index = -1
+			}
+			values = append(values, Value{
+				Field: m.field.String(),
+				Index: index,
+				Value: float64(v) * m.scale,
+			})
+		}
+	}
+	return packagePowerFallback(values)
+}
+
+// packagePowerFallback reports the average power as the package power when
+// the current power is not in the table, the average power is the only
+// power reported by the older devices
+func packagePowerFallback(values []Value) []Value {
+	power := exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String()
+	avgPower := exportermetrics.GPUMetricField_GPU_AVERAGE_PACKAGE_POWER.String()
+	for _, v := range values {
+		if v.Field == power {
+			return values
+		}
+	}
+	for _, v := range values {
+		if v.Field == avgPower {
+			v.Field = power
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// activeInstances limits the per partition statistics to the partitions
+// reported in num_partition
+func (t *Table) activeInstances(member string, raw []uint64) []uint64 {
+	partitions, ok := t.values["num_partition"]
+	if !ok || !strings.HasPrefix(member, "xcp_stats.") || len(raw)%numXCP != 0 {
+		return raw
+	}
+	n := min(int(partitions[0]), numXCP) * len(raw) / numXCP
+	return raw[:n]
+}
+
+func allOnes(size int) uint64 {
+	if size >= 8 {
+		return math.MaxUint64
+	}
+	return 1<<(8*size) - 1
+}
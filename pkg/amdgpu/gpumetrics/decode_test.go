@@ -0,0 +1,251 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpumetrics
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+// fixture is a gpu_metrics table with the members written at the offsets
+// of the kernel structs
+type fixture []byte
+
+func newFixture(size int, format, content uint8) fixture {
+	f := make(fixture, size)
+	binary.LittleEndian.PutUint16(f, uint16(size))
+	f[2] = format
+	f[3] = content
+	return f
+}
+
+func (f fixture) u16(offset int, v uint16) fixture {
+	binary.LittleEndian.PutUint16(f[offset:], v)
+	return f
+}
+
+func (f fixture) u32(offset int, v uint32) fixture {
+	binary.LittleEndian.PutUint32(f[offset:], v)
+	return f
+}
+
+func (f fixture) u64(offset int, v uint64) fixture {
+	binary.LittleEndian.PutUint64(f[offset:], v)
+	return f
+}
+
+func valueMap(values []Value) map[string]map[int]float64 {
+	m := map[string]map[int]float64{}
+	for _, v := range values {
+		if m[v.Field] == nil {
+			m[v.Field] = map[int]float64{}
+		}
+		m[v.Field][v.Index] = v.Value
+	}
+	return m
+}
+
+func TestLayoutSizes(t *testing.T) {
+	sizes := map[[2]uint8]int{
+		{1, 0}: 80,
+		{1, 3}: 120,
+		{1, 4}: 288,
+		{1, 6}: 1664,
+		{2, 1}: 120,
+		{3, 0}: 256,
+	}
+	for rev, size := range sizes {
+		assert.Equal(t, structSize(layouts[rev]), size, "v%v_%v", rev[0], rev[1])
+	}
+}
+
+func TestDecodeV1_0(t *testing.T) {
+	data := newFixture(80, 1, 0).
+		u64(8, 12345).
+		u16(16, 40).   // temperature_edge
+		u16(18, 55).   // temperature_hotspot
+		u16(28, 80).   // average_gfx_activity
+		u16(34, 150).  // average_socket_power
+		u32(36, 1000). // energy_accumulator
+		u32(68, 0x4).  // throttle_status
+		u16(72, 1200)  // current_fan_speed
+	data[75] = 160 // pcie_link_speed
+
+	table, err := Decode(data)
+	assert.NilError(t, err)
+	assert.Equal(t, table.Version(), "v1_0")
+	raw, ok := table.Field("system_clock_counter")
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, raw, []uint64{12345})
+	raw, _ = table.Field("throttle_status")
+	assert.DeepEqual(t, raw, []uint64{4})
+
+	values := valueMap(table.Values())
+	assert.Equal(t, values["GPU_EDGE_TEMPERATURE"][-1], 40.0)
+	assert.Equal(t, values["GPU_JUNCTION_TEMPERATURE"][-1], 55.0)
+	assert.Equal(t, values["GPU_GFX_ACTIVITY"][-1], 80.0)
+	assert.Equal(t, values["GPU_AVERAGE_PACKAGE_POWER"][-1], 150.0)
+	// no current socket power in v1_0
+	assert.Equal(t, values["GPU_PACKAGE_POWER"][-1], 150.0)
+	assert.Equal(t, values["GPU_ENERGY_CONSUMED"][-1], 1000*energyUnit)
+	assert.Equal(t, values["GPU_FAN_SPEED"][-1], 1200.0)
+	assert.Equal(t, values["PCIE_SPEED"][-1], 16.0)
+}
+
+func TestDecodeV1_3(t *testing.T) {
+	data := newFixture(120, 1, 3).
+		u16(4, 35).          // temperature_edge
+		u16(88, 60).         // temperature_hbm[0]
+		u16(90, 0xffff).     // temperature_hbm[1] not supported
+		u16(92, 62).         // temperature_hbm[2]
+		u16(94, 0xffff).     // temperature_hbm[3] not supported
+		u16(104, 850).       // voltage_soc
+		u16(106, 700).       // voltage_gfx
+		u16(108, 1200).      // voltage_mem
+		u64(112, 1<<32|0x10) // indep_throttle_status
+
+	table, err := Decode(data)
+	assert.NilError(t, err)
+	raw, _ := table.Field("indep_throttle_status")
+	assert.DeepEqual(t, raw, []uint64{1<<32 | 0x10})
+
+	values := valueMap(table.Values())
+	assert.Equal(t, values["GPU_EDGE_TEMPERATURE"][-1], 35.0)
+	assert.DeepEqual(t, values["GPU_HBM_TEMPERATURE"], map[int]float64{0: 60, 2: 62})
+	assert.Equal(t, values["GPU_VOLTAGE"][-1], 850.0)
+	assert.Equal(t, values["GPU_GFX_VOLTAGE"][-1], 700.0)
+	assert.Equal(t, values["GPU_MEMORY_VOLTAGE"][-1], 1200.0)
+}
+
+func TestDecodeV1_6(t *testing.T) {
+	data := newFixture(1664, 1, 6).
+		u16(4, 70).          // temperature_hotspot
+		u16(6, 65).          // temperature_mem
+		u16(10, 550).        // curr_socket_power
+		u16(12, 90).         // average_gfx_activity
+		u16(14, 30).         // average_umc_activity
+		u64(16, 2000).       // energy_accumulator
+		u32(32, 100).        // accumulation_counter
+		u32(40, 25).         // ppt_residency_acc
+		u64(80, 500).        // pcie_bandwidth_acc
+		u64(88, 2).          // pcie_bandwidth_inst
+		u64(104, 3).         // pcie_replay_count_acc
+		u32(120, 4).         // pcie_nak_sent_count_acc
+		u32(124, 5).         // pcie_nak_rcvd_count_acc
+		u64(128, 1024).      // xgmi_read_data_acc[0]
+		u64(192+7*8, 2048).  // xgmi_write_data_acc[7]
+		u16(62, 320).        // pcie_link_speed
+		u16(306, 2).         // num_partition
+		u32(312, 95).        // xcp_stats[0].gfx_busy_inst[0]
+		u32(312+4, 85).      // xcp_stats[0].gfx_busy_inst[1]
+		u16(312+32, 10).     // xcp_stats[0].jpeg_busy[0]
+		u16(312+96, 20).     // xcp_stats[0].vcn_busy[0]
+		u32(312+168, 75).    // xcp_stats[1].gfx_busy_inst[0]
+		u16(312+168+96, 40). // xcp_stats[1].vcn_busy[0]
+		u32(312+2*168, 99).  // xcp_stats[2] beyond num_partition
+		u32(1656, 7)         // pcie_lc_perf_other_end_recovery
+
+	table, err := Decode(data)
+	assert.NilError(t, err)
+	raw, _ := table.Field("xcp_stats.gfx_busy_inst")
+	assert.Equal(t, len(raw), numXCP*maxXCC)
+	assert.Equal(t, raw[2*maxXCC], uint64(99))
+	raw, _ = table.Field("pcie_lc_perf_other_end_recovery")
+	assert.DeepEqual(t, raw, []uint64{7})
+
+	values := valueMap(table.Values())
+	assert.Equal(t, values["GPU_JUNCTION_TEMPERATURE"][-1], 70.0)
+	assert.Equal(t, values["GPU_MEMORY_TEMPERATURE"][-1], 65.0)
+	assert.Equal(t, values["GPU_PACKAGE_POWER"][-1], 550.0)
+	assert.Equal(t, values["GPU_UMC_ACTIVITY"][-1], 30.0)
+	assert.Equal(t, values["GPU_ENERGY_CONSUMED"][-1], 2000*energyUnit)
+	assert.Equal(t, values["GPU_VIOLATION_CURRENT_ACCUMULATED_COUNTER"][-1], 100.0)
+	assert.Equal(t, values["GPU_VIOLATION_PPT_RESIDENCY_ACCUMULATED"][-1], 25.0)
+	assert.Equal(t, values["PCIE_BIDIRECTIONAL_BANDWIDTH"][-1], 500.0)
+	assert.Equal(t, values["PCIE_BANDWIDTH"][-1], 16000.0)
+	assert.Equal(t, values["PCIE_REPLAY_COUNT"][-1], 3.0)
+	assert.Equal(t, values["PCIE_NACK_SENT_COUNT"][-1], 4.0)
+	assert.Equal(t, values["PCIE_NACK_RECEIVED_COUNT"][-1], 5.0)
+	assert.Equal(t, values["PCIE_SPEED"][-1], 32.0)
+	assert.Equal(t, values["GPU_XGMI_LINK_RX"][0], 1024.0)
+	assert.Equal(t, values["GPU_XGMI_LINK_TX"][7], 2048.0)
+
+	// only the instances of the active partitions are reported
+	gfxBusy := values["GPU_GFX_BUSY_INSTANTANEOUS"]
+	assert.Equal(t, len(gfxBusy), 2*maxXCC)
+	assert.Equal(t, gfxBusy[0], 95.0)
+	assert.Equal(t, gfxBusy[1], 85.0)
+	assert.Equal(t, gfxBusy[maxXCC], 75.0)
+	assert.Equal(t, values["GPU_JPEG_BUSY_INSTANTANEOUS"][0], 10.0)
+	assert.Equal(t, values["GPU_VCN_BUSY_INSTANTANEOUS"][0], 20.0)
+	assert.Equal(t, values["GPU_VCN_BUSY_INSTANTANEOUS"][numVCN], 40.0)
+	assert.Equal(t, len(values["GPU_VCN_BUSY_INSTANTANEOUS"]), 2*numVCN)
+}
+
+func TestDecodeV2V3(t *testing.T) {
+	data := newFixture(120, 2, 1).
+		u16(4, 4550).  // temperature_gfx
+		u16(28, 12).   // average_gfx_activity
+		u64(32, 999).  // system_clock_counter
+		u16(40, 15000) // average_socket_power
+	table, err := Decode(data)
+	assert.NilError(t, err)
+	values := valueMap(table.Values())
+	assert.Equal(t, values["GPU_EDGE_TEMPERATURE"][-1], 45.5)
+	assert.Equal(t, values["GPU_GFX_ACTIVITY"][-1], 12.0)
+	assert.Equal(t, values["GPU_AVERAGE_PACKAGE_POWER"][-1], 15.0)
+	assert.Equal(t, values["GPU_PACKAGE_POWER"][-1], 15.0)
+
+	data = newFixture(256, 3, 0).
+		u16(4, 5000).   // temperature_gfx
+		u16(44, 33).    // average_vcn_activity
+		u64(104, 1).    // system_clock_counter
+		u32(112, 25000) // average_socket_power
+	table, err = Decode(data)
+	assert.NilError(t, err)
+	values = valueMap(table.Values())
+	assert.Equal(t, values["GPU_EDGE_TEMPERATURE"][-1], 50.0)
+	assert.Equal(t, values["GPU_VCN_ACTIVITY"][-1], 33.0)
+	_, ok := values["GPU_MMA_ACTIVITY"]
+	assert.Assert(t, !ok)
+	assert.Equal(t, values["GPU_AVERAGE_PACKAGE_POWER"][-1], 25.0)
+	raw, _ := table.Field("system_clock_counter")
+	assert.DeepEqual(t, raw, []uint64{1})
+}
+
+func TestDecodeErrors(t *testing.T) {
+	_, err := Decode([]byte{1, 2})
+	assert.ErrorContains(t, err, "too short")
+	_, err = Decode(newFixture(80, 9, 0))
+	assert.ErrorContains(t, err, "unsupported gpu_metrics revision v9_0")
+	_, err = Decode(newFixture(100, 1, 0))
+	assert.ErrorContains(t, err, "size mismatch")
+	_, err = Decode(newFixture(80, 1, 0)[:40])
+	assert.ErrorContains(t, err, "truncated")
+
+	dir := t.TempDir()
+	_, err = Read(dir)
+	assert.Assert(t, os.IsNotExist(err))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "gpu_metrics"), newFixture(120, 1, 3), 0644))
+	table, err := Read(dir)
+	assert.NilError(t, err)
+	assert.Equal(t, table.Version(), "v1_3")
+}
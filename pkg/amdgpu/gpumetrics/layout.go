@@ -0,0 +1,416 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpumetrics
+
+import "encoding/binary"
+
+// instance counts of the kernel gpu_metrics arrays
+const (
+	numHBMInstances = 4
+	numVCN          = 4
+	numJPEGEng      = 32
+	numXGMILinks    = 8
+	maxGFXClks      = 8
+	maxClks         = 4
+	numXCP          = 8
+	maxXCC          = 8
+)
+
+// field is a member of a gpu_metrics struct, fields of a nested struct
+// are set for the struct members
+type field struct {
+	name   string
+	size   int
+	count  int
+	fields []field
+}
+
+func u8(name string) field  { return field{name: name, size: 1, count: 1} }
+func u16(name string) field { return field{name: name, size: 2, count: 1} }
+func u32(name string) field { return field{name: name, size: 4, count: 1} }
+func u64(name string) field { return field{name: name, size: 8, count: 1} }
+
+func u16s(name string, count int) field { return field{name: name, size: 2, count: count} }
+func u32s(name string, count int) field { return field{name: name, size: 4, count: count} }
+func u64s(name string, count int) field { return field{name: name, size: 8, count: count} }
+
+func structs(name string, count int, fields ...field) field {
+	return field{name: name, count: count, fields: fields}
+}
+
+func (f field) align() int {
+	if f.fields == nil {
+		return f.size
+	}
+	return structAlign(f.fields)
+}
+
+// elemSize is the size of an element including the trailing padding of
+// the structs
+func (f field) elemSize() int {
+	if f.fields == nil {
+		return f.size
+	}
+	return structSize(f.fields)
+}
+
+func alignUp(offset, align int) int {
+	return (offset + align - 1) / align * align
+}
+
+func structAlign(fields []field) int {
+	align := 1
+	for _, f := range fields {
+		align = max(align, f.align())
+	}
+	return align
+}
+
+// structSize returns the size of the struct with the natural alignment of
+// its members, the gpu_metrics structs are not packed
+func structSize(fields []field) int {
+	offset := 0
+	for _, f := range fields {
+		offset = alignUp(offset, f.align()) + f.elemSize()*f.count
+	}
+	return alignUp(offset, structAlign(fields))
+}
+
+// decodeStruct reads the members of the struct, the members of the
+// nested structs are named parent.member with the elements of all the
+// parent instances
+func decodeStruct(data []byte, prefix string, fields []field, t *Table) {
+	offset := 0
+	for _, f := range fields {
+		offset = alignUp(offset, f.align())
+		name := prefix + f.name
+		for i := 0; i < f.count; i++ {
+			elem := data[offset+i*f.elemSize():]
+			if f.fields != nil {
+				decodeStruct(elem, name+".", f.fields, t)
+				continue
+			}
+			t.values[name] = append(t.values[name], readUint(elem, f.size))
+			t.sizes[name] = f.size
+		}
+		if f.count > 1 || prefix != "" {
+			t.arrays[name] = true
+		}
+		offset += f.elemSize() * f.count
+	}
+}
+
+func readUint(data []byte, size int) uint64 {
+	switch size {
+	case 1:
+		return uint64(data[0])
+	case 2:
+		return uint64(binary.LittleEndian.Uint16(data))
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(data))
+	default:
+		return binary.LittleEndian.Uint64(data)
+	}
+}
+
+// metrics_table_header
+var header = []field{
+	u16("structure_size"),
+	u8("format_revision"),
+	u8("content_revision"),
+}
+
+func with(parts ...[]field) []field {
+	fields := []field{}
+	for _, p := range parts {
+		fields = append(fields, p...)
+	}
+	return fields
+}
+
+// members shared by the gpu_metrics_v1_x layouts
+var (
+	v1Temperatures = []field{
+		u16("temperature_edge"),
+		u16("temperature_hotspot"),
+		u16("temperature_mem"),
+		u16("temperature_vrgfx"),
+		u16("temperature_vrsoc"),
+		u16("temperature_vrmem"),
+	}
+	v1Activity = []field{
+		u16("average_gfx_activity"),
+		u16("average_umc_activity"),
+		u16("average_mm_activity"),
+	}
+	v1Clocks = []field{
+		u16("average_gfxclk_frequency"),
+		u16("average_socclk_frequency"),
+		u16("average_uclk_frequency"),
+		u16("average_vclk0_frequency"),
+		u16("average_dclk0_frequency"),
+		u16("average_vclk1_frequency"),
+		u16("average_dclk1_frequency"),
+		u16("current_gfxclk"),
+		u16("current_socclk"),
+		u16("current_uclk"),
+		u16("current_vclk0"),
+		u16("current_dclk0"),
+		u16("current_vclk1"),
+		u16("current_dclk1"),
+	}
+	// arcturus and aldebaran members of v1_1 to v1_3
+	v1_1 = with(header, v1Temperatures, v1Activity, []field{
+		u16("average_socket_power"),
+		u64("energy_accumulator"),
+		u64("system_clock_counter"),
+	}, v1Clocks, []field{
+		u32("throttle_status"),
+		u16("current_fan_speed"),
+		u16("pcie_link_width"),
+		u16("pcie_link_speed"),
+		u16("padding"),
+		u32("gfx_activity_acc"),
+		u32("mem_activity_acc"),
+		u16s("temperature_hbm", numHBMInstances),
+	})
+	v1_2 = with(v1_1, []field{
+		u64("firmware_timestamp"),
+	})
+	// current clocks and pcie/xgmi members of the v1_4 and later layouts
+	v1CurrentClocks = []field{
+		u64("firmware_timestamp"),
+		u16s("current_gfxclk", maxGFXClks),
+		u16s("current_socclk", maxClks),
+		u16s("current_vclk0", maxClks),
+		u16s("current_dclk0", maxClks),
+		u16("current_uclk"),
+	}
+	v1Links = []field{
+		u16("pcie_link_width"),
+		u16("pcie_link_speed"),
+		u16("xgmi_link_width"),
+		u16("xgmi_link_speed"),
+		u32("gfx_activity_acc"),
+		u32("mem_activity_acc"),
+		u64("pcie_bandwidth_acc"),
+		u64("pcie_bandwidth_inst"),
+		u64("pcie_l0_to_recov_count_acc"),
+		u64("pcie_replay_count_acc"),
+		u64("pcie_replay_rover_count_acc"),
+	}
+	v1XGMIData = []field{
+		u64s("xgmi_read_data_acc", numXGMILinks),
+		u64s("xgmi_write_data_acc", numXGMILinks),
+	}
+	v1PCIeNAK = []field{
+		u32("pcie_nak_sent_count_acc"),
+		u32("pcie_nak_rcvd_count_acc"),
+	}
+)
+
+// members shared by the gpu_metrics_v2_x layouts of the APUs
+var (
+	v2Temperatures = []field{
+		u16("temperature_gfx"),
+		u16("temperature_soc"),
+		u16s("temperature_core", 8),
+		u16s("temperature_l3", 2),
+	}
+	v2Activity = []field{
+		u16("average_gfx_activity"),
+		u16("average_mm_activity"),
+	}
+	v2PowerClocks = []field{
+		u16("average_socket_power"),
+		u16("average_cpu_power"),
+		u16("average_soc_power"),
+		u16("average_gfx_power"),
+		u16s("average_core_power", 8),
+		u16("average_gfxclk_frequency"),
+		u16("average_socclk_frequency"),
+		u16("average_uclk_frequency"),
+		u16("average_fclk_frequency"),
+		u16("average_vclk_frequency"),
+		u16("average_dclk_frequency"),
+		u16("current_gfxclk"),
+		u16("current_socclk"),
+		u16("current_uclk"),
+		u16("current_fclk"),
+		u16("current_vclk"),
+		u16("current_dclk"),
+		u16s("current_coreclk", 8),
+		u16s("current_l3clk", 2),
+		u32("throttle_status"),
+		u16("fan_pwm"),
+	}
+	v2_1 = with(header, v2Temperatures, v2Activity, []field{
+		u64("system_clock_counter"),
+	}, v2PowerClocks, []field{
+		u16s("padding", 3),
+	})
+	v2_2 = with(v2_1, []field{
+		u64("indep_throttle_status"),
+	})
+	v2_3 = with(v2_2, []field{
+		u16("average_temperature_gfx"),
+		u16("average_temperature_soc"),
+		u16s("average_temperature_core", 8),
+		u16s("average_temperature_l3", 2),
+	})
+)
+
+// layouts of the published gpu_metrics revisions keyed by format and
+// content revision
+var layouts = map[[2]uint8][]field{
+	{1, 0}: with(header, []field{
+		u64("system_clock_counter"),
+	}, v1Temperatures, v1Activity, []field{
+		u16("average_socket_power"),
+		u32("energy_accumulator"),
+	}, v1Clocks, []field{
+		u32("throttle_status"),
+		u16("current_fan_speed"),
+		u8("pcie_link_width"),
+		u8("pcie_link_speed"),
+	}),
+	{1, 1}: v1_1,
+	{1, 2}: v1_2,
+	{1, 3}: with(v1_2, []field{
+		u16("voltage_soc"),
+		u16("voltage_gfx"),
+		u16("voltage_mem"),
+		u16("padding1"),
+		u64("indep_throttle_status"),
+	}),
+	{1, 4}: with(header, []field{
+		u16("temperature_hotspot"),
+		u16("temperature_mem"),
+		u16("temperature_vrsoc"),
+		u16("curr_socket_power"),
+		u16("average_gfx_activity"),
+		u16("average_umc_activity"),
+		u16s("vcn_activity", numVCN),
+		u64("energy_accumulator"),
+		u64("system_clock_counter"),
+		u32("throttle_status"),
+		u32("gfxclk_lock_status"),
+	}, v1Links, v1XGMIData, v1CurrentClocks, []field{
+		u16("padding"),
+	}),
+	{1, 5}: with(header, []field{
+		u16("temperature_hotspot"),
+		u16("temperature_mem"),
+		u16("temperature_vrsoc"),
+		u16("curr_socket_power"),
+		u16("average_gfx_activity"),
+		u16("average_umc_activity"),
+		u16s("vcn_activity", numVCN),
+		u16s("jpeg_activity", numJPEGEng),
+		u64("energy_accumulator"),
+		u64("system_clock_counter"),
+		u32("throttle_status"),
+		u32("gfxclk_lock_status"),
+	}, v1Links, v1PCIeNAK, v1XGMIData, v1CurrentClocks, []field{
+		u16("padding"),
+	}),
+	{1, 6}: with(header, []field{
+		u16("temperature_hotspot"),
+		u16("temperature_mem"),
+		u16("temperature_vrsoc"),
+		u16("curr_socket_power"),
+		u16("average_gfx_activity"),
+		u16("average_umc_activity"),
+		u64("energy_accumulator"),
+		u64("system_clock_counter"),
+		u32("accumulation_counter"),
+		u32("prochot_residency_acc"),
+		u32("ppt_residency_acc"),
+		u32("socket_thm_residency_acc"),
+		u32("vr_thm_residency_acc"),
+		u32("hbm_thm_residency_acc"),
+		u32("gfxclk_lock_status"),
+	}, v1Links, v1PCIeNAK, v1XGMIData, v1CurrentClocks, []field{
+		u16("num_partition"),
+		structs("xcp_stats", numXCP,
+			u32s("gfx_busy_inst", maxXCC),
+			u16s("jpeg_busy", numJPEGEng),
+			u16s("vcn_busy", numVCN),
+			u64s("gfx_busy_acc", maxXCC),
+		),
+		u32("pcie_lc_perf_other_end_recovery"),
+	}),
+	{2, 0}: with(header, []field{
+		u64("system_clock_counter"),
+	}, v2Temperatures, v2Activity, v2PowerClocks, []field{
+		u16("padding"),
+	}),
+	{2, 1}: v2_1,
+	{2, 2}: v2_2,
+	{2, 3}: v2_3,
+	{2, 4}: with(v2_3, []field{
+		u16("average_cpu_voltage"),
+		u16("average_soc_voltage"),
+		u16("average_gfx_voltage"),
+		u16("average_cpu_current"),
+		u16("average_soc_current"),
+		u16("average_gfx_current"),
+	}),
+	{3, 0}: with(header, []field{
+		u16("temperature_gfx"),
+		u16("temperature_soc"),
+		u16s("temperature_core", 16),
+		u16("temperature_skin"),
+		u16("average_gfx_activity"),
+		u16("average_vcn_activity"),
+		u16s("average_ipu_activity", 8),
+		u16s("average_core_c0_activity", 16),
+		u16("average_dram_reads"),
+		u16("average_dram_writes"),
+		u16("average_ipu_reads"),
+		u16("average_ipu_writes"),
+		u64("system_clock_counter"),
+		u32("average_socket_power"),
+		u16("average_ipu_power"),
+		u32("average_apu_power"),
+		u32("average_gfx_power"),
+		u32("average_dgpu_power"),
+		u32("average_all_core_power"),
+		u16s("average_core_power", 16),
+		u16("stapm_power_limit"),
+		u16("current_stapm_power_limit"),
+		u16("average_gfxclk_frequency"),
+		u16("average_socclk_frequency"),
+		u16("average_vpeclk_frequency"),
+		u16("average_ipuclk_frequency"),
+		u16("average_fclk_frequency"),
+		u16("average_vclk_frequency"),
+		u16("average_uclk_frequency"),
+		u16("average_mpipu_frequency"),
+		u16s("current_coreclk", 16),
+		u16("current_core_maxfreq"),
+		u16("current_gfx_maxfreq"),
+		u32("throttle_residency_prochot"),
+		u32("throttle_residency_spl"),
+		u32("throttle_residency_fppt"),
+		u32("throttle_residency_sppt"),
+		u32("throttle_residency_thm_core"),
+		u32("throttle_residency_thm_gfx"),
+		u32("throttle_residency_thm_soc"),
+		u32("time_filter_alphavalue"),
+	}),
+}
@@ -0,0 +1,190 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package healthhistory
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Store is a bounded append only log of the GPU health transitions backed
+// by a file, one json encoded entry per line
+type Store struct {
+	sync.Mutex
+	filePath   string
+	maxEntries int
+	entries    []*metricssvc.GPUHealthHistoryEntry
+	// number of lines in the file, the file is compacted to the latest
+	// maxEntries once it grows to twice the limit
+	fileEntries int
+}
+
+// NewStore creates the history store and loads the existing entries of the
+// file, an unreadable file leaves the store empty
+func NewStore(filePath string, maxEntries int) *Store {
+	if maxEntries <= 0 {
+		maxEntries = 1
+	}
+	s := &Store{
+		filePath:   filePath,
+		maxEntries: maxEntries,
+		entries:    []*metricssvc.GPUHealthHistoryEntry{},
+	}
+	if err := s.load(); err != nil {
+		logger.Log.Printf("health history load from %v failed, err: %v", filePath, err)
+	}
+	return s
+}
+
+// FilePath returns the file backing the store
+func (s *Store) FilePath() string {
+	return s.filePath
+}
+
+// MaxEntries returns the number of the latest entries kept
+func (s *Store) MaxEntries() int {
+	return s.maxEntries
+}
+
+func (s *Store) load() error {
+	data, err := os.ReadFile(s.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		s.fileEntries++
+		entry := &metricssvc.GPUHealthHistoryEntry{}
+		if err := protojson.Unmarshal(line, entry); err != nil {
+			// skip the partially written entries
+			logger.Log.Printf("health history skipping invalid entry, err: %v", err)
+			continue
+		}
+		s.entries = append(s.entries, entry)
+	}
+	if len(s.entries) > s.maxEntries {
+		s.entries = s.entries[len(s.entries)-s.maxEntries:]
+	}
+	return scanner.Err()
+}
+
+// Append adds the entry to the store and the backing file
+func (s *Store) Append(entry *metricssvc.GPUHealthHistoryEntry) error {
+	s.Lock()
+	defer s.Unlock()
+	s.entries = append(s.entries, entry)
+	if len(s.entries) > s.maxEntries {
+		s.entries = s.entries[len(s.entries)-s.maxEntries:]
+	}
+	if s.fileEntries+1 >= 2*s.maxEntries {
+		return s.compact()
+	}
+	line, err := protojson.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.filePath), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	s.fileEntries++
+	return nil
+}
+
+// compact rewrites the file with the entries in memory
+func (s *Store) compact() error {
+	var buf bytes.Buffer
+	for _, entry := range s.entries {
+		line, err := protojson.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	if err := os.MkdirAll(filepath.Dir(s.filePath), 0755); err != nil {
+		return err
+	}
+	tmpFile := s.filePath + ".tmp"
+	if err := os.WriteFile(tmpFile, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, s.filePath); err != nil {
+		return fmt.Errorf("health history compaction failed, err: %v", err)
+	}
+	s.fileEntries = len(s.entries)
+	return nil
+}
+
+// Query returns the entries matching the request filters ordered by time
+func (s *Store) Query(req *metricssvc.GPUHealthHistoryRequest) []*metricssvc.GPUHealthHistoryEntry {
+	ids := map[string]bool{}
+	for _, id := range req.GetID() {
+		ids[id] = true
+	}
+	states := map[string]bool{}
+	for _, state := range req.GetHealth() {
+		states[strings.ToLower(state)] = true
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	resp := []*metricssvc.GPUHealthHistoryEntry{}
+	for _, entry := range s.entries {
+		if len(ids) > 0 && !ids[entry.ID] {
+			continue
+		}
+		if len(states) > 0 && !states[strings.ToLower(entry.Health)] {
+			continue
+		}
+		if req.GetStartTime() != nil && entry.Time.AsTime().Before(req.GetStartTime().AsTime()) {
+			continue
+		}
+		if req.GetEndTime() != nil && entry.Time.AsTime().After(req.GetEndTime().AsTime()) {
+			continue
+		}
+		resp = append(resp, entry)
+	}
+	if req.GetLimit() > 0 && len(resp) > int(req.GetLimit()) {
+		resp = resp[len(resp)-int(req.GetLimit()):]
+	}
+	return resp
+}
@@ -0,0 +1,80 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package healthhistory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/assert"
+)
+
+func newEntry(id, health string, ts time.Time) *metricssvc.GPUHealthHistoryEntry {
+	return &metricssvc.GPUHealthHistoryEntry{
+		ID:     id,
+		Health: health,
+		Time:   timestamppb.New(ts),
+	}
+}
+
+func TestHistoryStore(t *testing.T) {
+	logger.Init(true)
+	filePath := filepath.Join(t.TempDir(), "history", "gpu_health_history.log")
+	s := NewStore(filePath, 3)
+
+	base := time.Now().Add(-time.Hour)
+	assert.NilError(t, s.Append(newEntry("0", "unhealthy", base)))
+	assert.NilError(t, s.Append(newEntry("1", "degraded", base.Add(time.Minute))))
+	assert.NilError(t, s.Append(newEntry("0", "healthy", base.Add(2*time.Minute))))
+
+	assert.Equal(t, len(s.Query(&metricssvc.GPUHealthHistoryRequest{})), 3)
+	assert.Equal(t, len(s.Query(&metricssvc.GPUHealthHistoryRequest{ID: []string{"0"}})), 2)
+	assert.Equal(t, len(s.Query(&metricssvc.GPUHealthHistoryRequest{Health: []string{"UNHEALTHY"}})), 1)
+	resp := s.Query(&metricssvc.GPUHealthHistoryRequest{
+		StartTime: timestamppb.New(base.Add(30 * time.Second)),
+		EndTime:   timestamppb.New(base.Add(90 * time.Second)),
+	})
+	assert.Equal(t, len(resp), 1)
+	assert.Equal(t, resp[0].ID, "1")
+	resp = s.Query(&metricssvc.GPUHealthHistoryRequest{Limit: 1})
+	assert.Equal(t, len(resp), 1)
+	assert.Equal(t, resp[0].Health, "healthy")
+
+	// bounded to the max entries
+	assert.NilError(t, s.Append(newEntry("1", "healthy", base.Add(3*time.Minute))))
+	assert.NilError(t, s.Append(newEntry("2", "unhealthy", base.Add(4*time.Minute))))
+	resp = s.Query(&metricssvc.GPUHealthHistoryRequest{})
+	assert.Equal(t, len(resp), 3)
+	assert.Equal(t, resp[0].Health, "healthy")
+	assert.Equal(t, resp[0].ID, "0")
+
+	// reload from the file after restart, skipping invalid entries
+	f, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0644)
+	assert.NilError(t, err)
+	_, err = f.WriteString("{\"ID\":\n")
+	assert.NilError(t, err)
+	f.Close()
+	s = NewStore(filePath, 3)
+	resp = s.Query(&metricssvc.GPUHealthHistoryRequest{})
+	assert.Equal(t, len(resp), 3)
+	assert.Equal(t, resp[2].ID, "2")
+}
@@ -0,0 +1,509 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package jobaccounting
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const summaryFilePrefix = "job-"
+
+// number of consecutive updates a job must be missing from before it is
+// ended, so that a GPU skipped or a listing gap of a cycle doesn't end it.
+// A job reported ended by the scheduler is ended on its first miss
+const endMissedUpdates = 3
+
+// Job is the identity of an accounted job
+type Job struct {
+	ID          string
+	User        string
+	Partition   string
+	Cluster     string
+	ArrayJobID  string
+	ArrayTaskID string
+}
+
+// Sample is the state of a GPU used by a job at a point in time, the
+// counters are accumulated values and only their deltas are accounted
+type Sample struct {
+	GPU  string
+	Time time.Time
+
+	// accumulated energy in micro joules
+	Energy    float64
+	HasEnergy bool
+
+	// used VRAM in MB
+	UsedVRAM float64
+	HasVRAM  bool
+
+	// GFX and UMC activity in percent
+	GFXActivity float64
+	UMCActivity float64
+	HasActivity bool
+
+	// accumulated ECC error counts
+	CorrectableErrors   uint64
+	UncorrectableErrors uint64
+	HasECC              bool
+
+	// accumulated violation sampling counter and the accumulated
+	// residency of each throttle reason in the same unit, nil if not
+	// available
+	ViolationCounter uint64
+	Residency        map[string]uint64
+}
+
+// RunningJob is a job seen running along with the samples of its GPUs
+type RunningJob struct {
+	Job     Job
+	Samples []Sample
+}
+
+// Summary is the GPU accounting of an ended job
+type Summary struct {
+	JobID               string             `json:"job_id"`
+	User                string             `json:"user,omitempty"`
+	Partition           string             `json:"partition,omitempty"`
+	Cluster             string             `json:"cluster,omitempty"`
+	ArrayJobID          string             `json:"array_job_id,omitempty"`
+	ArrayTaskID         string             `json:"array_task_id,omitempty"`
+	Hostname            string             `json:"hostname,omitempty"`
+	StartTime           time.Time          `json:"start_time"`
+	EndTime             time.Time          `json:"end_time"`
+	DurationSeconds     float64            `json:"duration_seconds"`
+	GPUs                []string           `json:"gpus"`
+	GPUHours            float64            `json:"gpu_hours"`
+	EnergyConsumed      float64            `json:"energy_consumed_joules"`
+	PeakVRAMUsed        float64            `json:"peak_vram_used_mb"`
+	AverageVRAMUsed     float64            `json:"average_vram_used_mb"`
+	AverageGFXActivity  float64            `json:"average_gfx_activity"`
+	AverageUMCActivity  float64            `json:"average_umc_activity"`
+	CorrectableErrors   uint64             `json:"correctable_errors"`
+	UncorrectableErrors uint64             `json:"uncorrectable_errors"`
+	ThrottleResidency   map[string]float64 `json:"throttle_residency_percent,omitempty"`
+
+	// file the summary is written to, empty if not written
+	file string
+}
+
+// average of a gauge weighted by the time it was observed, the plain
+// mean of the samples is used until some time has elapsed
+type timeAverage struct {
+	weighted float64
+	seconds  float64
+	sum      float64
+	count    int
+}
+
+func (a *timeAverage) add(value, seconds float64) {
+	a.weighted += value * seconds
+	a.seconds += seconds
+	a.sum += value
+	a.count++
+}
+
+func (a *timeAverage) value() (float64, bool) {
+	if a.seconds > 0 {
+		return a.weighted / a.seconds, true
+	}
+	if a.count > 0 {
+		return a.sum / float64(a.count), true
+	}
+	return 0, false
+}
+
+type gpuUsage struct {
+	last           Sample
+	seconds        float64
+	energy         float64
+	peakVRAM       float64
+	vram           timeAverage
+	gfx            timeAverage
+	umc            timeAverage
+	correctable    uint64
+	uncorrectable  uint64
+	violationTicks uint64
+	residency      map[string]uint64
+}
+
+type jobUsage struct {
+	job         Job
+	start       time.Time
+	end         time.Time
+	gpus        map[string]*gpuUsage
+	missed      int
+	endReported bool
+}
+
+// Accountant accumulates the GPU usage of the running jobs and keeps the
+// summaries of the latest ended jobs, each summary is also written as a
+// json file in the summary directory
+type Accountant struct {
+	sync.Mutex
+	dir          string
+	maxSummaries int
+	hostname     string
+	running      map[string]*jobUsage
+	summaries    []*Summary
+}
+
+// NewAccountant creates the accountant and loads the existing summaries of
+// the directory, an unreadable directory leaves the summaries empty
+func NewAccountant(dir string, maxSummaries int, hostname string) *Accountant {
+	if maxSummaries <= 0 {
+		maxSummaries = 1
+	}
+	a := &Accountant{
+		dir:          dir,
+		maxSummaries: maxSummaries,
+		hostname:     hostname,
+		running:      map[string]*jobUsage{},
+		summaries:    []*Summary{},
+	}
+	if err := a.load(); err != nil {
+		logger.Log.Printf("job summaries load from %v failed, err: %v", dir, err)
+	}
+	return a
+}
+
+// Configure updates the summary directory and the number of summaries kept,
+// the jobs being accounted are preserved
+func (a *Accountant) Configure(dir string, maxSummaries int) {
+	if maxSummaries <= 0 {
+		maxSummaries = 1
+	}
+	a.Lock()
+	defer a.Unlock()
+	a.maxSummaries = maxSummaries
+	if dir != a.dir {
+		a.dir = dir
+		a.summaries = []*Summary{}
+		if err := a.load(); err != nil {
+			logger.Log.Printf("job summaries load from %v failed, err: %v", dir, err)
+		}
+	}
+	a.trim()
+}
+
+func (a *Accountant) load() error {
+	files, err := filepath.Glob(filepath.Join(a.dir, summaryFilePrefix+"*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			logger.Log.Printf("job summary %v read failed, err: %v", file, err)
+			continue
+		}
+		summary := &Summary{}
+		if err := json.Unmarshal(data, summary); err != nil {
+			// skip the partially written summaries
+			logger.Log.Printf("job summary %v skipped, err: %v", file, err)
+			continue
+		}
+		summary.file = file
+		a.summaries = append(a.summaries, summary)
+	}
+	sort.SliceStable(a.summaries, func(i, j int) bool {
+		return a.summaries[i].EndTime.Before(a.summaries[j].EndTime)
+	})
+	a.trim()
+	return nil
+}
+
+// trim drops the oldest summaries beyond maxSummaries along with their
+// files
+func (a *Accountant) trim() {
+	if len(a.summaries) <= a.maxSummaries {
+		return
+	}
+	drop := len(a.summaries) - a.maxSummaries
+	for _, s := range a.summaries[:drop] {
+		if s.file == "" {
+			continue
+		}
+		if err := os.Remove(s.file); err != nil && !os.IsNotExist(err) {
+			logger.Log.Printf("job %v summary remove failed, err: %v", s.JobID, err)
+		}
+	}
+	a.summaries = a.summaries[drop:]
+}
+
+// Update accounts the samples of the running jobs, the jobs accounted
+// earlier and missing from endMissedUpdates consecutive updates are ended
+// and their summaries returned. The ended ids are the jobs reported ended by
+// the scheduler, they are ended as soon as they are not running
+func (a *Accountant) Update(running []RunningJob, endedIDs []string) []*Summary {
+	a.Lock()
+	defer a.Unlock()
+	for _, id := range endedIDs {
+		if ju, ok := a.running[id]; ok {
+			ju.endReported = true
+		}
+	}
+	seen := map[string]bool{}
+	for _, rj := range running {
+		if rj.Job.ID == "" {
+			continue
+		}
+		seen[rj.Job.ID] = true
+		ju, ok := a.running[rj.Job.ID]
+		if !ok {
+			ju = &jobUsage{
+				job:  rj.Job,
+				gpus: map[string]*gpuUsage{},
+			}
+			a.running[rj.Job.ID] = ju
+		}
+		ju.missed = 0
+		mergeJob(&ju.job, rj.Job)
+		for _, s := range rj.Samples {
+			ju.add(s)
+		}
+	}
+
+	ended := []*Summary{}
+	for id, ju := range a.running {
+		if seen[id] {
+			continue
+		}
+		ju.missed++
+		if !ju.endReported && ju.missed < endMissedUpdates {
+			continue
+		}
+		delete(a.running, id)
+		if len(ju.gpus) == 0 {
+			continue
+		}
+		summary := ju.summary(a.hostname)
+		if err := a.write(summary); err != nil {
+			logger.Log.Printf("job %v summary write failed, err: %v", id, err)
+		}
+		a.summaries = append(a.summaries, summary)
+		ended = append(ended, summary)
+	}
+	sort.SliceStable(a.summaries, func(i, j int) bool {
+		return a.summaries[i].EndTime.Before(a.summaries[j].EndTime)
+	})
+	a.trim()
+	return ended
+}
+
+// Summaries returns the kept summaries of the jobs ordered by end time, all
+// the jobs if ids is empty and only the latest limit summaries if set
+func (a *Accountant) Summaries(ids []string, limit int) []*Summary {
+	filter := map[string]bool{}
+	for _, id := range ids {
+		filter[id] = true
+	}
+	a.Lock()
+	defer a.Unlock()
+	resp := []*Summary{}
+	for _, s := range a.summaries {
+		if len(filter) > 0 && !filter[s.JobID] {
+			continue
+		}
+		resp = append(resp, s)
+	}
+	if limit > 0 && len(resp) > limit {
+		resp = resp[len(resp)-limit:]
+	}
+	return resp
+}
+
+func (a *Accountant) write(summary *Summary) error {
+	if a.dir == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(a.dir, 0755); err != nil {
+		return err
+	}
+	name := summaryFilePrefix + strings.ReplaceAll(summary.JobID, string(os.PathSeparator), "_")
+	filePath := filepath.Join(a.dir, name+".json")
+	// a job id seen again, a requeued job, doesn't replace the summary of
+	// the earlier run
+	if _, err := os.Stat(filePath); err == nil {
+		filePath = filepath.Join(a.dir, fmt.Sprintf("%v-%v.json", name, summary.StartTime.Unix()))
+	}
+	tmpFile := filePath + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, filePath); err != nil {
+		return fmt.Errorf("job summary rename failed, err: %v", err)
+	}
+	summary.file = filePath
+	return nil
+}
+
+// mergeJob fills the details of the job learnt after the job started
+func mergeJob(job *Job, update Job) {
+	if update.User != "" {
+		job.User = update.User
+	}
+	if update.Partition != "" {
+		job.Partition = update.Partition
+	}
+	if update.Cluster != "" {
+		job.Cluster = update.Cluster
+	}
+	if update.ArrayJobID != "" {
+		job.ArrayJobID = update.ArrayJobID
+	}
+	if update.ArrayTaskID != "" {
+		job.ArrayTaskID = update.ArrayTaskID
+	}
+}
+
+func (ju *jobUsage) add(s Sample) {
+	if ju.start.IsZero() || s.Time.Before(ju.start) {
+		ju.start = s.Time
+	}
+	if s.Time.After(ju.end) {
+		ju.end = s.Time
+	}
+	gu, ok := ju.gpus[s.GPU]
+	if !ok {
+		gu = &gpuUsage{residency: map[string]uint64{}}
+		ju.gpus[s.GPU] = gu
+		gu.observe(s, 0)
+		gu.last = s
+		return
+	}
+	prev := gu.last
+	// samples of both the poll loop and the scrapes may be received out
+	// of order, the older ones are dropped
+	if !s.Time.After(prev.Time) {
+		return
+	}
+	seconds := s.Time.Sub(prev.Time).Seconds()
+	gu.seconds += seconds
+	gu.observe(s, seconds)
+	if s.HasEnergy && prev.HasEnergy && s.Energy >= prev.Energy {
+		gu.energy += s.Energy - prev.Energy
+	}
+	if s.HasECC && prev.HasECC {
+		gu.correctable += counterDelta(prev.CorrectableErrors, s.CorrectableErrors)
+		gu.uncorrectable += counterDelta(prev.UncorrectableErrors, s.UncorrectableErrors)
+	}
+	if s.Residency != nil && prev.Residency != nil && s.ViolationCounter > prev.ViolationCounter {
+		gu.violationTicks += s.ViolationCounter - prev.ViolationCounter
+		for reason, value := range s.Residency {
+			if old, ok := prev.Residency[reason]; ok {
+				gu.residency[reason] += counterDelta(old, value)
+			}
+		}
+	}
+	gu.last = s
+}
+
+// observe accounts the gauges of the sample for the elapsed seconds
+func (gu *gpuUsage) observe(s Sample, seconds float64) {
+	if s.HasVRAM {
+		if s.UsedVRAM > gu.peakVRAM {
+			gu.peakVRAM = s.UsedVRAM
+		}
+		gu.vram.add(s.UsedVRAM, seconds)
+	}
+	if s.HasActivity {
+		gu.gfx.add(s.GFXActivity, seconds)
+		gu.umc.add(s.UMCActivity, seconds)
+	}
+}
+
+// counterDelta returns the increase of an accumulated counter, a counter
+// reset is not accounted
+func counterDelta(prev, cur uint64) uint64 {
+	if cur < prev {
+		return 0
+	}
+	return cur - prev
+}
+
+func (ju *jobUsage) summary(hostname string) *Summary {
+	s := &Summary{
+		JobID:       ju.job.ID,
+		User:        ju.job.User,
+		Partition:   ju.job.Partition,
+		Cluster:     ju.job.Cluster,
+		ArrayJobID:  ju.job.ArrayJobID,
+		ArrayTaskID: ju.job.ArrayTaskID,
+		Hostname:    hostname,
+		StartTime:   ju.start,
+		EndTime:     ju.end,
+		GPUs:        []string{},
+	}
+	s.DurationSeconds = ju.end.Sub(ju.start).Seconds()
+	var vramSum, gfxSum, umcSum float64
+	var vramCount, activityCount int
+	var violationTicks uint64
+	residency := map[string]uint64{}
+	for gpu, gu := range ju.gpus {
+		s.GPUs = append(s.GPUs, gpu)
+		s.GPUHours += gu.seconds / 3600
+		s.EnergyConsumed += gu.energy / 1e6
+		if gu.peakVRAM > s.PeakVRAMUsed {
+			s.PeakVRAMUsed = gu.peakVRAM
+		}
+		if v, ok := gu.vram.value(); ok {
+			vramSum += v
+			vramCount++
+		}
+		if v, ok := gu.gfx.value(); ok {
+			gfxSum += v
+			u, _ := gu.umc.value()
+			umcSum += u
+			activityCount++
+		}
+		s.CorrectableErrors += gu.correctable
+		s.UncorrectableErrors += gu.uncorrectable
+		violationTicks += gu.violationTicks
+		for reason, value := range gu.residency {
+			residency[reason] += value
+		}
+	}
+	sort.Strings(s.GPUs)
+	if vramCount > 0 {
+		s.AverageVRAMUsed = vramSum / float64(vramCount)
+	}
+	if activityCount > 0 {
+		s.AverageGFXActivity = gfxSum / float64(activityCount)
+		s.AverageUMCActivity = umcSum / float64(activityCount)
+	}
+	if violationTicks > 0 {
+		s.ThrottleResidency = map[string]float64{}
+		for reason, value := range residency {
+			s.ThrottleResidency[reason] = float64(value) / float64(violationTicks) * 100
+		}
+	}
+	return s
+}
@@ -0,0 +1,143 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package jobaccounting
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"gotest.tools/assert"
+)
+
+func newSample(gpu string, ts time.Time, energy, vram, gfx float64, ce uint64, ticks, ppt uint64) Sample {
+	return Sample{
+		GPU:               gpu,
+		Time:              ts,
+		Energy:            energy,
+		HasEnergy:         true,
+		UsedVRAM:          vram,
+		HasVRAM:           true,
+		GFXActivity:       gfx,
+		UMCActivity:       gfx / 2,
+		HasActivity:       true,
+		CorrectableErrors: ce,
+		HasECC:            true,
+		ViolationCounter:  ticks,
+		Residency:         map[string]uint64{"ppt": ppt},
+	}
+}
+
+func TestAccountant(t *testing.T) {
+	logger.Init(true)
+	dir := filepath.Join(t.TempDir(), "jobs")
+	a := NewAccountant(dir, 2, "node1")
+
+	job := Job{ID: "100", User: "alice"}
+	base := time.Now().Add(-time.Hour)
+	ended := a.Update([]RunningJob{{Job: job, Samples: []Sample{
+		newSample("0", base, 1e6, 100, 10, 1, 100, 0),
+		newSample("1", base, 5e6, 300, 30, 0, 100, 0),
+	}}}, nil)
+	assert.Equal(t, len(ended), 0)
+	// partition learnt later
+	job.Partition = "gpu"
+	ended = a.Update([]RunningJob{{Job: job, Samples: []Sample{
+		newSample("0", base.Add(30*time.Minute), 1001e6, 200, 50, 3, 200, 50),
+		newSample("1", base.Add(30*time.Minute), 5e6, 300, 30, 0, 200, 0),
+	}}}, nil)
+	assert.Equal(t, len(ended), 0)
+	// out of order sample is dropped, energy counter reset is not accounted
+	ended = a.Update([]RunningJob{{Job: job, Samples: []Sample{
+		newSample("0", base.Add(20*time.Minute), 0, 1000, 0, 0, 0, 0),
+		newSample("1", base.Add(time.Hour), 1e6, 300, 30, 0, 300, 0),
+	}}}, nil)
+	assert.Equal(t, len(ended), 0)
+	assert.Equal(t, len(a.Summaries(nil, 0)), 0)
+
+	// a job missing from a few updates is not ended
+	for i := 1; i < endMissedUpdates; i++ {
+		assert.Equal(t, len(a.Update([]RunningJob{}, nil)), 0)
+	}
+	ended = a.Update([]RunningJob{}, nil)
+	assert.Equal(t, len(ended), 1)
+	s := ended[0]
+	assert.Equal(t, s.JobID, "100")
+	assert.Equal(t, s.User, "alice")
+	assert.Equal(t, s.Partition, "gpu")
+	assert.Equal(t, s.Hostname, "node1")
+	assert.DeepEqual(t, s.GPUs, []string{"0", "1"})
+	assert.Equal(t, s.DurationSeconds, 3600.0)
+	assert.Equal(t, s.GPUHours, 1.5)
+	assert.Equal(t, s.EnergyConsumed, 1000.0)
+	assert.Equal(t, s.PeakVRAMUsed, 300.0)
+	// gpu 0 at 200MB, gpu 1 at 300MB, weighted by the elapsed time
+	assert.Equal(t, s.AverageVRAMUsed, 250.0)
+	assert.Equal(t, s.AverageGFXActivity, 40.0)
+	assert.Equal(t, s.AverageUMCActivity, 20.0)
+	assert.Equal(t, s.CorrectableErrors, uint64(2))
+	// 50 of 300 violation ticks accumulated on both gpus
+	assert.Assert(t, math.Abs(s.ThrottleResidency["ppt"]-100.0/6) < 1e-9)
+
+	_, err := os.Stat(filepath.Join(dir, "job-100.json"))
+	assert.NilError(t, err)
+
+	// only the latest summaries are kept
+	for _, id := range []string{"101", "102"} {
+		a.Update([]RunningJob{{Job: Job{ID: id}, Samples: []Sample{{GPU: "0", Time: time.Now()}}}}, nil)
+		// ended by the scheduler, not running anymore
+		a.Update([]RunningJob{{Job: Job{ID: id}}}, []string{id})
+		assert.Equal(t, len(a.Update(nil, nil)), 1)
+	}
+	summaries := a.Summaries(nil, 0)
+	assert.Equal(t, len(summaries), 2)
+	assert.Equal(t, summaries[0].JobID, "101")
+	assert.Equal(t, len(a.Summaries([]string{"102"}, 0)), 1)
+	summaries = a.Summaries(nil, 1)
+	assert.Equal(t, len(summaries), 1)
+	assert.Equal(t, summaries[0].JobID, "102")
+	// the file of the dropped summary is removed
+	_, err = os.Stat(filepath.Join(dir, "job-100.json"))
+	assert.Assert(t, os.IsNotExist(err))
+
+	// reload from the directory after restart, skipping invalid summaries
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "job-103.json"), []byte("{\"job_id\":"), 0644))
+	a = NewAccountant(dir, 5, "node1")
+	summaries = a.Summaries(nil, 0)
+	assert.Equal(t, len(summaries), 2)
+	assert.Equal(t, summaries[0].JobID, "101")
+
+	// a requeued job with the same id keeps the summary of the earlier run
+	start := time.Now()
+	a.Update([]RunningJob{{Job: Job{ID: "102"}, Samples: []Sample{{GPU: "0", Time: start}}}}, nil)
+	assert.Equal(t, len(a.Update(nil, []string{"102"})), 1)
+	_, err = os.Stat(filepath.Join(dir, fmt.Sprintf("job-102-%v.json", start.Unix())))
+	assert.NilError(t, err)
+	a = NewAccountant(dir, 5, "node1")
+	assert.Equal(t, len(a.Summaries([]string{"102"}, 0)), 2)
+
+	// a lower limit removes the files of the oldest summaries on reload
+	a = NewAccountant(dir, 1, "node1")
+	assert.Equal(t, len(a.Summaries(nil, 0)), 1)
+	files, err := filepath.Glob(filepath.Join(dir, "job-10[0-2]*.json"))
+	assert.NilError(t, err)
+	assert.Equal(t, len(files), 1)
+}
@@ -0,0 +1,195 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package kfdprocess
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+)
+
+// per process GPU usage reported by the KFD process accounting in
+// /sys/class/kfd/kfd/proc/<pid>, the GPUs are identified by their KFD gpu id
+const kfdProcDir = "class/kfd/kfd/proc"
+
+// containerIdRe matches the container id of a cgroup path, ex:
+// docker-<id>.scope, cri-containerd-<id>.scope or /docker/<id>
+var containerIdRe = regexp.MustCompile(`[0-9a-f]{64}`)
+
+// Usage is the usage of a GPU by a process
+type Usage struct {
+	// GPUID is the KFD gpu id of the GPU
+	GPUID string
+	// VRAM used in bytes
+	VRAM uint64
+	// SDMA accumulated usage in microseconds
+	SDMA uint64
+	// CUOccupancy is the number of compute units occupied
+	CUOccupancy    uint64
+	HasCUOccupancy bool
+}
+
+// Process is a process using GPUs
+type Process struct {
+	Pid     int
+	Command string
+	// Cgroup is the cgroup v2 path of the process, the first hierarchy
+	// with cgroup v1
+	Cgroup string
+	// ContainerId is the container id found in the cgroup path
+	ContainerId string
+	GPUs        []Usage
+}
+
+// Option set desired options
+type Option func(r *Reader)
+
+// WithSysRoot sets the sysfs root of the KFD process accounting
+func WithSysRoot(root string) Option {
+	return func(r *Reader) {
+		r.sysRoot = root
+	}
+}
+
+// WithProcRoot sets the procfs root used to resolve the processes
+func WithProcRoot(root string) Option {
+	return func(r *Reader) {
+		r.procRoot = root
+	}
+}
+
+// Reader reads the KFD process accounting
+type Reader struct {
+	sysRoot  string
+	procRoot string
+}
+
+// NewReader - creates a KFD process accounting reader
+func NewReader(opts ...Option) *Reader {
+	r := &Reader{
+		sysRoot:  globals.SysRoot,
+		procRoot: globals.ProcRoot,
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// Processes returns the processes using GPUs sorted by pid, a process is
+// using a GPU when it has VRAM allocated, SDMA usage or occupied CUs
+func (r *Reader) Processes() ([]*Process, error) {
+	entries, err := os.ReadDir(filepath.Join(r.sysRoot, kfdProcDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kfd processes: %w", err)
+	}
+	processes := []*Process{}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		gpus := r.processGPUs(filepath.Join(r.sysRoot, kfdProcDir, entry.Name()))
+		if len(gpus) == 0 {
+			continue
+		}
+		p := &Process{
+			Pid:     pid,
+			Command: readString(filepath.Join(r.procRoot, entry.Name(), "comm")),
+			Cgroup:  r.processCgroup(entry.Name()),
+			GPUs:    gpus,
+		}
+		if ids := containerIdRe.FindAllString(p.Cgroup, -1); len(ids) != 0 {
+			p.ContainerId = ids[len(ids)-1]
+		}
+		processes = append(processes, p)
+	}
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].Pid < processes[j].Pid
+	})
+	return processes, nil
+}
+
+// processGPUs returns the usage of the GPUs used by the process from the
+// vram_<gpu id>, sdma_<gpu id> and stats_<gpu id>/cu_occupancy files
+func (r *Reader) processGPUs(dir string) []Usage {
+	files, _ := filepath.Glob(filepath.Join(dir, "vram_*"))
+	gpus := []Usage{}
+	for _, f := range files {
+		gpuID := strings.TrimPrefix(filepath.Base(f), "vram_")
+		u := Usage{
+			GPUID: gpuID,
+			VRAM:  readUint(f),
+			SDMA:  readUint(filepath.Join(dir, "sdma_"+gpuID)),
+		}
+		occupancy := readString(filepath.Join(dir, "stats_"+gpuID, "cu_occupancy"))
+		if v, err := strconv.ParseUint(occupancy, 10, 64); err == nil {
+			u.CUOccupancy = v
+			u.HasCUOccupancy = true
+		}
+		if u.VRAM == 0 && u.SDMA == 0 && u.CUOccupancy == 0 {
+			continue
+		}
+		gpus = append(gpus, u)
+	}
+	sort.Slice(gpus, func(i, j int) bool {
+		return gpus[i].GPUID < gpus[j].GPUID
+	})
+	return gpus
+}
+
+// processCgroup returns the cgroup v2 path of the process, or the path of
+// the first cgroup v1 hierarchy
+func (r *Reader) processCgroup(pid string) string {
+	data, err := os.ReadFile(filepath.Join(r.procRoot, pid, "cgroup"))
+	if err != nil {
+		return ""
+	}
+	cgroup := ""
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[0] == "0" && fields[1] == "" {
+			return fields[2]
+		}
+		if cgroup == "" {
+			cgroup = fields[2]
+		}
+	}
+	return cgroup
+}
+
+func readString(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func readUint(path string) uint64 {
+	v, _ := strconv.ParseUint(readString(path), 10, 64)
+	return v
+}
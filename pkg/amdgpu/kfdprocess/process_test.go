@@ -0,0 +1,88 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package kfdprocess
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func writeFile(t *testing.T, path, data string) {
+	assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	assert.NilError(t, os.WriteFile(path, []byte(data), 0644))
+}
+
+func TestProcesses(t *testing.T) {
+	root := t.TempDir()
+	sys := filepath.Join(root, "sys")
+	proc := filepath.Join(root, "proc")
+	containerId := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	// process using gpu 12345 in a docker container
+	writeFile(t, filepath.Join(sys, kfdProcDir, "100", "vram_12345"), "1048576\n")
+	writeFile(t, filepath.Join(sys, kfdProcDir, "100", "sdma_12345"), "500\n")
+	writeFile(t, filepath.Join(sys, kfdProcDir, "100", "stats_12345", "cu_occupancy"), "40\n")
+	writeFile(t, filepath.Join(sys, kfdProcDir, "100", "vram_23456"), "0\n")
+	writeFile(t, filepath.Join(sys, kfdProcDir, "100", "sdma_23456"), "0\n")
+	writeFile(t, filepath.Join(proc, "100", "comm"), "python3\n")
+	writeFile(t, filepath.Join(proc, "100", "cgroup"), "0::/system.slice/docker-"+containerId+".scope\n")
+
+	// process using both gpus on a cgroup v1 host, without cu occupancy
+	writeFile(t, filepath.Join(sys, kfdProcDir, "200", "vram_12345"), "2048\n")
+	writeFile(t, filepath.Join(sys, kfdProcDir, "200", "vram_23456"), "4096\n")
+	writeFile(t, filepath.Join(proc, "200", "comm"), "rccl-test\n")
+	writeFile(t, filepath.Join(proc, "200", "cgroup"), "12:devices:/slurm/uid_1000/job_7\n1:name=systemd:/slurm\n")
+
+	// idle process and exited process without procfs entry
+	writeFile(t, filepath.Join(sys, kfdProcDir, "300", "vram_12345"), "0\n")
+	writeFile(t, filepath.Join(sys, kfdProcDir, "400", "sdma_23456"), "10\n")
+	writeFile(t, filepath.Join(sys, kfdProcDir, "400", "vram_23456"), "0\n")
+
+	r := NewReader(WithSysRoot(sys), WithProcRoot(proc))
+	processes, err := r.Processes()
+	assert.NilError(t, err)
+	assert.Equal(t, len(processes), 3)
+	assert.DeepEqual(t, *processes[0], Process{
+		Pid:         100,
+		Command:     "python3",
+		Cgroup:      "/system.slice/docker-" + containerId + ".scope",
+		ContainerId: containerId,
+		GPUs: []Usage{
+			{GPUID: "12345", VRAM: 1048576, SDMA: 500, CUOccupancy: 40, HasCUOccupancy: true},
+		},
+	})
+	assert.DeepEqual(t, *processes[1], Process{
+		Pid:     200,
+		Command: "rccl-test",
+		Cgroup:  "/slurm/uid_1000/job_7",
+		GPUs: []Usage{
+			{GPUID: "12345", VRAM: 2048},
+			{GPUID: "23456", VRAM: 4096},
+		},
+	})
+	assert.DeepEqual(t, *processes[2], Process{
+		Pid:  400,
+		GPUs: []Usage{{GPUID: "23456", SDMA: 10}},
+	})
+
+	// no kfd process accounting
+	_, err = NewReader(WithSysRoot(t.TempDir())).Processes()
+	assert.Assert(t, err != nil)
+}
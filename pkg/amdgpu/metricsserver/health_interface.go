@@ -16,11 +16,19 @@
 
 package metricsserver
 
+import "github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+
 // HealthInterface defines the interface for health metrics of AMD GPUs.
 type HealthInterface interface {
 	// Get health update of clients
 	GetGPUHealthStates() (map[string]interface{}, error)
 
+	// clear the sticky unhealthy state of the GPUs, returns the cleared list
+	ClearGPUHealth(gpuids []string) ([]string, error)
+
+	// get the recorded health transitions matching the request
+	GetHealthHistory(req *metricssvc.GPUHealthHistoryRequest) ([]*metricssvc.GPUHealthHistoryEntry, error)
+
 	// debug/mock
 	SetError(gpuid string, fields []string, values []uint32) error
 }
@@ -19,7 +19,9 @@ package metricsserver
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -29,7 +31,9 @@ type MetricsSvcImpl struct {
 	sync.Mutex
 	enableDebugAPI bool
 	metricssvc.UnimplementedMetricsServiceServer
-	clients []HealthInterface
+	clients       []HealthInterface
+	watchInterval time.Duration
+	watch         *watchPoller
 }
 
 // GetGPUState retrieves the GPU states for the specified IDs from all registered clients
@@ -96,6 +100,46 @@ func (m *MetricsSvcImpl) SetError(ctx context.Context, req *metricssvc.GPUErrorR
 	return resp, nil
 }
 
+// ClearGPUHealth clears the sticky unhealthy state of the requested GPUs
+func (m *MetricsSvcImpl) ClearGPUHealth(ctx context.Context, req *metricssvc.GPUHealthClearRequest) (*metricssvc.GPUHealthClearResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	resp := &metricssvc.GPUHealthClearResponse{
+		ID: []string{},
+	}
+	for _, client := range m.clients {
+		cleared, err := client.ClearGPUHealth(req.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp.ID = append(resp.ID, cleared...)
+	}
+	return resp, nil
+}
+
+// GetHealthHistory returns the health transitions recorded by all registered clients
+func (m *MetricsSvcImpl) GetHealthHistory(ctx context.Context, req *metricssvc.GPUHealthHistoryRequest) (*metricssvc.GPUHealthHistoryResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	resp := &metricssvc.GPUHealthHistoryResponse{
+		Entry: []*metricssvc.GPUHealthHistoryEntry{},
+	}
+	for _, client := range m.clients {
+		entries, err := client.GetHealthHistory(req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Entry = append(resp.Entry, entries...)
+	}
+	sort.SliceStable(resp.Entry, func(i, j int) bool {
+		return resp.Entry[i].Time.AsTime().Before(resp.Entry[j].Time.AsTime())
+	})
+	if req.Limit > 0 && len(resp.Entry) > int(req.Limit) {
+		resp.Entry = resp.Entry[len(resp.Entry)-int(req.Limit):]
+	}
+	return resp, nil
+}
+
 // nolint:unused // mustEmbedUnimplementedMetricsServiceServer is kept for future use
 func (m *MetricsSvcImpl) mustEmbedUnimplementedMetricsServiceServer() {}
 
@@ -104,6 +148,8 @@ func NewMetricsServer(enableDebugAPI bool) *MetricsSvcImpl {
 	msrv := &MetricsSvcImpl{
 		enableDebugAPI: enableDebugAPI,
 		clients:        []HealthInterface{},
+		watchInterval:  defaultWatchInterval,
+		watch:          newWatchPoller(),
 	}
 	return msrv
 }
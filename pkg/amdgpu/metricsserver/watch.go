@@ -0,0 +1,250 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+// interval at which the watch poller looks up the GPU states, the lookup is
+// on the in memory state of the clients
+const defaultWatchInterval = time.Second
+
+// gpuStatesResult is the result of one lookup of the GPU states, it is
+// shared by all the watch streams and must not be modified
+type gpuStatesResult struct {
+	states map[string]*metricssvc.GPUState
+	err    error
+}
+
+// watchPoller looks up the GPU states once per interval on behalf of all
+// the watch streams and fans the result out to them, it only runs while
+// there is at least one subscribed stream
+type watchPoller struct {
+	sync.Mutex
+	subs map[chan *gpuStatesResult]bool
+	last *gpuStatesResult
+	stop chan struct{}
+}
+
+func newWatchPoller() *watchPoller {
+	return &watchPoller{
+		subs: map[chan *gpuStatesResult]bool{},
+	}
+}
+
+// Watch streams the GPU state changes, the first event is a snapshot of all
+// the requested GPU states followed by the change events
+func (m *MetricsSvcImpl) Watch(req *metricssvc.GPUWatchRequest, stream metricssvc.MetricsService_WatchServer) error {
+	ids := map[string]bool{}
+	for _, id := range req.GetID() {
+		ids[id] = true
+	}
+	ch := m.subscribeWatch()
+	defer m.unsubscribeWatch(ch)
+
+	var last map[string]*metricssvc.GPUState
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case res := <-ch:
+			if res.err != nil && last == nil {
+				return res.err
+			}
+			if res.err != nil {
+				logger.Log.Printf("watch failed to get gpu states, err: %v", res.err)
+				continue
+			}
+			states := filterGPUStates(res.states, ids)
+			for _, event := range gpuStateEvents(last, states) {
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+			}
+			last = states
+		}
+	}
+}
+
+// subscribeWatch registers a watch stream with the poller, starting the
+// poller for the first stream. The latest lookup, if any, is delivered
+// right away so a new stream does not wait for the next interval
+func (m *MetricsSvcImpl) subscribeWatch() chan *gpuStatesResult {
+	w := m.watch
+	w.Lock()
+	defer w.Unlock()
+	ch := make(chan *gpuStatesResult, 1)
+	w.subs[ch] = true
+	if w.last != nil {
+		ch <- w.last
+	}
+	if len(w.subs) == 1 {
+		w.stop = make(chan struct{})
+		go m.pollGPUStates(w.stop)
+	}
+	return ch
+}
+
+// unsubscribeWatch removes a watch stream, the poller is stopped with the
+// last stream
+func (m *MetricsSvcImpl) unsubscribeWatch(ch chan *gpuStatesResult) {
+	w := m.watch
+	w.Lock()
+	defer w.Unlock()
+	delete(w.subs, ch)
+	if len(w.subs) == 0 && w.stop != nil {
+		close(w.stop)
+		w.stop = nil
+		w.last = nil
+	}
+}
+
+// pollGPUStates looks up the GPU states every watch interval until stopped
+func (m *MetricsSvcImpl) pollGPUStates(stop chan struct{}) {
+	ticker := time.NewTicker(m.watchInterval)
+	defer ticker.Stop()
+	for {
+		states, err := m.getGPUStates()
+		m.watch.publish(stop, &gpuStatesResult{states: states, err: err})
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish hands the lookup to all the subscribed streams, a stream that has
+// not consumed the previous lookup only gets the latest one
+func (w *watchPoller) publish(stop chan struct{}, res *gpuStatesResult) {
+	w.Lock()
+	defer w.Unlock()
+	select {
+	case <-stop:
+		// poller stopped while looking up the states
+		return
+	default:
+	}
+	w.last = res
+	for ch := range w.subs {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- res
+	}
+}
+
+// getGPUStates returns a copy of the GPU states of all registered clients
+func (m *MetricsSvcImpl) getGPUStates() (map[string]*metricssvc.GPUState, error) {
+	m.Lock()
+	defer m.Unlock()
+	states := map[string]*metricssvc.GPUState{}
+	for _, client := range m.clients {
+		gpuStateMap, err := client.GetGPUHealthStates()
+		if err != nil {
+			return nil, err
+		}
+		for id, gstate := range gpuStateMap {
+			states[id] = proto.Clone(gstate.(*metricssvc.GPUState)).(*metricssvc.GPUState)
+		}
+	}
+	return states, nil
+}
+
+// filterGPUStates returns the GPU states of the ids, all GPUs if empty
+func filterGPUStates(states map[string]*metricssvc.GPUState, ids map[string]bool) map[string]*metricssvc.GPUState {
+	filtered := map[string]*metricssvc.GPUState{}
+	for id, state := range states {
+		if len(ids) > 0 && !ids[id] {
+			continue
+		}
+		filtered[id] = state
+	}
+	return filtered
+}
+
+// gpuStateEvents returns the events to move from the old to the new states,
+// a snapshot if there is no old state
+func gpuStateEvents(oldStates, newStates map[string]*metricssvc.GPUState) []*metricssvc.GPUStateEvent {
+	if oldStates == nil {
+		return []*metricssvc.GPUStateEvent{
+			{Type: metricssvc.StateEventType_SNAPSHOT, GPUState: sortedGPUStates(newStates)},
+		}
+	}
+	added := map[string]*metricssvc.GPUState{}
+	modified := map[string]*metricssvc.GPUState{}
+	deleted := map[string]*metricssvc.GPUState{}
+	for id, nstate := range newStates {
+		ostate, ok := oldStates[id]
+		if !ok {
+			added[id] = nstate
+		} else if gpuStateChanged(ostate, nstate) {
+			modified[id] = nstate
+		}
+	}
+	for id, ostate := range oldStates {
+		if _, ok := newStates[id]; !ok {
+			deleted[id] = ostate
+		}
+	}
+	events := []*metricssvc.GPUStateEvent{}
+	for _, e := range []struct {
+		eventType metricssvc.StateEventType
+		states    map[string]*metricssvc.GPUState
+	}{
+		{metricssvc.StateEventType_DELETED, deleted},
+		{metricssvc.StateEventType_ADDED, added},
+		{metricssvc.StateEventType_MODIFIED, modified},
+	} {
+		if len(e.states) > 0 {
+			events = append(events, &metricssvc.GPUStateEvent{Type: e.eventType, GPUState: sortedGPUStates(e.states)})
+		}
+	}
+	return events
+}
+
+// gpuStateChanged compares the states ignoring the last seen time of the
+// reasons which is refreshed on every evaluation
+func gpuStateChanged(a, b *metricssvc.GPUState) bool {
+	strip := func(s *metricssvc.GPUState) *metricssvc.GPUState {
+		c := proto.Clone(s).(*metricssvc.GPUState)
+		for _, r := range c.Reasons {
+			r.LastSeen = nil
+		}
+		return c
+	}
+	return !proto.Equal(strip(a), strip(b))
+}
+
+func sortedGPUStates(states map[string]*metricssvc.GPUState) []*metricssvc.GPUState {
+	list := make([]*metricssvc.GPUState, 0, len(states))
+	for _, s := range states {
+		list = append(list, s)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
@@ -0,0 +1,182 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/assert"
+)
+
+type mockHealthClient struct {
+	sync.Mutex
+	states  map[string]interface{}
+	lookups int
+}
+
+func (m *mockHealthClient) GetGPUHealthStates() (map[string]interface{}, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.lookups++
+	states := map[string]interface{}{}
+	for k, v := range m.states {
+		states[k] = v
+	}
+	return states, nil
+}
+
+func (m *mockHealthClient) ClearGPUHealth(gpuids []string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *mockHealthClient) GetHealthHistory(req *metricssvc.GPUHealthHistoryRequest) ([]*metricssvc.GPUHealthHistoryEntry, error) {
+	return nil, nil
+}
+
+func (m *mockHealthClient) SetError(gpuid string, fields []string, values []uint32) error {
+	return nil
+}
+
+func (m *mockHealthClient) setStates(states map[string]interface{}) {
+	m.Lock()
+	defer m.Unlock()
+	m.states = states
+}
+
+type mockWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *metricssvc.GPUStateEvent
+}
+
+func (s *mockWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockWatchStream) Send(e *metricssvc.GPUStateEvent) error {
+	s.events <- e
+	return nil
+}
+
+func TestWatch(t *testing.T) {
+	logger.Init(true)
+	server := NewMetricsServer(false)
+	server.watchInterval = 10 * time.Millisecond
+	client := &mockHealthClient{}
+	client.setStates(map[string]interface{}{
+		"0": &metricssvc.GPUState{ID: "0", Health: "healthy"},
+		"1": &metricssvc.GPUState{ID: "1", Health: "healthy"},
+	})
+	assert.NilError(t, server.RegisterHealthClient(client))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockWatchStream{ctx: ctx, events: make(chan *metricssvc.GPUStateEvent, 10)}
+	done := make(chan error)
+	go func() {
+		done <- server.Watch(&metricssvc.GPUWatchRequest{ID: []string{"0"}}, stream)
+	}()
+
+	event := <-stream.events
+	assert.Equal(t, event.Type, metricssvc.StateEventType_SNAPSHOT)
+	assert.Equal(t, len(event.GPUState), 1)
+	assert.Equal(t, event.GPUState[0].ID, "0")
+
+	client.setStates(map[string]interface{}{
+		"0": &metricssvc.GPUState{ID: "0", Health: "unhealthy"},
+		"1": &metricssvc.GPUState{ID: "1", Health: "unhealthy"},
+	})
+	event = <-stream.events
+	assert.Equal(t, event.Type, metricssvc.StateEventType_MODIFIED)
+	assert.Equal(t, len(event.GPUState), 1)
+	assert.Equal(t, event.GPUState[0].Health, "unhealthy")
+
+	cancel()
+	assert.NilError(t, <-done)
+}
+
+func TestWatchSharedPoller(t *testing.T) {
+	logger.Init(true)
+	server := NewMetricsServer(false)
+	server.watchInterval = time.Hour
+	client := &mockHealthClient{}
+	client.setStates(map[string]interface{}{
+		"0": &metricssvc.GPUState{ID: "0", Health: "healthy"},
+		"1": &metricssvc.GPUState{ID: "1", Health: "healthy"},
+	})
+	assert.NilError(t, server.RegisterHealthClient(client))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 2)
+	for _, ids := range [][]string{{"0"}, {}} {
+		stream := &mockWatchStream{ctx: ctx, events: make(chan *metricssvc.GPUStateEvent, 10)}
+		go func(ids []string) {
+			done <- server.Watch(&metricssvc.GPUWatchRequest{ID: ids}, stream)
+		}(ids)
+		// wait for the snapshot so the second stream reuses the lookup
+		event := <-stream.events
+		assert.Equal(t, event.Type, metricssvc.StateEventType_SNAPSHOT)
+		assert.Equal(t, len(event.GPUState), 2-len(ids))
+	}
+	client.Lock()
+	assert.Equal(t, client.lookups, 1, "expected one lookup shared by the streams")
+	client.Unlock()
+
+	cancel()
+	assert.NilError(t, <-done)
+	assert.NilError(t, <-done)
+	server.watch.Lock()
+	assert.Equal(t, len(server.watch.subs), 0)
+	assert.Assert(t, server.watch.stop == nil, "expected poller to stop with the last stream")
+	server.watch.Unlock()
+}
+
+func TestGPUStateEvents(t *testing.T) {
+	reason := func(lastSeen time.Time) []*metricssvc.GPUHealthReason {
+		return []*metricssvc.GPUHealthReason{
+			{Type: "REASON_FIELD_THRESHOLD", Rule: "GPU_ECC_UNCORRECT_UMC", LastSeen: timestamppb.New(lastSeen)},
+		}
+	}
+	now := time.Now()
+	oldStates := map[string]*metricssvc.GPUState{
+		"0": {ID: "0", Health: "unhealthy", Reasons: reason(now)},
+		"1": {ID: "1", Health: "healthy"},
+	}
+	newStates := map[string]*metricssvc.GPUState{
+		"0": {ID: "0", Health: "unhealthy", Reasons: reason(now.Add(time.Minute))},
+		"2": {ID: "2", Health: "healthy"},
+	}
+
+	events := gpuStateEvents(nil, newStates)
+	assert.Equal(t, len(events), 1)
+	assert.Equal(t, events[0].Type, metricssvc.StateEventType_SNAPSHOT)
+	assert.Equal(t, len(events[0].GPUState), 2)
+
+	// last seen refresh of the reasons is not a change
+	events = gpuStateEvents(oldStates, newStates)
+	assert.Equal(t, len(events), 2)
+	assert.Equal(t, events[0].Type, metricssvc.StateEventType_DELETED)
+	assert.Equal(t, events[0].GPUState[0].ID, "1")
+	assert.Equal(t, events[1].Type, metricssvc.StateEventType_ADDED)
+	assert.Equal(t, events[1].GPUState[0].ID, "2")
+}
@@ -30,8 +30,10 @@ import (
 )
 
 const (
-	rocprofilerTimeout = 15
-	cachedTimer        = 10 * time.Second
+	// DefaultTimeout of the rocpctl queries
+	DefaultTimeout = 15 * time.Second
+	// DefaultCacheTTL is the time the metrics are reused
+	DefaultCacheTTL = 10 * time.Second
 )
 
 type ROCProfilerClient struct {
@@ -45,6 +47,8 @@ type profilerCache struct {
 	sync.RWMutex
 	cachedMetrics *amdgpu.GpuProfiler
 	cacheLastRead time.Time
+	cacheTTL      time.Duration
+	timeout       time.Duration
 }
 
 func NewRocProfilerClient(name string) *ROCProfilerClient {
@@ -52,10 +56,33 @@ func NewRocProfilerClient(name string) *ROCProfilerClient {
 	return &ROCProfilerClient{
 		Name:         name,
 		MetricFields: []string{},
-		pCache:       &profilerCache{},
+		pCache: &profilerCache{
+			cacheTTL: DefaultCacheTTL,
+			timeout:  DefaultTimeout,
+		},
 	}
 }
 
+// SetPolling sets the time the metrics are reused and the timeout of the
+// queries
+func (rpc *ROCProfilerClient) SetPolling(cacheTTL, timeout time.Duration) {
+	rpc.pCache.Lock()
+	defer rpc.pCache.Unlock()
+	rpc.pCache.cacheTTL = cacheTTL
+	rpc.pCache.timeout = timeout
+}
+
+// CacheTimestamp returns the time of the cached metrics, zero when there
+// are no cached metrics
+func (rpc *ROCProfilerClient) CacheTimestamp() time.Time {
+	rpc.pCache.RLock()
+	defer rpc.pCache.RUnlock()
+	if rpc.pCache.cachedMetrics == nil {
+		return time.Time{}
+	}
+	return rpc.pCache.cacheLastRead
+}
+
 func (rpc *ROCProfilerClient) SetFields(fields []string) {
 	logger.Log.Printf("rocprofiler fields pulled for %v", strings.Join(fields, ","))
 	rpc.MetricFields = fields
@@ -69,15 +96,16 @@ func (rpc *ROCProfilerClient) cacheMetrics() (*amdgpu.GpuProfiler, error) {
 	rpc.pCache.RLock()
 
 	// If cache is fresh, return it
-	if time.Since(rpc.pCache.cacheLastRead) < cachedTimer && rpc.pCache.cachedMetrics != nil {
+	if time.Since(rpc.pCache.cacheLastRead) < rpc.pCache.cacheTTL && rpc.pCache.cachedMetrics != nil {
 		rpc.pCache.RUnlock()
 		logger.Log.Printf("returning metrics from cache")
 		return rpc.pCache.cachedMetrics, nil
 	}
+	timeout := rpc.pCache.timeout
 	rpc.pCache.RUnlock()
 
 	// Otherwise, fetch new metrics and update cache
-	metrics, err := rpc.getMetrics()
+	metrics, err := rpc.getMetrics(timeout)
 	rpc.pCache.Lock()
 	rpc.pCache.cacheLastRead = time.Now()
 	if err == nil {
@@ -95,21 +123,20 @@ func (rpc *ROCProfilerClient) GetMetrics() (*amdgpu.GpuProfiler, error) {
 	return rpc.cacheMetrics()
 }
 
-func (rpc *ROCProfilerClient) getMetrics() (*amdgpu.GpuProfiler, error) {
+func (rpc *ROCProfilerClient) getMetrics(timeout time.Duration) (*amdgpu.GpuProfiler, error) {
 	gpus := amdgpu.GpuProfiler{}
 
 	if len(rpc.MetricFields) == 0 {
 		return &gpus, nil
 	}
 
-	// Create a context with a 15s timeout
-	ctx, cancel := context.WithTimeout(context.Background(), rocprofilerTimeout*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	cmd := exec.CommandContext(ctx, "/bin/bash", "-c", rpc.cmd)
 	gpuMetrics, err := cmd.Output()
 	if ctx.Err() == context.DeadlineExceeded {
-		logger.Log.Printf("command timed out after 15s: %v", rpc.cmd)
+		logger.Log.Printf("command timed out after %v: %v", timeout, rpc.cmd)
 		return nil, ctx.Err()
 	}
 
@@ -0,0 +1,251 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package sampler
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// Source returns the values of the fields keyed by device and field
+type Source func() (map[string]map[string]float64, error)
+
+// Stats are the statistics of the latest samples of a field
+type Stats struct {
+	Count int
+	Min   float64
+	Max   float64
+	Sum   float64
+	// Samples are the latest samples, oldest first
+	Samples []float64
+}
+
+// Avg returns the average of the samples
+func (s *Stats) Avg() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Sum / float64(s.Count)
+}
+
+// Quantile returns the q quantile of the kept samples, interpolated
+// between the closest ranks
+func (s *Stats) Quantile(q float64) float64 {
+	if len(s.Samples) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(s.Samples)
+	slices.Sort(sorted)
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
+
+// window is the ring buffer of the latest samples of a field
+type window struct {
+	samples []float64
+	next    int
+}
+
+func (w *window) add(v float64, maxSamples int) {
+	if len(w.samples) < maxSamples {
+		w.samples = append(w.samples, v)
+		return
+	}
+	w.samples[w.next] = v
+	w.next = (w.next + 1) % maxSamples
+}
+
+// stats returns the statistics of the samples, oldest first
+func (w *window) stats() *Stats {
+	s := &Stats{
+		Count:   len(w.samples),
+		Samples: append(slices.Clone(w.samples[w.next:]), w.samples[:w.next]...),
+	}
+	for i, v := range s.Samples {
+		if i == 0 || v < s.Min {
+			s.Min = v
+		}
+		if i == 0 || v > s.Max {
+			s.Max = v
+		}
+		s.Sum += v
+	}
+	return s
+}
+
+// Observer is called with every sampled value of a device and field
+type Observer func(device, field string, value float64)
+
+// Option set desired options
+type Option func(s *Sampler)
+
+// WithInterval sets the sampling interval
+func WithInterval(interval time.Duration) Option {
+	return func(s *Sampler) {
+		s.interval = interval
+	}
+}
+
+// WithMaxSamples sets the number of latest samples kept per device and
+// field
+func WithMaxSamples(maxSamples int) Option {
+	return func(s *Sampler) {
+		s.maxSamples = maxSamples
+	}
+}
+
+// WithObserver sets the observer of every sampled value
+func WithObserver(observer Observer) Option {
+	return func(s *Sampler) {
+		s.observer = observer
+	}
+}
+
+// Sampler samples fields of the devices at a fixed interval and keeps
+// their latest samples, the memory is bounded by the max samples. The
+// statistics are of the latest samples and not changed by reading them
+type Sampler struct {
+	sync.Mutex
+	source     Source
+	fields     map[string]bool
+	interval   time.Duration
+	maxSamples int
+	observer   Observer
+	windows    map[string]map[string]*window
+	lastErr    string
+	cancel     context.CancelFunc
+	done       chan struct{}
+}
+
+// New returns a sampler of the fields of the source
+func New(source Source, fields []string, opts ...Option) *Sampler {
+	s := &Sampler{
+		source:     source,
+		fields:     make(map[string]bool),
+		interval:   globals.SamplingIntervalMs * time.Millisecond,
+		maxSamples: globals.SamplingMaxSamples,
+		windows:    make(map[string]map[string]*window),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	for _, field := range fields {
+		s.fields[field] = true
+	}
+	return s
+}
+
+// Start samples the source until Stop is called
+func (s *Sampler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.Sample()
+			}
+		}
+	}()
+}
+
+// Stop stops the sampling started by Start
+func (s *Sampler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+	s.cancel = nil
+}
+
+// Sample reads the source once, adds the values of the sampled fields and
+// passes them to the observer
+func (s *Sampler) Sample() {
+	values, err := s.source()
+	if !s.add(values, err) || s.observer == nil {
+		return
+	}
+	// observed outside of the sampler lock
+	for device, fields := range values {
+		for field, v := range fields {
+			if s.fields[field] {
+				s.observer(device, field, v)
+			}
+		}
+	}
+}
+
+func (s *Sampler) add(values map[string]map[string]float64, err error) bool {
+	s.Lock()
+	defer s.Unlock()
+	if err != nil {
+		// failures are logged once until the source recovers
+		if err.Error() != s.lastErr {
+			logger.Log.Printf("sampling failed: %v", err)
+		}
+		s.lastErr = err.Error()
+		return false
+	}
+	s.lastErr = ""
+	for device, fields := range values {
+		for field, v := range fields {
+			if !s.fields[field] {
+				continue
+			}
+			if s.windows[device] == nil {
+				s.windows[device] = make(map[string]*window)
+			}
+			w, ok := s.windows[device][field]
+			if !ok {
+				w = &window{}
+				s.windows[device][field] = w
+			}
+			w.add(v, s.maxSamples)
+		}
+	}
+	return true
+}
+
+// Stats returns the stats of the latest samples keyed by device and field,
+// the samples are kept so every reader gets the same stats
+func (s *Sampler) Stats() map[string]map[string]*Stats {
+	s.Lock()
+	defer s.Unlock()
+	stats := make(map[string]map[string]*Stats, len(s.windows))
+	for device, fields := range s.windows {
+		stats[device] = make(map[string]*Stats, len(fields))
+		for field, w := range fields {
+			stats[device][field] = w.stats()
+		}
+	}
+	return stats
+}
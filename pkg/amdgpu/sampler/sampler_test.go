@@ -0,0 +1,100 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package sampler
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"gotest.tools/assert"
+)
+
+func TestSampler(t *testing.T) {
+	logger.Init(true)
+	values := []float64{300, 750, 200, 500, 250}
+	n := 0
+	source := func() (map[string]map[string]float64, error) {
+		if n == len(values) {
+			return nil, fmt.Errorf("read failed")
+		}
+		v := values[n]
+		n++
+		return map[string]map[string]float64{
+			"0000:c1:00.0": {"GPU_PACKAGE_POWER": v, "GPU_EDGE_TEMPERATURE": 40},
+		}, nil
+	}
+	observed := []float64{}
+	s := New(source, []string{"GPU_PACKAGE_POWER"}, WithMaxSamples(3),
+		WithObserver(func(device, field string, v float64) {
+			assert.Equal(t, device, "0000:c1:00.0")
+			assert.Equal(t, field, "GPU_PACKAGE_POWER")
+			observed = append(observed, v)
+		}))
+	for i := 0; i <= len(values); i++ {
+		s.Sample()
+	}
+	// every sample is observed, not only the kept ones
+	assert.DeepEqual(t, observed, values)
+
+	stats := s.Stats()
+	assert.Equal(t, len(stats), 1)
+	power := stats["0000:c1:00.0"]["GPU_PACKAGE_POWER"]
+	// only the latest samples are kept
+	assert.Equal(t, power.Count, 3)
+	assert.Equal(t, power.Min, 200.0)
+	assert.Equal(t, power.Max, 500.0)
+	assert.Equal(t, power.Avg(), 950.0/3)
+	assert.DeepEqual(t, power.Samples, []float64{200, 500, 250})
+	assert.Equal(t, power.Quantile(0.5), 250.0)
+	assert.Equal(t, power.Quantile(1), 500.0)
+	assert.Equal(t, power.Quantile(0.25), 225.0)
+	_, ok := stats["0000:c1:00.0"]["GPU_EDGE_TEMPERATURE"]
+	assert.Assert(t, !ok)
+
+	// reading the stats doesn't change them
+	assert.DeepEqual(t, s.Stats(), stats)
+}
+
+func TestSamplerStartStop(t *testing.T) {
+	logger.Init(true)
+	var reads atomic.Int32
+	source := func() (map[string]map[string]float64, error) {
+		reads.Add(1)
+		return map[string]map[string]float64{"gpu": {"GPU_GFX_ACTIVITY": 100}}, nil
+	}
+	s := New(source, []string{"GPU_GFX_ACTIVITY"}, WithInterval(time.Millisecond))
+	s.Start()
+	assert.Assert(t, waitFor(func() bool { return reads.Load() >= 5 }))
+	s.Stop()
+	s.Stop()
+	count := s.Stats()["gpu"]["GPU_GFX_ACTIVITY"].Count
+	assert.Assert(t, count >= 5)
+	assert.Equal(t, int(reads.Load()), count)
+}
+
+func waitFor(cond func() bool) bool {
+	for i := 0; i < 1000; i++ {
+		if cond() {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return false
+}
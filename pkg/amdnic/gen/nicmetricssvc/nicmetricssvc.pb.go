@@ -139,6 +139,62 @@ func (Health) EnumDescriptor() ([]byte, []int) {
 	return file_nicmetricssvc_proto_rawDescGZIP(), []int{1}
 }
 
+type StateEventType int32
+
+const (
+	// full list of the NIC states, always the first event of the stream
+	StateEventType_SNAPSHOT StateEventType = 0
+	// NIC reported for the first time
+	StateEventType_ADDED StateEventType = 1
+	// state of the NIC changed
+	StateEventType_MODIFIED StateEventType = 2
+	// NIC no longer reported
+	StateEventType_DELETED StateEventType = 3
+)
+
+// Enum value maps for StateEventType.
+var (
+	StateEventType_name = map[int32]string{
+		0: "SNAPSHOT",
+		1: "ADDED",
+		2: "MODIFIED",
+		3: "DELETED",
+	}
+	StateEventType_value = map[string]int32{
+		"SNAPSHOT": 0,
+		"ADDED":    1,
+		"MODIFIED": 2,
+		"DELETED":  3,
+	}
+)
+
+func (x StateEventType) Enum() *StateEventType {
+	p := new(StateEventType)
+	*p = x
+	return p
+}
+
+func (x StateEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StateEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_nicmetricssvc_proto_enumTypes[2].Descriptor()
+}
+
+func (StateEventType) Type() protoreflect.EnumType {
+	return &file_nicmetricssvc_proto_enumTypes[2]
+}
+
+func (x StateEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StateEventType.Descriptor instead.
+func (StateEventType) EnumDescriptor() ([]byte, []int) {
+	return file_nicmetricssvc_proto_rawDescGZIP(), []int{2}
+}
+
 type NICState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -253,6 +309,62 @@ func (x *NICStateResponse) GetNICState() []*NICState {
 	return nil
 }
 
+type NICStateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type StateEventType `protobuf:"varint,1,opt,name=Type,proto3,enum=nicmetricssvc.StateEventType" json:"Type,omitempty"`
+	// full list on SNAPSHOT, the changed NIC states otherwise
+	NICState []*NICState `protobuf:"bytes,2,rep,name=NICState,proto3" json:"NICState,omitempty"`
+}
+
+func (x *NICStateEvent) Reset() {
+	*x = NICStateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nicmetricssvc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NICStateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NICStateEvent) ProtoMessage() {}
+
+func (x *NICStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_nicmetricssvc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NICStateEvent.ProtoReflect.Descriptor instead.
+func (*NICStateEvent) Descriptor() ([]byte, []int) {
+	return file_nicmetricssvc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NICStateEvent) GetType() StateEventType {
+	if x != nil {
+		return x.Type
+	}
+	return StateEventType_SNAPSHOT
+}
+
+func (x *NICStateEvent) GetNICState() []*NICState {
+	if x != nil {
+		return x.NICState
+	}
+	return nil
+}
+
 var File_nicmetricssvc_proto protoreflect.FileDescriptor
 
 var file_nicmetricssvc_proto_rawDesc = []byte{
@@ -269,19 +381,36 @@ var file_nicmetricssvc_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x4e, 0x49, 0x43, 0x53, 0x74, 0x61, 0x74,
 	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x69, 0x63, 0x6d, 0x65, 0x74,
 	0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x4e, 0x49, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x08, 0x4e, 0x49, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x1e, 0x0a, 0x0a, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x00,
-	0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x2a, 0x31, 0x0a, 0x06, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x0d,
-	0x0a, 0x09, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x32, 0x53, 0x0a,
+	0x52, 0x08, 0x4e, 0x49, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x77, 0x0a, 0x0d, 0x4e, 0x49,
+	0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6e, 0x69, 0x63, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33,
+	0x0a, 0x08, 0x4e, 0x49, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6e, 0x69, 0x63, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63,
+	0x2e, 0x4e, 0x49, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x4e, 0x49, 0x43, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x2a, 0x1e, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x01, 0x2a, 0x31, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45,
+	0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x2a, 0x44, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x4e, 0x41, 0x50,
+	0x53, 0x48, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x32, 0x96, 0x01, 0x0a,
 	0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
 	0x41, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
 	0x1f, 0x2e, 0x6e, 0x69, 0x63, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e,
 	0x4e, 0x49, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x13, 0x5a, 0x11, 0x67, 0x65, 0x6e, 0x2f, 0x6e, 0x69, 0x63, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6e, 0x69, 0x63, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x73, 0x76, 0x63, 0x2e, 0x4e, 0x49, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x13, 0x5a, 0x11, 0x67, 0x65, 0x6e, 0x2f, 0x6e, 0x69, 0x63,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -296,24 +425,30 @@ func file_nicmetricssvc_proto_rawDescGZIP() []byte {
 	return file_nicmetricssvc_proto_rawDescData
 }
 
-var file_nicmetricssvc_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_nicmetricssvc_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_nicmetricssvc_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_nicmetricssvc_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_nicmetricssvc_proto_goTypes = []any{
 	(AdminState)(0),          // 0: nicmetricssvc.AdminState
 	(Health)(0),              // 1: nicmetricssvc.Health
-	(*NICState)(nil),         // 2: nicmetricssvc.NICState
-	(*NICStateResponse)(nil), // 3: nicmetricssvc.NICStateResponse
-	(*empty.Empty)(nil),      // 4: google.protobuf.Empty
+	(StateEventType)(0),      // 2: nicmetricssvc.StateEventType
+	(*NICState)(nil),         // 3: nicmetricssvc.NICState
+	(*NICStateResponse)(nil), // 4: nicmetricssvc.NICStateResponse
+	(*NICStateEvent)(nil),    // 5: nicmetricssvc.NICStateEvent
+	(*empty.Empty)(nil),      // 6: google.protobuf.Empty
 }
 var file_nicmetricssvc_proto_depIdxs = []int32{
-	2, // 0: nicmetricssvc.NICStateResponse.NICState:type_name -> nicmetricssvc.NICState
-	4, // 1: nicmetricssvc.MetricsService.List:input_type -> google.protobuf.Empty
-	3, // 2: nicmetricssvc.MetricsService.List:output_type -> nicmetricssvc.NICStateResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: nicmetricssvc.NICStateResponse.NICState:type_name -> nicmetricssvc.NICState
+	2, // 1: nicmetricssvc.NICStateEvent.Type:type_name -> nicmetricssvc.StateEventType
+	3, // 2: nicmetricssvc.NICStateEvent.NICState:type_name -> nicmetricssvc.NICState
+	6, // 3: nicmetricssvc.MetricsService.List:input_type -> google.protobuf.Empty
+	6, // 4: nicmetricssvc.MetricsService.Watch:input_type -> google.protobuf.Empty
+	4, // 5: nicmetricssvc.MetricsService.List:output_type -> nicmetricssvc.NICStateResponse
+	5, // 6: nicmetricssvc.MetricsService.Watch:output_type -> nicmetricssvc.NICStateEvent
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_nicmetricssvc_proto_init() }
@@ -346,14 +481,26 @@ func file_nicmetricssvc_proto_init() {
 				return nil
 			}
 		}
+		file_nicmetricssvc_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*NICStateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nicmetricssvc_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
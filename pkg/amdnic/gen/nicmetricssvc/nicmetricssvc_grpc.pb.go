@@ -42,7 +42,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MetricsService_List_FullMethodName = "/nicmetricssvc.MetricsService/List"
+	MetricsService_List_FullMethodName  = "/nicmetricssvc.MetricsService/List"
+	MetricsService_Watch_FullMethodName = "/nicmetricssvc.MetricsService/Watch"
 )
 
 // MetricsServiceClient is the client API for MetricsService service.
@@ -51,6 +52,9 @@ const (
 type MetricsServiceClient interface {
 	// NIC APIs
 	List(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NICStateResponse, error)
+	// stream of NIC state changes, starting with a snapshot of all states,
+	// the changes are found by polling the NIC states every 30 seconds
+	Watch(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NICStateEvent], error)
 }
 
 type metricsServiceClient struct {
@@ -71,12 +75,34 @@ func (c *metricsServiceClient) List(ctx context.Context, in *empty.Empty, opts .
 	return out, nil
 }
 
+func (c *metricsServiceClient) Watch(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NICStateEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MetricsService_ServiceDesc.Streams[0], MetricsService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[empty.Empty, NICStateEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetricsService_WatchClient = grpc.ServerStreamingClient[NICStateEvent]
+
 // MetricsServiceServer is the server API for MetricsService service.
 // All implementations must embed UnimplementedMetricsServiceServer
 // for forward compatibility.
 type MetricsServiceServer interface {
 	// NIC APIs
 	List(context.Context, *empty.Empty) (*NICStateResponse, error)
+	// stream of NIC state changes, starting with a snapshot of all states,
+	// the changes are found by polling the NIC states every 30 seconds
+	Watch(*empty.Empty, grpc.ServerStreamingServer[NICStateEvent]) error
 	mustEmbedUnimplementedMetricsServiceServer()
 }
 
@@ -90,6 +116,9 @@ type UnimplementedMetricsServiceServer struct{}
 func (UnimplementedMetricsServiceServer) List(context.Context, *empty.Empty) (*NICStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedMetricsServiceServer) Watch(*empty.Empty, grpc.ServerStreamingServer[NICStateEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedMetricsServiceServer) mustEmbedUnimplementedMetricsServiceServer() {}
 func (UnimplementedMetricsServiceServer) testEmbeddedByValue()                        {}
 
@@ -129,6 +158,17 @@ func _MetricsService_List_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetricsService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetricsServiceServer).Watch(m, &grpc.GenericServerStream[empty.Empty, NICStateEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetricsService_WatchServer = grpc.ServerStreamingServer[NICStateEvent]
+
 // MetricsService_ServiceDesc is the grpc.ServiceDesc for MetricsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -141,6 +181,12 @@ var MetricsService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _MetricsService_List_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _MetricsService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "nicmetricssvc.proto",
 }
@@ -19,6 +19,7 @@ package metricsserver
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
@@ -29,13 +30,17 @@ type MetricsSvcImpl struct {
 	sync.Mutex
 	enableDebugAPI bool
 	nicmetricssvc.UnimplementedMetricsServiceServer
-	clients []HealthInterface
+	clients       []HealthInterface
+	watchInterval time.Duration
+	watch         *watchPoller
 }
 
 func NewMetricsServer(enableDebugAPI bool) *MetricsSvcImpl {
 	msrv := &MetricsSvcImpl{
 		enableDebugAPI: enableDebugAPI,
 		clients:        []HealthInterface{},
+		watchInterval:  defaultWatchInterval,
+		watch:          newWatchPoller(),
 	}
 	return msrv
 }
@@ -20,8 +20,11 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gotest.tools/assert"
 )
@@ -30,9 +33,11 @@ import (
 type MockHealthInterface struct {
 	nicHealthStateMap map[string]interface{}
 	err               error
+	lookups           int
 }
 
 func (m *MockHealthInterface) GetNICHealthStates() (map[string]interface{}, error) {
+	m.lookups++
 	return m.nicHealthStateMap, m.err
 }
 
@@ -84,3 +89,106 @@ func TestList(t *testing.T) {
 	assert.Assert(t, err != nil, "expected error from List due to mock error")
 	assert.Assert(t, resp == nil, "expected response to be nil due to error")
 }
+
+// mockWatchStream collects the events sent on the watch stream
+type mockWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *nicmetricssvc.NICStateEvent
+}
+
+func (s *mockWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockWatchStream) Send(e *nicmetricssvc.NICStateEvent) error {
+	s.events <- e
+	return nil
+}
+
+func TestWatch(t *testing.T) {
+	logger.Init(true)
+	server := NewMetricsServer(false)
+	server.watchInterval = 10 * time.Millisecond
+	mockClient := &MockHealthInterface{
+		nicHealthStateMap: map[string]interface{}{
+			"nic1": &nicmetricssvc.NICState{UUID: "uuid1", Device: "device1", Health: "healthy"},
+		},
+	}
+	server.RegisterHealthClient(mockClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockWatchStream{ctx: ctx, events: make(chan *nicmetricssvc.NICStateEvent, 10)}
+	done := make(chan error)
+	go func() {
+		done <- server.Watch(&emptypb.Empty{}, stream)
+	}()
+
+	event := <-stream.events
+	assert.Equal(t, event.Type, nicmetricssvc.StateEventType_SNAPSHOT)
+	assert.Equal(t, len(event.NICState), 1)
+
+	server.Lock()
+	mockClient.nicHealthStateMap = map[string]interface{}{
+		"nic1": &nicmetricssvc.NICState{UUID: "uuid1", Device: "device1", Health: "unhealthy"},
+	}
+	server.Unlock()
+	event = <-stream.events
+	assert.Equal(t, event.Type, nicmetricssvc.StateEventType_MODIFIED)
+	assert.Equal(t, event.NICState[0].Health, "unhealthy")
+
+	cancel()
+	assert.Assert(t, <-done == nil, "expected watch to end on cancel")
+}
+
+func TestWatchSharedPoller(t *testing.T) {
+	logger.Init(true)
+	server := NewMetricsServer(false)
+	server.watchInterval = time.Hour
+	mockClient := &MockHealthInterface{
+		nicHealthStateMap: map[string]interface{}{
+			"nic1": &nicmetricssvc.NICState{UUID: "uuid1", Device: "device1", Health: "healthy"},
+		},
+	}
+	server.RegisterHealthClient(mockClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		stream := &mockWatchStream{ctx: ctx, events: make(chan *nicmetricssvc.NICStateEvent, 10)}
+		go func() {
+			done <- server.Watch(&emptypb.Empty{}, stream)
+		}()
+		// wait for the snapshot so the second stream reuses the lookup
+		event := <-stream.events
+		assert.Equal(t, event.Type, nicmetricssvc.StateEventType_SNAPSHOT)
+	}
+	server.Lock()
+	assert.Equal(t, mockClient.lookups, 1, "expected one lookup shared by the streams")
+	server.Unlock()
+
+	cancel()
+	assert.Assert(t, <-done == nil, "expected watch to end on cancel")
+	assert.Assert(t, <-done == nil, "expected watch to end on cancel")
+	server.watch.Lock()
+	assert.Assert(t, server.watch.stop == nil, "expected poller to stop with the last stream")
+	server.watch.Unlock()
+}
+
+func TestNICStateEvents(t *testing.T) {
+	oldStates := map[string]*nicmetricssvc.NICState{
+		"uuid1": {UUID: "uuid1", Device: "device1", Health: "healthy"},
+		"uuid2": {UUID: "uuid2", Device: "device2", Health: "healthy"},
+	}
+	newStates := map[string]*nicmetricssvc.NICState{
+		"uuid1": {UUID: "uuid1", Device: "device1", Health: "healthy"},
+		"uuid3": {UUID: "uuid3", Device: "device3", Health: "healthy"},
+	}
+	events := nicStateEvents(oldStates, newStates)
+	assert.Equal(t, len(events), 2)
+	assert.Equal(t, events[0].Type, nicmetricssvc.StateEventType_DELETED)
+	assert.Equal(t, events[0].NICState[0].UUID, "uuid2")
+	assert.Equal(t, events[1].Type, nicmetricssvc.StateEventType_ADDED)
+	assert.Equal(t, events[1].NICState[0].UUID, "uuid3")
+	assert.Equal(t, len(nicStateEvents(newStates, newStates)), 0)
+}
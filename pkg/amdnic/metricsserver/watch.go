@@ -0,0 +1,219 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// interval at which the watch poller looks up the NIC states, the lookup
+// queries nicctl for every LIF so it is kept at the health check interval
+const defaultWatchInterval = 30 * time.Second
+
+// nicStatesResult is the result of one lookup of the NIC states, it is
+// shared by all the watch streams and must not be modified
+type nicStatesResult struct {
+	states map[string]*nicmetricssvc.NICState
+	err    error
+}
+
+// watchPoller looks up the NIC states once per interval on behalf of all
+// the watch streams and fans the result out to them, it only runs while
+// there is at least one subscribed stream
+type watchPoller struct {
+	sync.Mutex
+	subs map[chan *nicStatesResult]bool
+	last *nicStatesResult
+	stop chan struct{}
+}
+
+func newWatchPoller() *watchPoller {
+	return &watchPoller{
+		subs: map[chan *nicStatesResult]bool{},
+	}
+}
+
+// Watch streams the NIC state changes, the first event is a snapshot of all
+// the NIC states followed by the change events
+func (m *MetricsSvcImpl) Watch(e *emptypb.Empty, stream nicmetricssvc.MetricsService_WatchServer) error {
+	ch := m.subscribeWatch()
+	defer m.unsubscribeWatch(ch)
+
+	var last map[string]*nicmetricssvc.NICState
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case res := <-ch:
+			if res.err != nil && last == nil {
+				return res.err
+			}
+			if res.err != nil {
+				logger.Log.Printf("watch failed to get nic states, err: %v", res.err)
+				continue
+			}
+			for _, event := range nicStateEvents(last, res.states) {
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+			}
+			last = res.states
+		}
+	}
+}
+
+// subscribeWatch registers a watch stream with the poller, starting the
+// poller for the first stream. The latest lookup, if any, is delivered
+// right away so a new stream does not wait for the next interval
+func (m *MetricsSvcImpl) subscribeWatch() chan *nicStatesResult {
+	w := m.watch
+	w.Lock()
+	defer w.Unlock()
+	ch := make(chan *nicStatesResult, 1)
+	w.subs[ch] = true
+	if w.last != nil {
+		ch <- w.last
+	}
+	if len(w.subs) == 1 {
+		w.stop = make(chan struct{})
+		go m.pollNICStates(w.stop)
+	}
+	return ch
+}
+
+// unsubscribeWatch removes a watch stream, the poller is stopped with the
+// last stream
+func (m *MetricsSvcImpl) unsubscribeWatch(ch chan *nicStatesResult) {
+	w := m.watch
+	w.Lock()
+	defer w.Unlock()
+	delete(w.subs, ch)
+	if len(w.subs) == 0 && w.stop != nil {
+		close(w.stop)
+		w.stop = nil
+		w.last = nil
+	}
+}
+
+// pollNICStates looks up the NIC states every watch interval until stopped
+func (m *MetricsSvcImpl) pollNICStates(stop chan struct{}) {
+	ticker := time.NewTicker(m.watchInterval)
+	defer ticker.Stop()
+	for {
+		states, err := m.getNICStates()
+		m.watch.publish(stop, &nicStatesResult{states: states, err: err})
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish hands the lookup to all the subscribed streams, a stream that has
+// not consumed the previous lookup only gets the latest one
+func (w *watchPoller) publish(stop chan struct{}, res *nicStatesResult) {
+	w.Lock()
+	defer w.Unlock()
+	select {
+	case <-stop:
+		// poller stopped while looking up the states
+		return
+	default:
+	}
+	w.last = res
+	for ch := range w.subs {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- res
+	}
+}
+
+// getNICStates returns the NIC states of all registered clients keyed by
+// the LIF UUID
+func (m *MetricsSvcImpl) getNICStates() (map[string]*nicmetricssvc.NICState, error) {
+	resp, err := m.List(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	states := map[string]*nicmetricssvc.NICState{}
+	for _, state := range resp.NICState {
+		states[state.UUID] = state
+	}
+	return states, nil
+}
+
+// nicStateEvents returns the events to move from the old to the new states,
+// a snapshot if there is no old state
+func nicStateEvents(oldStates, newStates map[string]*nicmetricssvc.NICState) []*nicmetricssvc.NICStateEvent {
+	if oldStates == nil {
+		return []*nicmetricssvc.NICStateEvent{
+			{Type: nicmetricssvc.StateEventType_SNAPSHOT, NICState: sortedNICStates(newStates)},
+		}
+	}
+	added := map[string]*nicmetricssvc.NICState{}
+	modified := map[string]*nicmetricssvc.NICState{}
+	deleted := map[string]*nicmetricssvc.NICState{}
+	for id, nstate := range newStates {
+		ostate, ok := oldStates[id]
+		if !ok {
+			added[id] = nstate
+		} else if !proto.Equal(ostate, nstate) {
+			modified[id] = nstate
+		}
+	}
+	for id, ostate := range oldStates {
+		if _, ok := newStates[id]; !ok {
+			deleted[id] = ostate
+		}
+	}
+	events := []*nicmetricssvc.NICStateEvent{}
+	for _, e := range []struct {
+		eventType nicmetricssvc.StateEventType
+		states    map[string]*nicmetricssvc.NICState
+	}{
+		{nicmetricssvc.StateEventType_DELETED, deleted},
+		{nicmetricssvc.StateEventType_ADDED, added},
+		{nicmetricssvc.StateEventType_MODIFIED, modified},
+	} {
+		if len(e.states) > 0 {
+			events = append(events, &nicmetricssvc.NICStateEvent{Type: e.eventType, NICState: sortedNICStates(e.states)})
+		}
+	}
+	return events
+}
+
+func sortedNICStates(states map[string]*nicmetricssvc.NICState) []*nicmetricssvc.NICState {
+	list := make([]*nicmetricssvc.NICState, 0, len(states))
+	for _, s := range states {
+		list = append(list, s)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Device < list[j].Device
+	})
+	return list
+}
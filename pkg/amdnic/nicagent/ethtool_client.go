@@ -74,7 +74,7 @@ func yamlifyEthtoolOutput(res []byte) []byte {
 	return lines
 }
 
-func (ec *EthtoolClient) UpdateNICStats(workloads map[string]scheduler.Workload) error {
+func (ec *EthtoolClient) UpdateNICStats(workloads map[string][]scheduler.Workload) error {
 	if !fetchEthtoolMetrics {
 		return nil
 	}
@@ -83,14 +83,14 @@ func (ec *EthtoolClient) UpdateNICStats(workloads map[string]scheduler.Workload)
 
 	// fetch Host Interface Stats
 	var nilPodInfo *scheduler.PodResourceInfo
-	if err := ec.fetchEthStatsForDevicesInPod(nilPodInfo); err != nil {
+	if err := ec.fetchEthStatsForDevicesInPod(nilPodInfo, workloads); err != nil {
 		logger.Log.Printf("failure to fetch stats for devices in Host: %v", err)
 	}
 
 	// fetch K8s Workload Pod Interface Stats
-	for i := range workloads {
-		wlPodInfo := (workloads[i].Info.(scheduler.PodResourceInfo))
-		if err := ec.fetchEthStatsForDevicesInPod(&wlPodInfo); err != nil {
+	for _, wlPodInfo := range workloadPods(workloads) {
+		wlPodInfo := wlPodInfo
+		if err := ec.fetchEthStatsForDevicesInPod(&wlPodInfo, workloads); err != nil {
 			logger.Log.Printf("failure to fetch stats for devices in pod: %v", err)
 		}
 	}
@@ -98,8 +98,9 @@ func (ec *EthtoolClient) UpdateNICStats(workloads map[string]scheduler.Workload)
 	return nil
 }
 
-// For Host Devices, podInfo arg value will be nil
-func (ec *EthtoolClient) fetchEthStatsForDevicesInPod(podInfo *scheduler.PodResourceInfo) error {
+// For Host Devices, podInfo arg value will be nil and the labels of the
+// workloads registered with the devices are set
+func (ec *EthtoolClient) fetchEthStatsForDevicesInPod(podInfo *scheduler.PodResourceInfo, workloads map[string][]scheduler.Workload) error {
 	netDevList, err := ec.na.getNetDevicesList(podInfo)
 	if err != nil {
 		logger.Log.Printf("failed to get netDevices in podInfo %v: %v", podInfo, err)
@@ -108,6 +109,9 @@ func (ec *EthtoolClient) fetchEthStatsForDevicesInPod(podInfo *scheduler.PodReso
 
 	for i := range netDevList {
 		labels := ec.na.populateLabelsForNetDevice(netDevList[i], podInfo)
+		if podInfo == nil {
+			ec.na.addRegisteredWorkloadLabels(labels, netDevList[i].PCIeBusId, workloads)
+		}
 		if err := ec.populateEthStatsForNetDevice(netDevList[i], labels); err != nil {
 			logger.Log.Printf("failure in fetch for ethstats of netDev %v : %v", netDevList[i], err)
 		}
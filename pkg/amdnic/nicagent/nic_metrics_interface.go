@@ -20,7 +20,7 @@ import "github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 
 type NICInterface interface {
 	// fill AINIC stats
-	UpdateNICStats(map[string]scheduler.Workload) error
+	UpdateNICStats(map[string][]scheduler.Workload) error
 	// Initiate connection and return connection status
 	Init() error
 	// Return NIC Client name implementing this interface
@@ -43,7 +43,10 @@ type NICAgentClient struct {
 	isKubernetes           bool
 	nics                   map[string]*NIC
 	k8sScheduler           scheduler.SchedulerClient
+	registrationScheduler  scheduler.SchedulerClient
 	k8sApiClient           *k8sclient.K8sClient
+	eventRecorder          *k8sclient.EventRecorder
+	reportedHealth         map[string]string // pcie address -> last reported health
 	staticHostLabels       map[string]string // static labels for the host
 	nodeHealthLabellerCfg  *utils.NodeHealthLabellerConfig
 	ctx                    context.Context
@@ -67,6 +70,14 @@ func WithK8sSchedulerClient(k8sScheduler scheduler.SchedulerClient) NICAgentClie
 	}
 }
 
+// WithRegistrationClient merges the workloads registered by the external
+// schedulers, the client is shared with the gpu agent and not closed
+func WithRegistrationClient(registration scheduler.SchedulerClient) NICAgentClientOptions {
+	return func(na *NICAgentClient) {
+		na.registrationScheduler = registration
+	}
+}
+
 // WithK8sClient sets the Kubernetes API client for the NICAgentClient
 func WithK8sClient(k8sApiClient *k8sclient.K8sClient) NICAgentClientOptions {
 	return func(na *NICAgentClient) {
@@ -74,6 +85,7 @@ func WithK8sClient(k8sApiClient *k8sclient.K8sClient) NICAgentClientOptions {
 			na.isKubernetes = true
 			logger.Log.Printf("K8sApiClient option set")
 			na.k8sApiClient = k8sApiClient
+			na.eventRecorder = k8sclient.NewEventRecorder(k8sApiClient, utils.GetNodeName())
 		}
 	}
 }
@@ -308,12 +320,30 @@ func (na *NICAgentClient) getNetDevicesList(podInfo *scheduler.PodResourceInfo)
 	return netDevices, nil
 }
 
-// ListWorkloads returns the list of workloads by device ID
-func (na *NICAgentClient) ListWorkloads() (map[string]scheduler.Workload, error) {
-	if na.isKubernetes && na.k8sScheduler != nil {
-		return na.k8sScheduler.ListWorkloads()
+// ListWorkloads returns the workloads of every device ID, a NIC may be
+// shared by several pods, kubernetes pods are listed before the registered
+// workloads
+func (na *NICAgentClient) ListWorkloads() (map[string][]scheduler.Workload, error) {
+	k8sEnabled := na.isKubernetes && na.k8sScheduler != nil
+	if !k8sEnabled && na.registrationScheduler == nil {
+		return nil, fmt.Errorf("scheduler is not initialized")
+	}
+	wls := make(map[string][]scheduler.Workload)
+	for _, scl := range []scheduler.SchedulerClient{na.k8sScheduler, na.registrationScheduler} {
+		if scl == nil || (scl == na.k8sScheduler && !k8sEnabled) {
+			continue
+		}
+		swls, err := scl.ListWorkloads()
+		if err != nil {
+			return nil, err
+		}
+		for k, list := range swls {
+			for _, wl := range list {
+				scheduler.AddWorkload(wls, k, wl)
+			}
+		}
 	}
-	return nil, fmt.Errorf("scheduler is not initialized")
+	return wls, nil
 }
 
 func (na *NICAgentClient) initializeContext() {
@@ -347,14 +377,14 @@ func (na *NICAgentClient) getMetricsAll() error {
 	if err != nil {
 		logger.Log.Printf("failed to list workloads, err: %v", err)
 	}
-	for i := range workloads {
-		podInfo := workloads[i].Info.(scheduler.PodResourceInfo)
+	for _, podInfo := range workloadPods(workloads) {
 		if err := na.addPodPidIfAbsent(podInfo.Pod, podInfo.Namespace); err != nil {
 			logger.Log.Printf("failure in pod2pid update for pod %s ns %s: %v",
 				podInfo.Pod, podInfo.Namespace, err)
 		}
 	}
 	k8PodLabelsMap, _ = na.fetchPodLabelsForNode()
+	na.fetchK8sMetadata()
 
 	labels := na.populateLabelsFromNIC("")
 	na.m.nicNodesTotal.With(labels).Set(float64(len(na.nics)))
@@ -386,18 +416,35 @@ func (na *NICAgentClient) sendNodeLabelUpdate(healthState map[string]interface{}
 		return fmt.Errorf("node name not found")
 	}
 	nicHealthStates := make(map[string]string)
+	// condition message reports the PCIe address as is
+	nicConditionStates := make(map[string]string)
 	for nicPCIeAddr, h := range healthState {
 		hs := h.(*nicmetricssvc.NICState)
 		if hs.Health == strings.ToLower(nicmetricssvc.Health_HEALTHY.String()) {
 			logger.Log.Printf("NIC %s is healthy, skipping label update", nicPCIeAddr)
 			continue
 		}
+		nicConditionStates[nicPCIeAddr] = hs.Health
 
 		nicPCIeAddr = strings.ReplaceAll(nicPCIeAddr, ":", "_") // replace ':' with '_' for label compatibility
 		nicPCIeAddr = strings.ReplaceAll(nicPCIeAddr, ".", "_")
 		nicHealthStates[nicPCIeAddr] = hs.Health
 	}
-	_ = na.k8sApiClient.UpdateHealthLabel(na.nodeHealthLabellerCfg, nodeName, nicHealthStates)
+	reporting := na.mh.GetNICMetricsConfig().GetNodeHealthReporting()
+	if !reporting.GetDisableNodeLabels() {
+		_ = na.k8sApiClient.UpdateHealthLabel(na.nodeHealthLabellerCfg, nodeName, nicHealthStates)
+	}
+	if reporting.GetEnableNodeCondition() {
+		condType := reporting.GetConditionType()
+		if condType == "" {
+			condType = k8sclient.NICHealthConditionType
+		}
+		cond := k8sclient.NewHealthCondition(condType, "NIC", nicConditionStates, nil)
+		_ = na.k8sApiClient.UpdateHealthCondition(nodeName, cond)
+	}
+	if !reporting.GetDisableEvents() {
+		na.sendHealthEvents(healthState)
+	}
 	return nil
 }
 
@@ -409,6 +456,28 @@ func (na *NICAgentClient) fetchPodLabelsForNode() (map[string]map[string]string,
 	return listMap, nil
 }
 
+// fetchK8sMetadata refreshes the pod annotations and node labels exported
+// with the metrics, the last known values are kept on failures
+func (na *NICAgentClient) fetchK8sMetadata() {
+	if !utils.IsKubernetes() || na.k8sApiClient == nil {
+		return
+	}
+	if len(extraPodAnnotationsMap) > 0 {
+		if annotations, err := na.k8sApiClient.GetAllPodAnnotations(); err != nil {
+			logger.Log.Printf("failed to fetch pod annotations for node: %v", err)
+		} else {
+			k8PodAnnotationsMap = annotations
+		}
+	}
+	if len(nodeLabelsMap) > 0 {
+		if nodeLabels, err := na.k8sApiClient.GetNodeLabels(); err != nil {
+			logger.Log.Printf("failed to fetch node labels: %v", err)
+		} else {
+			k8NodeLabels = nodeLabels
+		}
+	}
+}
+
 func (na *NICAgentClient) Close() {
 	na.Lock()
 	defer na.Unlock()
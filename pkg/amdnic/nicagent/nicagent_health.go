@@ -22,8 +22,11 @@ import (
 	"os/exec"
 	"strings"
 
+	v1 "k8s.io/api/core/v1"
+
 	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 )
 
 // GetNICHealthStates retrieves the health states of all NICs managed by the NIC agent.
@@ -112,3 +115,59 @@ func (na *NICAgentClient) getAdminStatus(lifUUID string) (string, error) {
 
 	return resp.NIC[0].Lif[0].Spec.AdminState, nil
 }
+
+// sendHealthEvents posts the NIC health transitions since the last report as
+// events on the node and on the pods using the NIC
+func (na *NICAgentClient) sendHealthEvents(healthState map[string]interface{}) {
+	if na.eventRecorder == nil {
+		return
+	}
+	healthy := strings.ToLower(nicmetricssvc.Health_HEALTHY.String())
+	transitions := map[string]string{}
+	na.Lock()
+	if na.reportedHealth == nil {
+		na.reportedHealth = make(map[string]string)
+	}
+	for nicPCIeAddr, h := range healthState {
+		hs, ok := h.(*nicmetricssvc.NICState)
+		if !ok {
+			continue
+		}
+		previous, known := na.reportedHealth[nicPCIeAddr]
+		na.reportedHealth[nicPCIeAddr] = hs.Health
+		// a NIC discovered healthy is not a transition
+		if previous == hs.Health || (!known && hs.Health == healthy) {
+			continue
+		}
+		transitions[nicPCIeAddr] = hs.Health
+	}
+	na.Unlock()
+	if len(transitions) == 0 {
+		return
+	}
+
+	wls, err := na.ListWorkloads()
+	if err != nil {
+		logger.Log.Printf("failed to list workloads for NIC health events, err: %v", err)
+	}
+	for nicPCIeAddr, health := range transitions {
+		evtType, reason := v1.EventTypeWarning, "AMDNICUnhealthy"
+		if health == healthy {
+			evtType, reason = v1.EventTypeNormal, "AMDNICHealthy"
+		}
+		msg := fmt.Sprintf("NIC %v is %v", nicPCIeAddr, health)
+		if err := na.eventRecorder.NodeEvent(evtType, reason, msg); err != nil {
+			logger.Log.Printf("NIC %v node event failed, err: %v", nicPCIeAddr, err)
+		}
+		if health == healthy {
+			continue
+		}
+		// post once per pod sharing the NIC
+		for _, podInfo := range workloadPods(map[string][]scheduler.Workload{nicPCIeAddr: wls[nicPCIeAddr]}) {
+			if err := na.eventRecorder.PodEvent(podInfo.Namespace, podInfo.Pod, evtType, reason, msg); err != nil {
+				logger.Log.Printf("NIC %v pod %v/%v event failed, err: %v",
+					nicPCIeAddr, podInfo.Namespace, podInfo.Pod, err)
+			}
+		}
+	}
+}
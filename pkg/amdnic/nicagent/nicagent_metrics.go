@@ -19,6 +19,7 @@ package nicagent
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
@@ -47,6 +48,13 @@ var (
 	fetchPortMetrics    bool
 	fetchLifMetrics     bool
 	fetchQPMetrics      bool
+	// pod annotations and node labels mapped to prometheus labels
+	extraPodAnnotationsMap map[string]string
+	k8PodAnnotationsMap    map[string]map[string]string
+	nodeLabelsMap          map[string]string
+	k8NodeLabels           map[string]string
+	// registered workload labels mapped to prometheus labels
+	registeredLabelsMap map[string]string
 )
 
 type FieldMeta struct {
@@ -297,6 +305,10 @@ func (na *NICAgentClient) GetExporterNonNICLabels() []string {
 	labelList := []string{
 		strings.ToLower(exportermetrics.MetricLabel_HOSTNAME.String()),
 	}
+	// Add node labels
+	for label := range nodeLabelsMap {
+		labelList = append(labelList, label)
+	}
 	// Add custom labels
 	for label := range customLabelMap {
 		labelList = append(labelList, strings.ToLower(label))
@@ -399,6 +411,17 @@ func (na *NICAgentClient) populateLabelsForNetDevice(netDev NetDevice, podInfo *
 		}
 	}
 
+	// Add extra pod annotations only if config has mapped any
+	if len(extraPodAnnotationsMap) > 0 {
+		utils.MapLabels(labelMap, extraPodAnnotationsMap, utils.GetPodAnnotations(podInfo, k8PodAnnotationsMap))
+	}
+
+	// Add node labels
+	utils.MapLabels(labelMap, nodeLabelsMap, k8NodeLabels)
+
+	// registered workload labels are set by the caller from the workloads
+	utils.MapLabels(labelMap, registeredLabelsMap, nil)
+
 	// Add custom labels
 	for label, value := range customLabelMap {
 		labelMap[label] = value
@@ -415,17 +438,19 @@ func (na *NICAgentClient) GetExportLabels() []string { //TODO .. move to exporte
 		labelList = append(labelList, strings.ToLower(key))
 	}
 
-	// process extra pod labels
-	for key := range extraPodLabelsMap {
-		exists := false
-		for _, label := range labelList {
-			if key == label {
-				exists = true
-				break
+	// process extra pod labels, pod annotations, node labels and registered workload labels
+	for _, k8sLabelsMap := range []map[string]string{extraPodLabelsMap, extraPodAnnotationsMap, nodeLabelsMap, registeredLabelsMap} {
+		for key := range k8sLabelsMap {
+			exists := false
+			for _, label := range labelList {
+				if key == label {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				labelList = append(labelList, key)
 			}
-		}
-		if !exists {
-			labelList = append(labelList, key)
 		}
 	}
 
@@ -1845,11 +1870,60 @@ func (na *NICAgentClient) initPodExtraLabels(config *exportermetrics.NICMetricCo
 	logger.Log.Printf("export-labels updated to %v", extraPodLabelsMap)
 }
 
+// reservedMetadataLabels returns the label names the pod annotation and node
+// label mappings cannot use, the exporter labels, the custom labels and the
+// given mappings
+func reservedMetadataLabels(mappings ...map[string]string) map[string]bool {
+	reserved := map[string]bool{}
+	for _, name := range exportermetrics.MetricLabel_name {
+		reserved[strings.ToLower(name)] = true
+	}
+	for _, name := range exportermetrics.NICMetricLabel_name {
+		reserved[strings.ToLower(name)] = true
+	}
+	for _, name := range []string{LabelPortName, LabelPortID, LabelRdmaDevName, LabelPcieBusId,
+		LabelEthIntfName, LabelEthIntfAlias, LabelQPID} {
+		reserved[name] = true
+	}
+	for _, mapping := range append(mappings, customLabelMap) {
+		for label := range mapping {
+			reserved[label] = true
+		}
+	}
+	return reserved
+}
+
+func (na *NICAgentClient) initPodExtraAnnotations(config *exportermetrics.NICMetricConfig) {
+	// initialize pod annotations maps
+	k8PodAnnotationsMap = make(map[string]map[string]string)
+	extraPodAnnotationsMap = utils.FilterLabelMapping(
+		utils.NormalizeLabelMapping(config.GetExtraPodAnnotations(), globals.MaxSupportedPodAnnotations, "pod annotations"),
+		reservedMetadataLabels(extraPodLabelsMap), "pod annotations")
+	logger.Log.Printf("export-annotations updated to %v", extraPodAnnotationsMap)
+}
+
+func (na *NICAgentClient) initRegisteredLabels(config *exportermetrics.NICMetricConfig) {
+	registeredLabelsMap = utils.NormalizeLabelMapping(config.GetWorkloadLabels(),
+		globals.MaxSupportedWorkloadLabels, "workload labels")
+	logger.Log.Printf("export-workload-labels updated to %v", registeredLabelsMap)
+}
+
+func (na *NICAgentClient) initNodeLabels(config *exportermetrics.NICMetricConfig) {
+	k8NodeLabels = make(map[string]string)
+	nodeLabelsMap = utils.FilterLabelMapping(
+		utils.NormalizeLabelMapping(config.GetNodeLabels(), globals.MaxSupportedNodeLabels, "node labels"),
+		reservedMetadataLabels(extraPodLabelsMap, extraPodAnnotationsMap), "node labels")
+	logger.Log.Printf("export-node-labels updated to %v", nodeLabelsMap)
+}
+
 func (na *NICAgentClient) InitConfigs() error {
 	filedConfigs := na.mh.GetNICMetricsConfig()
 
-	na.initPodExtraLabels(filedConfigs)
 	na.initCustomLabels(filedConfigs)
+	na.initPodExtraLabels(filedConfigs)
+	na.initPodExtraAnnotations(filedConfigs)
+	na.initNodeLabels(filedConfigs)
+	na.initRegisteredLabels(filedConfigs)
 	na.initLabelConfigs(filedConfigs)
 	na.initFieldConfig(filedConfigs)
 	na.initPrometheusMetrics()
@@ -1863,6 +1937,7 @@ func (na *NICAgentClient) UpdateStaticMetrics() error {
 		logger.Log.Printf("Failed to fetch pod labels for node: %v", err)
 		return err
 	}
+	na.fetchK8sMetadata()
 	labels := na.populateLabelsFromNIC("")
 	na.m.nicNodesTotal.With(labels).Set(float64(len(na.nics)))
 	return nil
@@ -1928,13 +2003,18 @@ func (na *NICAgentClient) populateLabelsFromNIC(UUID string) map[string]string {
 		}
 	}
 
-	// these extra pod labels are overwritten when there is a workload associated with the NIC with the respective pod label values
-	for prometheusLabel := range extraPodLabelsMap {
-		if nic != nil {
-			labels[strings.ToLower(prometheusLabel)] = ""
+	// these extra pod labels, annotations and registered workload labels are overwritten when there is a workload associated with the NIC with the respective workload values
+	for _, k8sLabelsMap := range []map[string]string{extraPodLabelsMap, extraPodAnnotationsMap, registeredLabelsMap} {
+		for prometheusLabel := range k8sLabelsMap {
+			if nic != nil {
+				labels[strings.ToLower(prometheusLabel)] = ""
+			}
 		}
 	}
 
+	// Add node labels
+	utils.MapLabels(labels, nodeLabelsMap, k8NodeLabels)
+
 	// Add custom labels
 	for label, value := range customLabelMap {
 		labels[label] = value
@@ -1942,34 +2022,121 @@ func (na *NICAgentClient) populateLabelsFromNIC(UUID string) map[string]string {
 	return labels
 }
 
-func (na *NICAgentClient) getAssociatedWorkloadLabelsForPcieAddr(pcieAddr string, workloads map[string]scheduler.Workload) map[string]string {
+// getAssociatedWorkloadLabelsForPcieAddr returns the workload labels of the
+// NIC, the label values of a NIC shared by several workloads are comma
+// separated
+func (na *NICAgentClient) getAssociatedWorkloadLabelsForPcieAddr(pcieAddr string, workloads map[string][]scheduler.Workload) map[string]string {
 	labels := map[string]string{
 		strings.ToLower(exportermetrics.MetricLabel_POD.String()):       "",
 		strings.ToLower(exportermetrics.MetricLabel_NAMESPACE.String()): "",
 		strings.ToLower(exportermetrics.MetricLabel_CONTAINER.String()): "",
 	}
 
-	if wl, wlFound := workloads[pcieAddr]; wlFound {
-		podInfo := wl.Info.(scheduler.PodResourceInfo)
-		labels[strings.ToLower(exportermetrics.MetricLabel_POD.String())] = podInfo.Pod
-		labels[strings.ToLower(exportermetrics.MetricLabel_NAMESPACE.String())] = podInfo.Namespace
-		labels[strings.ToLower(exportermetrics.MetricLabel_CONTAINER.String())] = podInfo.Container
+	na.addRegisteredWorkloadLabels(labels, pcieAddr, workloads)
+	labelSets := []map[string]string{}
+	for _, wl := range workloads[pcieAddr] {
+		podInfo, ok := wl.Info.(scheduler.PodResourceInfo)
+		if !ok {
+			continue
+		}
+		podLabels := map[string]string{
+			strings.ToLower(exportermetrics.MetricLabel_POD.String()):       podInfo.Pod,
+			strings.ToLower(exportermetrics.MetricLabel_NAMESPACE.String()): podInfo.Namespace,
+			strings.ToLower(exportermetrics.MetricLabel_CONTAINER.String()): podInfo.Container,
+		}
 
 		// Add extra pod labels only if config has mapped any
 		if len(extraPodLabelsMap) > 0 {
-			podLabels := utils.GetPodLabels(&podInfo, k8PodLabelsMap)
+			k8sLabels := utils.GetPodLabels(&podInfo, k8PodLabelsMap)
 			// populate labels from extraPodLabelsMap; regarless of whether there is a workload or not
 			for prometheusPodlabel, k8Podlabel := range extraPodLabelsMap {
 				label := strings.ToLower(prometheusPodlabel)
-				labels[label] = podLabels[k8Podlabel]
+				podLabels[label] = k8sLabels[k8Podlabel]
 			}
 		}
+
+		// Add extra pod annotations only if config has mapped any
+		if len(extraPodAnnotationsMap) > 0 {
+			utils.MapLabels(podLabels, extraPodAnnotationsMap, utils.GetPodAnnotations(&podInfo, k8PodAnnotationsMap))
+		}
+		labelSets = append(labelSets, podLabels)
+	}
+	for k, v := range joinWorkloadLabels(labelSets) {
+		labels[k] = v
 	}
 	return labels
 }
 
+// addRegisteredWorkloadLabels sets the mapped labels of the workloads
+// registered with the NIC pcie address, empty without a registered workload
+func (na *NICAgentClient) addRegisteredWorkloadLabels(labels map[string]string, pcieAddr string, workloads map[string][]scheduler.Workload) {
+	labelSets := []map[string]string{}
+	for _, wl := range workloads[strings.ToLower(pcieAddr)] {
+		if reg, ok := wl.Info.(*scheduler.RegisteredWorkload); ok {
+			values := map[string]string{}
+			utils.MapLabels(values, registeredLabelsMap, reg.Labels)
+			labelSets = append(labelSets, values)
+		}
+	}
+	if len(labelSets) == 0 {
+		utils.MapLabels(labels, registeredLabelsMap, nil)
+		return
+	}
+	for k, v := range joinWorkloadLabels(labelSets) {
+		labels[k] = v
+	}
+}
+
+// joinWorkloadLabels merges the label sets of the workloads sharing a NIC,
+// the distinct values of every label are comma separated
+func joinWorkloadLabels(labelSets []map[string]string) map[string]string {
+	if len(labelSets) == 0 {
+		return map[string]string{}
+	}
+	if len(labelSets) == 1 {
+		return labelSets[0]
+	}
+	labels := make(map[string]string, len(labelSets[0]))
+	for key := range labelSets[0] {
+		values := []string{}
+		seen := map[string]bool{}
+		for _, set := range labelSets {
+			if v := set[key]; v != "" && !seen[v] {
+				seen[v] = true
+				values = append(values, v)
+			}
+		}
+		labels[key] = strings.Join(values, ",")
+	}
+	return labels
+}
+
+// workloadPods returns the pods of the workloads once, sorted by namespace
+// and name
+func workloadPods(workloads map[string][]scheduler.Workload) []scheduler.PodResourceInfo {
+	pods := []scheduler.PodResourceInfo{}
+	seen := map[string]bool{}
+	for _, list := range workloads {
+		for _, wl := range list {
+			podInfo, ok := wl.Info.(scheduler.PodResourceInfo)
+			if !ok || seen[podInfo.Namespace+"/"+podInfo.Pod] {
+				continue
+			}
+			seen[podInfo.Namespace+"/"+podInfo.Pod] = true
+			pods = append(pods, podInfo)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Pod < pods[j].Pod
+	})
+	return pods
+}
+
 // getAssociatedWorkloadLabels returns the workload labels for a given NIC and LIF
-func (na *NICAgentClient) getAssociatedWorkloadLabels(nicID string, lifID string, workloads map[string]scheduler.Workload) map[string]string {
+func (na *NICAgentClient) getAssociatedWorkloadLabels(nicID string, lifID string, workloads map[string][]scheduler.Workload) map[string]string {
 	labels := map[string]string{
 		strings.ToLower(exportermetrics.MetricLabel_POD.String()):       "",
 		strings.ToLower(exportermetrics.MetricLabel_NAMESPACE.String()): "",
@@ -2184,6 +2351,15 @@ func isExtraOrCustomLabel(key string) bool {
 	if _, ok := extraPodLabelsMap[key]; ok {
 		return true
 	}
+	if _, ok := extraPodAnnotationsMap[key]; ok {
+		return true
+	}
+	if _, ok := nodeLabelsMap[key]; ok {
+		return true
+	}
+	if _, ok := registeredLabelsMap[key]; ok {
+		return true
+	}
 	if _, ok := customLabelMap[key]; ok {
 		return true
 	}
@@ -58,11 +58,11 @@ func (rc *NICCtlClient) GetClientName() string {
 	return NICCtlClientName
 }
 
-func (nc *NICCtlClient) UpdateNICStats(workloads map[string]scheduler.Workload) error {
+func (nc *NICCtlClient) UpdateNICStats(workloads map[string][]scheduler.Workload) error {
 	nc.Lock()
 	defer nc.Unlock()
 
-	fn_ptrs := []func(map[string]scheduler.Workload) error{
+	fn_ptrs := []func(map[string][]scheduler.Workload) error{
 		nc.UpdatePortStats,
 		nc.UpdateLifStats,
 		nc.UpdateQPStats}
@@ -70,7 +70,7 @@ func (nc *NICCtlClient) UpdateNICStats(workloads map[string]scheduler.Workload)
 	var wg sync.WaitGroup
 	for _, fn := range fn_ptrs {
 		wg.Add(1)
-		go func(f func(map[string]scheduler.Workload) error) {
+		go func(f func(map[string][]scheduler.Workload) error) {
 			defer wg.Done()
 			if err := f(workloads); err != nil {
 				logger.Log.Printf("failed to update NIC stats, err: %+v", err)
@@ -81,7 +81,7 @@ func (nc *NICCtlClient) UpdateNICStats(workloads map[string]scheduler.Workload)
 	return nil
 }
 
-func (nc *NICCtlClient) UpdatePortStats(workloads map[string]scheduler.Workload) error {
+func (nc *NICCtlClient) UpdatePortStats(workloads map[string][]scheduler.Workload) error {
 	if !fetchPortMetrics {
 		return nil
 	}
@@ -168,7 +168,7 @@ func (nc *NICCtlClient) UpdatePortStats(workloads map[string]scheduler.Workload)
 	return nil
 }
 
-func (nc *NICCtlClient) UpdateLifStats(workloads map[string]scheduler.Workload) error {
+func (nc *NICCtlClient) UpdateLifStats(workloads map[string][]scheduler.Workload) error {
 	if !fetchLifMetrics {
 		return nil
 	}
@@ -217,7 +217,7 @@ func (nc *NICCtlClient) UpdateLifStats(workloads map[string]scheduler.Workload)
 	return nil
 }
 
-func (nc *NICCtlClient) UpdateQPStats(workloads map[string]scheduler.Workload) error {
+func (nc *NICCtlClient) UpdateQPStats(workloads map[string][]scheduler.Workload) error {
 	var wg sync.WaitGroup
 	if !fetchQPMetrics {
 		return nil
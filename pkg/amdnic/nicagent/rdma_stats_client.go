@@ -55,35 +55,38 @@ func (rc *RDMAStatsClient) GetClientName() string {
 	return RDMAClientName
 }
 
-func (rc *RDMAStatsClient) populateRdmaDeviceLabels(rdmaDevName, pcieAddr string, workloads map[string]scheduler.Workload) (map[string]string, error) {
-
-	var podInfo scheduler.PodResourceInfo
-	var podInfoPtr *scheduler.PodResourceInfo
-
-	wl, exists := workloads[pcieAddr]
-	if exists {
-		podInfo = (wl.Info.(scheduler.PodResourceInfo))
-		podInfoPtr = &podInfo
-	}
-
-	netDevices, err := rc.na.getNetDevicesList(podInfoPtr)
-	if err != nil {
-		err = fmt.Errorf("failed to get netdevs in pod %s: %v", podInfo.Pod, err)
-		return map[string]string{}, err
+// populateRdmaDeviceLabels returns the labels of the rdma device, the
+// device is looked up in the pods using the NIC and then on the host
+func (rc *RDMAStatsClient) populateRdmaDeviceLabels(rdmaDevName, pcieAddr string, workloads map[string][]scheduler.Workload) (map[string]string, error) {
+	pods := workloadPods(map[string][]scheduler.Workload{pcieAddr: workloads[pcieAddr]})
+	candidates := make([]*scheduler.PodResourceInfo, 0, len(pods)+1)
+	for i := range pods {
+		candidates = append(candidates, &pods[i])
 	}
+	candidates = append(candidates, nil)
 
-	for i := range netDevices {
-		if netDevices[i].RoceDevName == rdmaDevName {
-			return rc.na.populateLabelsForNetDevice(netDevices[i], podInfoPtr), nil
+	for _, podInfoPtr := range candidates {
+		netDevices, err := rc.na.getNetDevicesList(podInfoPtr)
+		if err != nil {
+			logger.Log.Printf("failed to get netdevs for rdmaDev %s: %v", rdmaDevName, err)
+			continue
+		}
+		for i := range netDevices {
+			if netDevices[i].RoceDevName == rdmaDevName {
+				labels := rc.na.populateLabelsForNetDevice(netDevices[i], podInfoPtr)
+				if podInfoPtr == nil {
+					rc.na.addRegisteredWorkloadLabels(labels, pcieAddr, workloads)
+				}
+				return labels, nil
+			}
 		}
 	}
 
-	err = fmt.Errorf("failed to get labelmap for rdmaDev %s pci %s pod %s",
-		rdmaDevName, pcieAddr, podInfo.Pod)
+	err := fmt.Errorf("failed to get labelmap for rdmaDev %s pci %s", rdmaDevName, pcieAddr)
 	return map[string]string{}, err
 }
 
-func (rc *RDMAStatsClient) UpdateNICStats(workloads map[string]scheduler.Workload) error {
+func (rc *RDMAStatsClient) UpdateNICStats(workloads map[string][]scheduler.Workload) error {
 	if !fetchRdmaMetrics {
 		return nil
 	}
@@ -0,0 +1,208 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package k8sclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/config"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// the resource is re-evaluated on every resync to pick up node label changes
+const configCRDResync = time.Minute
+
+var configCRDResource = schema.GroupVersionResource{
+	Group:    config.ConfigCRDGroup,
+	Version:  config.ConfigCRDVersion,
+	Resource: config.ConfigCRDResource,
+}
+
+// configCRDWatcher applies the config built from the MetricsExporterConfig
+// resource for the node and reports it in the resource status
+type configCRDWatcher struct {
+	sync.Mutex
+	k          *K8sClient
+	name       string
+	onChange   func(cfg *exportermetrics.MetricConfig)
+	applied    *exportermetrics.MetricConfig
+	lastStatus *config.NodeConfigStatus
+}
+
+// WatchConfigCRD watches the cluster scoped MetricsExporterConfig resource
+// with the name, onChange is called with the config built for the node when
+// it changes and with nil when the resource is removed or invalid so the
+// config file is used as fallback
+func (k *K8sClient) WatchConfigCRD(name string, onChange func(cfg *exportermetrics.MetricConfig)) error {
+	if k.dynamicClient == nil {
+		return fmt.Errorf("dynamic client not initialized")
+	}
+	w := &configCRDWatcher{
+		k:        k,
+		name:     name,
+		onChange: onChange,
+	}
+	res := k.dynamicClient.Resource(configCRDResource)
+	selector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			opts.FieldSelector = selector
+			return res.List(k.ctx, opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.FieldSelector = selector
+			return res.Watch(k.ctx, opts)
+		},
+	}
+	informer := cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, configCRDResync, cache.Indexers{})
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if u, ok := obj.(*unstructured.Unstructured); ok {
+				w.apply(u)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if u, ok := newObj.(*unstructured.Unstructured); ok {
+				w.apply(u)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			logger.Log.Printf("config resource %v deleted, using the config file", name)
+			w.set(nil)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	logger.Log.Printf("watching config resource %v", name)
+	go informer.Run(k.stopCh)
+	return nil
+}
+
+// nodeLabels returns the labels of the node from the cache, or from the
+// API server if the cache is not synced yet
+func (w *configCRDWatcher) nodeLabels() (map[string]string, error) {
+	if node, err := w.k.GetNode(); err == nil {
+		return node.Labels, nil
+	}
+	ctx, cancel := context.WithCancel(w.k.ctx)
+	defer cancel()
+	node, err := w.k.clientset.CoreV1().Nodes().Get(ctx, w.k.nodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return node.Labels, nil
+}
+
+func (w *configCRDWatcher) apply(u *unstructured.Unstructured) {
+	status := &config.NodeConfigStatus{
+		ObservedGeneration: u.GetGeneration(),
+		Source:             "file",
+	}
+	cfg, applied, err := w.build(u)
+	if err != nil {
+		logger.Log.Printf("config resource %v not applied, using the config file: %v", w.name, err)
+		status.Error = err.Error()
+		w.set(nil)
+	} else {
+		status.Source = "crd"
+		status.AppliedOverrides = applied
+		w.set(cfg)
+	}
+	w.updateStatus(status)
+}
+
+func (w *configCRDWatcher) build(u *unstructured.Unstructured) (*exportermetrics.MetricConfig, []string, error) {
+	specObj, _, err := unstructured.NestedMap(u.Object, "spec")
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := json.Marshal(specObj)
+	if err != nil {
+		return nil, nil, err
+	}
+	spec := &config.ConfigSpec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, nil, fmt.Errorf("invalid spec: %v", err)
+	}
+	nodeLabels, err := w.nodeLabels()
+	if err != nil {
+		return nil, nil, fmt.Errorf("node labels: %v", err)
+	}
+	return config.BuildNodeConfig(spec, nodeLabels)
+}
+
+// set calls onChange if the node config changed
+func (w *configCRDWatcher) set(cfg *exportermetrics.MetricConfig) {
+	w.Lock()
+	if (cfg == nil && w.applied == nil) || (cfg != nil && w.applied != nil && proto.Equal(cfg, w.applied)) {
+		w.Unlock()
+		return
+	}
+	w.applied = cfg
+	w.Unlock()
+	w.onChange(cfg)
+}
+
+// updateStatus reports the node config in the resource status, only the
+// node entry is patched and the status is not patched if unchanged
+func (w *configCRDWatcher) updateStatus(status *config.NodeConfigStatus) {
+	w.Lock()
+	defer w.Unlock()
+	if reflect.DeepEqual(w.lastStatus, status) {
+		return
+	}
+	reported := *status
+	reported.LastUpdateTime = time.Now().UTC().Format(time.RFC3339)
+	patch := map[string]interface{}{
+		"status": map[string]interface{}{
+			"nodes": map[string]interface{}{
+				w.k.nodeName: reported,
+			},
+		},
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		logger.Log.Printf("failed to marshal patch %v: %v", patch, err)
+		return
+	}
+	ctx, cancel := context.WithCancel(w.k.ctx)
+	defer cancel()
+	_, err = w.k.dynamicClient.Resource(configCRDResource).Patch(ctx, w.name, types.MergePatchType,
+		patchBytes, metav1.PatchOptions{}, "status")
+	if err != nil {
+		logger.Log.Printf("config resource %v status update failed: %v", w.name, err)
+		return
+	}
+	w.lastStatus = status
+}
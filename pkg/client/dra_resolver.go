@@ -0,0 +1,135 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	resourcev1beta1 "k8s.io/api/resource/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+)
+
+const (
+	// draCacheTTL is how long the resolved DRA devices of the node are reused
+	draCacheTTL = 1 * time.Minute
+	// draMissRefreshInterval limits the refreshes triggered by unknown devices
+	draMissRefreshInterval = 10 * time.Second
+)
+
+// draPCIeAttributes are the device attribute names (without the driver
+// domain) that carry the pcie address of a DRA device
+var draPCIeAttributes = map[string]bool{
+	"pciaddr":    true,
+	"pciaddress": true,
+	"pcibusid":   true,
+	"pciebusid":  true,
+}
+
+// draDeviceCache caches the device ids of the DRA devices published in
+// the resource slices of the node
+type draDeviceCache struct {
+	sync.Mutex
+	devices     map[string][]string
+	lastRefresh time.Time
+}
+
+func draDeviceKey(driver, pool, device string) string {
+	return fmt.Sprintf("%s/%s/%s", driver, pool, device)
+}
+
+// resourceSliceDeviceIDs returns the device ids (pcie address or partition
+// name) of the AMD devices in the resource slice keyed by driver/pool/device
+func resourceSliceDeviceIDs(slice *resourcev1beta1.ResourceSlice) map[string][]string {
+	result := map[string][]string{}
+	if slice == nil || !scheduler.IsAMDDRADriver(slice.Spec.Driver) {
+		return result
+	}
+	for _, dev := range slice.Spec.Devices {
+		ids := []string{}
+		if dev.Basic != nil {
+			for name, attr := range dev.Basic.Attributes {
+				if attr.StringValue == nil {
+					continue
+				}
+				// attributes may be qualified with the driver domain
+				attrName := string(name)
+				if i := strings.LastIndex(attrName, "/"); i >= 0 {
+					attrName = attrName[i+1:]
+				}
+				if draPCIeAttributes[strings.ToLower(attrName)] {
+					ids = append(ids, strings.ToLower(*attr.StringValue))
+					continue
+				}
+				ids = append(ids, scheduler.ParseDRADeviceIDs(*attr.StringValue)...)
+			}
+		}
+		if len(ids) == 0 {
+			ids = scheduler.ParseDRADeviceIDs(dev.Name)
+		}
+		if len(ids) != 0 {
+			result[draDeviceKey(slice.Spec.Driver, slice.Spec.Pool.Name, dev.Name)] = ids
+		}
+	}
+	return result
+}
+
+// refreshDRADevices reloads the AMD DRA devices published for the node
+func (k *K8sClient) refreshDRADevices() error {
+	ctx, cancel := context.WithTimeout(k.ctx, 10*time.Second)
+	defer cancel()
+	slices, err := k.clientset.ResourceV1beta1().ResourceSlices().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(resourcev1beta1.ResourceSliceSelectorNodeName, k.nodeName).String(),
+	})
+	if err != nil {
+		return err
+	}
+	devices := map[string][]string{}
+	for i := range slices.Items {
+		for key, ids := range resourceSliceDeviceIDs(&slices.Items[i]) {
+			devices[key] = ids
+		}
+	}
+	k.draCache.devices = devices
+	return nil
+}
+
+// ResolveDRADevice returns the pcie address or partition name of a DRA
+// allocated device from the resource slices of the node
+func (k *K8sClient) ResolveDRADevice(driver, pool, device string) []string {
+	k.draCache.Lock()
+	defer k.draCache.Unlock()
+	key := draDeviceKey(driver, pool, device)
+	ids, ok := k.draCache.devices[key]
+	age := time.Since(k.draCache.lastRefresh)
+	if (ok && age < draCacheTTL) || (!ok && age < draMissRefreshInterval) {
+		return ids
+	}
+	k.draCache.lastRefresh = time.Now()
+	if err := k.refreshDRADevices(); err != nil {
+		logger.Log.Printf("failed to list resource slices of node %v: %v", k.nodeName, err)
+		return ids
+	}
+	return k.draCache.devices[key]
+}
@@ -0,0 +1,65 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package k8sclient
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	resourcev1beta1 "k8s.io/api/resource/v1beta1"
+)
+
+func TestResourceSliceDeviceIDs(t *testing.T) {
+	pciAddr := "0000:1B:00.0"
+	partition := "amdgpu_xcp_2"
+	model := "MI300X"
+	slice := &resourcev1beta1.ResourceSlice{
+		Spec: resourcev1beta1.ResourceSliceSpec{
+			Driver: "gpu.amd.com",
+			Pool:   resourcev1beta1.ResourcePool{Name: "node1"},
+			Devices: []resourcev1beta1.Device{
+				{
+					Name: "gpu-1",
+					Basic: &resourcev1beta1.BasicDevice{
+						Attributes: map[resourcev1beta1.QualifiedName]resourcev1beta1.DeviceAttribute{
+							"gpu.amd.com/pciAddr": {StringValue: &pciAddr},
+							"productName":         {StringValue: &model},
+						},
+					},
+				},
+				{
+					Name: "gpu-1-partition-2",
+					Basic: &resourcev1beta1.BasicDevice{
+						Attributes: map[resourcev1beta1.QualifiedName]resourcev1beta1.DeviceAttribute{
+							"partitionName": {StringValue: &partition},
+						},
+					},
+				},
+				{Name: "0000:2c:00.0"},
+				{Name: "gpu-3"},
+			},
+		},
+	}
+	ids := resourceSliceDeviceIDs(slice)
+	assert.Equal(t, len(ids), 3)
+	assert.DeepEqual(t, ids["gpu.amd.com/node1/gpu-1"], []string{"0000:1b:00.0"})
+	assert.DeepEqual(t, ids["gpu.amd.com/node1/gpu-1-partition-2"], []string{"amdgpu_xcp_2"})
+	assert.DeepEqual(t, ids["gpu.amd.com/node1/0000:2c:00.0"], []string{"0000:2c:00.0"})
+
+	slice.Spec.Driver = "gpu.example.com"
+	assert.Equal(t, len(resourceSliceDeviceIDs(slice)), 0)
+}
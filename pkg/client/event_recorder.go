@@ -0,0 +1,167 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package k8sclient
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const (
+	// identical events are posted once within the window
+	defaultEventDedupWindow = 10 * time.Minute
+	// max events posted per rate interval
+	defaultEventBurst        = 20
+	defaultEventRateInterval = time.Minute
+)
+
+// EventSink creates the events and resolves the pods they are attached to
+type EventSink interface {
+	CreateEvent(evtObj *v1.Event) error
+	GetPod(namespace, name string) (*v1.Pod, error)
+}
+
+// EventRecorder posts events on the node and its pods, identical events are
+// deduplicated and the number of events posted is rate limited
+type EventRecorder struct {
+	sync.Mutex
+	sink         EventSink
+	nodeName     string
+	dedupWindow  time.Duration
+	burst        int
+	rateInterval time.Duration
+	// event key -> last posted time
+	recent map[string]time.Time
+	// posted times within the current rate interval
+	posted []time.Time
+	now    func() time.Time
+}
+
+// EventRecorderOption set desired option
+type EventRecorderOption func(r *EventRecorder)
+
+// WithEventDedupWindow sets the window identical events are deduplicated in
+func WithEventDedupWindow(window time.Duration) EventRecorderOption {
+	return func(r *EventRecorder) {
+		r.dedupWindow = window
+	}
+}
+
+// WithEventRateLimit sets the max number of events posted per interval
+func WithEventRateLimit(burst int, interval time.Duration) EventRecorderOption {
+	return func(r *EventRecorder) {
+		r.burst = burst
+		r.rateInterval = interval
+	}
+}
+
+// NewEventRecorder returns an event recorder for the node
+func NewEventRecorder(sink EventSink, nodeName string, opts ...EventRecorderOption) *EventRecorder {
+	r := &EventRecorder{
+		sink:         sink,
+		nodeName:     nodeName,
+		dedupWindow:  defaultEventDedupWindow,
+		burst:        defaultEventBurst,
+		rateInterval: defaultEventRateInterval,
+		recent:       make(map[string]time.Time),
+		now:          time.Now,
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// NodeEvent posts an event on the node
+func (r *EventRecorder) NodeEvent(evtType, reason, message string) error {
+	return r.record(NewNodeEvent(r.nodeName, evtType, reason, message))
+}
+
+// PodEvent posts an event on the pod, the pod must be running on the node
+func (r *EventRecorder) PodEvent(namespace, name, evtType, reason, message string) error {
+	pod, err := r.sink.GetPod(namespace, name)
+	if err != nil {
+		return err
+	}
+	currTime := metav1.NewTime(time.Now().UTC())
+	return r.record(&v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "amd-gpu-",
+			Namespace:    namespace,
+		},
+		FirstTimestamp: currTime,
+		LastTimestamp:  currTime,
+		Count:          1,
+		Type:           evtType,
+		Reason:         reason,
+		Message:        message,
+		InvolvedObject: v1.ObjectReference{
+			Kind:       "Pod",
+			APIVersion: "v1",
+			Namespace:  namespace,
+			Name:       name,
+			UID:        pod.UID,
+		},
+		Source: v1.EventSource{
+			Host:      r.nodeName,
+			Component: globals.ExporterEventSourceComponentName,
+		},
+	})
+}
+
+// record posts the event unless an identical one was posted within the
+// dedup window or the rate limit is reached
+func (r *EventRecorder) record(evt *v1.Event) error {
+	r.Lock()
+	now := r.now()
+	for k, t := range r.recent {
+		if now.Sub(t) >= r.dedupWindow {
+			delete(r.recent, k)
+		}
+	}
+	key := fmt.Sprintf("%s/%s/%s/%s/%s/%s", evt.InvolvedObject.Kind, evt.InvolvedObject.Namespace,
+		evt.InvolvedObject.Name, evt.Type, evt.Reason, evt.Message)
+	if _, ok := r.recent[key]; ok {
+		r.Unlock()
+		return nil
+	}
+	posted := r.posted[:0]
+	for _, t := range r.posted {
+		if now.Sub(t) < r.rateInterval {
+			posted = append(posted, t)
+		}
+	}
+	r.posted = posted
+	if len(r.posted) >= r.burst {
+		r.Unlock()
+		logger.Log.Printf("event rate limit reached, dropping event %v: %v", evt.Reason, evt.Message)
+		return errors.New("event rate limit reached")
+	}
+	r.recent[key] = now
+	r.posted = append(r.posted, now)
+	r.Unlock()
+
+	return r.sink.CreateEvent(evt)
+}
@@ -0,0 +1,86 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package k8sclient
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+type fakeEventSink struct {
+	events []*v1.Event
+	pods   map[string]*v1.Pod
+}
+
+func (f *fakeEventSink) CreateEvent(evtObj *v1.Event) error {
+	f.events = append(f.events, evtObj)
+	return nil
+}
+
+func (f *fakeEventSink) GetPod(namespace, name string) (*v1.Pod, error) {
+	if pod, ok := f.pods[namespace+"/"+name]; ok {
+		return pod, nil
+	}
+	return nil, fmt.Errorf("pod %v/%v not found", namespace, name)
+}
+
+func TestEventRecorder(t *testing.T) {
+	logger.Init(true)
+	sink := &fakeEventSink{pods: map[string]*v1.Pod{}}
+	sink.pods["ns0/pod0"] = &v1.Pod{}
+	sink.pods["ns0/pod0"].UID = types.UID("uid0")
+
+	now := time.Now()
+	r := NewEventRecorder(sink, "node1", WithEventDedupWindow(time.Minute),
+		WithEventRateLimit(3, time.Minute))
+	r.now = func() time.Time { return now }
+
+	assert.NilError(t, r.NodeEvent(v1.EventTypeWarning, "AMDGPUUnhealthy", "GPU 0 is unhealthy"))
+	assert.Equal(t, len(sink.events), 1)
+	assert.Equal(t, sink.events[0].InvolvedObject.Kind, "Node")
+	assert.Equal(t, sink.events[0].InvolvedObject.Name, "node1")
+
+	// duplicate within the window
+	assert.NilError(t, r.NodeEvent(v1.EventTypeWarning, "AMDGPUUnhealthy", "GPU 0 is unhealthy"))
+	assert.Equal(t, len(sink.events), 1)
+
+	// same event on a pod is not a duplicate
+	assert.NilError(t, r.PodEvent("ns0", "pod0", v1.EventTypeWarning, "AMDGPUUnhealthy", "GPU 0 is unhealthy"))
+	assert.Equal(t, len(sink.events), 2)
+	assert.Equal(t, sink.events[1].Namespace, "ns0")
+	assert.Equal(t, sink.events[1].InvolvedObject.UID, types.UID("uid0"))
+
+	// unknown pod
+	assert.Assert(t, r.PodEvent("ns0", "pod1", v1.EventTypeWarning, "AMDGPUUnhealthy", "GPU 0 is unhealthy") != nil)
+
+	// rate limited
+	assert.NilError(t, r.NodeEvent(v1.EventTypeWarning, "AMDGPUUnhealthy", "GPU 1 is unhealthy"))
+	assert.Assert(t, r.NodeEvent(v1.EventTypeWarning, "AMDGPUUnhealthy", "GPU 2 is unhealthy") != nil)
+	assert.Equal(t, len(sink.events), 3)
+
+	// window expired
+	now = now.Add(time.Minute)
+	assert.NilError(t, r.NodeEvent(v1.EventTypeWarning, "AMDGPUUnhealthy", "GPU 0 is unhealthy"))
+	assert.Equal(t, len(sink.events), 4)
+}
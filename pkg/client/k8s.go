@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -54,12 +55,14 @@ type K8sClient struct {
 	sync.Mutex
 	ctx             context.Context
 	clientset       kubernetes.Interface
+	dynamicClient   dynamic.Interface
 	nodeName        string
 	stopCh          chan struct{}
 	started         bool
 	nodeInformer    cache.SharedIndexInformer
 	podInformer     cache.SharedIndexInformer
 	nodelabellerCfg utils.NodeHealthLabellerConfig
+	draCache        draDeviceCache
 }
 
 func NewClient(ctx context.Context, configPath, nodeName string) (*K8sClient, error) {
@@ -83,13 +86,19 @@ func NewClient(ctx context.Context, configPath, nodeName string) (*K8sClient, er
 		logger.Log.Printf("clientset from config failed %v", err)
 		return nil, err
 	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		logger.Log.Printf("dynamic client from config failed %v", err)
+		return nil, err
+	}
 
 	k8c := &K8sClient{
-		ctx:       ctx,
-		clientset: clientset,
-		nodeName:  nodeName,
-		stopCh:    make(chan struct{}),
-		started:   false,
+		ctx:           ctx,
+		clientset:     clientset,
+		dynamicClient: dynamicClient,
+		nodeName:      nodeName,
+		stopCh:        make(chan struct{}),
+		started:       false,
 	}
 	return k8c, nil
 }
@@ -173,6 +182,13 @@ func (k *K8sClient) RemoveNodeLabel(nodeName string, keys []string) error {
 }
 
 func (k *K8sClient) UpdateHealthLabel(nodelabellerCfg *utils.NodeHealthLabellerConfig, nodeName string, newHealthMap map[string]string) error {
+	return k.UpdateHealthLabelWithReasons(nodelabellerCfg, nodeName, newHealthMap, nil)
+}
+
+// UpdateHealthLabelWithReasons updates the node health labels and the
+// health reason annotations of the devices
+func (k *K8sClient) UpdateHealthLabelWithReasons(nodelabellerCfg *utils.NodeHealthLabellerConfig, nodeName string,
+	newHealthMap map[string]string, newReasonMap map[string]string) error {
 	if nodelabellerCfg == nil {
 		return fmt.Errorf("nodelabeller config cannot be nil")
 	}
@@ -190,13 +206,25 @@ func (k *K8sClient) UpdateHealthLabel(nodelabellerCfg *utils.NodeHealthLabellerC
 	}
 
 	oldHealthMap := nodelabellerCfg.ParseNodeHealthLabel(node.Labels)
+	oldReasonMap := nodelabellerCfg.ParseNodeHealthAnnotation(node.Annotations)
+	if newReasonMap == nil {
+		newReasonMap = map[string]string{}
+	}
 
 	// check diff
-	if reflect.DeepEqual(oldHealthMap, newHealthMap) {
+	if reflect.DeepEqual(oldHealthMap, newHealthMap) && reflect.DeepEqual(oldReasonMap, newReasonMap) {
 		return nil
 	}
+	if node.Labels == nil {
+		node.Labels = map[string]string{}
+	}
 	nodelabellerCfg.RemoveNodeHealthLabel(node.Labels)
 	nodelabellerCfg.AddNodeHealthLabel(node.Labels, newHealthMap)
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	nodelabellerCfg.RemoveNodeHealthAnnotation(node.Annotations)
+	nodelabellerCfg.AddNodeHealthAnnotation(node.Annotations, newReasonMap)
 
 	// Update the node
 	_, err = k.clientset.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
@@ -362,6 +390,36 @@ func (k *K8sClient) GetAllPods() (map[string]map[string]string, error) {
 	return k8PodLabelsMap, nil
 }
 
+// GetAllPodAnnotations returns the annotations of the pods on the node
+// keyed by the pod unique key
+func (k *K8sClient) GetAllPodAnnotations() (map[string]map[string]string, error) {
+	k8PodAnnotationsMap := make(map[string]map[string]string)
+
+	pods, err := k.ListPods()
+	if err != nil {
+		log.Printf("Error fetching pods for node %v: %v", k.nodeName, err)
+		return nil, err
+	}
+
+	for _, pod := range pods {
+		podKey := exporterTypes.PodUniqueKey{
+			PodName:   pod.Name,
+			Namespace: pod.Namespace,
+		}
+		k8PodAnnotationsMap[podKey.String()] = pod.Annotations
+	}
+	return k8PodAnnotationsMap, nil
+}
+
+// GetNodeLabels returns the labels of the node from the cache
+func (k *K8sClient) GetNodeLabels() (map[string]string, error) {
+	node, err := k.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	return node.Labels, nil
+}
+
 func (k *K8sClient) GetNode() (*v1.Node, error) {
 	if k.nodeInformer == nil || !k.nodeInformer.HasSynced() {
 		return nil, errors.New("cache not synced or API server unavailable")
@@ -378,6 +436,24 @@ func (k *K8sClient) GetNode() (*v1.Node, error) {
 	return nil, errors.New("failed to cast object to *v1.Node")
 }
 
+// GetPod returns the pod running on the node from the cache
+func (k *K8sClient) GetPod(namespace, name string) (*v1.Pod, error) {
+	if k.podInformer == nil || !k.podInformer.HasSynced() {
+		return nil, errors.New("cache not synced or API server unavailable")
+	}
+	obj, exists, err := k.podInformer.GetStore().GetByKey(namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("pod %v/%v not found", namespace, name)
+	}
+	if pod, ok := obj.(*v1.Pod); ok {
+		return pod.DeepCopy(), nil
+	}
+	return nil, errors.New("failed to cast object to *v1.Pod")
+}
+
 func (k *K8sClient) ListPods() ([]*v1.Pod, error) {
 	if k.podInformer == nil || !k.podInformer.HasSynced() {
 		return nil, errors.New("cache not synced or API server unavailable")
@@ -0,0 +1,141 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package k8sclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const (
+	// GPUHealthConditionType is the default node condition type for GPU health
+	GPUHealthConditionType = "AMDGPUHealthy"
+	// NICHealthConditionType is the default node condition type for NIC health
+	NICHealthConditionType = "AMDNICHealthy"
+)
+
+// NewHealthCondition builds the node condition of a device kind (GPU, NIC)
+// from the non healthy devices and their reasons, the condition is True
+// when all the devices are healthy
+func NewHealthCondition(condType, kind string, healthMap, reasonMap map[string]string) v1.NodeCondition {
+	cond := v1.NodeCondition{
+		Type: v1.NodeConditionType(condType),
+	}
+	if len(healthMap) == 0 {
+		cond.Status = v1.ConditionTrue
+		cond.Reason = fmt.Sprintf("%sHealthy", kind)
+		cond.Message = fmt.Sprintf("all %ss are healthy", kind)
+		return cond
+	}
+
+	ids := make([]string, 0, len(healthMap))
+	levels := map[string]bool{}
+	for id, health := range healthMap {
+		ids = append(ids, id)
+		levels[health] = true
+	}
+	sort.Strings(ids)
+
+	devices := make([]string, 0, len(ids))
+	for _, id := range ids {
+		device := fmt.Sprintf("%s %s %s", kind, id, healthMap[id])
+		if reason := reasonMap[id]; reason != "" {
+			device = fmt.Sprintf("%s (%s)", device, reason)
+		}
+		devices = append(devices, device)
+	}
+
+	cond.Status = v1.ConditionFalse
+	// report the most severe level in the reason, anything other than
+	// degraded is unhealthy
+	if len(levels) == 1 && levels["degraded"] {
+		cond.Reason = fmt.Sprintf("%sDegraded", kind)
+	} else {
+		cond.Reason = fmt.Sprintf("%sUnhealthy", kind)
+	}
+	cond.Message = strings.Join(devices, "; ")
+	return cond
+}
+
+// mergeHealthCondition fills the condition timestamps from the current node
+// conditions, returns false if the condition is unchanged
+func mergeHealthCondition(conditions []v1.NodeCondition, cond v1.NodeCondition, now metav1.Time) (v1.NodeCondition, bool) {
+	cond.LastHeartbeatTime = now
+	cond.LastTransitionTime = now
+	for _, c := range conditions {
+		if c.Type != cond.Type {
+			continue
+		}
+		if c.Status == cond.Status {
+			cond.LastTransitionTime = c.LastTransitionTime
+		}
+		if c.Status == cond.Status && c.Reason == cond.Reason && c.Message == cond.Message {
+			return cond, false
+		}
+		return cond, true
+	}
+	return cond, true
+}
+
+// UpdateHealthCondition sets the device health condition on the node through
+// a patch of the status subresource, the node is not updated if the
+// condition is unchanged
+func (k *K8sClient) UpdateHealthCondition(nodeName string, cond v1.NodeCondition) error {
+	k.Lock()
+	defer k.Unlock()
+
+	ctx, cancel := context.WithCancel(k.ctx)
+	defer cancel()
+
+	node, err := k.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		logger.Log.Printf("k8s internal node get failed %v", err)
+		return err
+	}
+
+	cond, changed := mergeHealthCondition(node.Status.Conditions, cond, metav1.NewTime(time.Now()))
+	if !changed {
+		return nil
+	}
+
+	// conditions are merged on the type key
+	patch := map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []v1.NodeCondition{cond},
+		},
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch %v: %v", patch, err)
+	}
+	_, err = k.clientset.CoreV1().Nodes().PatchStatus(ctx, nodeName, patchBytes)
+	if err != nil {
+		logger.Log.Printf("failed to set condition %v on node %v err %v", cond.Type, nodeName, err)
+		return err
+	}
+	logger.Log.Printf("node %v condition %v set to %v: %v", nodeName, cond.Type, cond.Status, cond.Message)
+	return nil
+}
@@ -0,0 +1,82 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package k8sclient
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewHealthCondition(t *testing.T) {
+	cond := NewHealthCondition(GPUHealthConditionType, "GPU", map[string]string{}, nil)
+	assert.Equal(t, cond.Type, v1.NodeConditionType("AMDGPUHealthy"))
+	assert.Equal(t, cond.Status, v1.ConditionTrue)
+	assert.Equal(t, cond.Reason, "GPUHealthy")
+
+	cond = NewHealthCondition(GPUHealthConditionType, "GPU",
+		map[string]string{"3": "degraded", "1": "unhealthy"},
+		map[string]string{"1": "ecc errors"})
+	assert.Equal(t, cond.Status, v1.ConditionFalse)
+	assert.Equal(t, cond.Reason, "GPUUnhealthy")
+	assert.Equal(t, cond.Message, "GPU 1 unhealthy (ecc errors); GPU 3 degraded")
+
+	cond = NewHealthCondition(NICHealthConditionType, "NIC",
+		map[string]string{"0000:05:00.0": "degraded"}, nil)
+	assert.Equal(t, cond.Type, v1.NodeConditionType("AMDNICHealthy"))
+	assert.Equal(t, cond.Reason, "NICDegraded")
+	assert.Equal(t, cond.Message, "NIC 0000:05:00.0 degraded")
+}
+
+func TestMergeHealthCondition(t *testing.T) {
+	t0 := metav1.NewTime(time.Now().Add(-time.Hour))
+	now := metav1.NewTime(time.Now())
+
+	healthy := NewHealthCondition(GPUHealthConditionType, "GPU", nil, nil)
+	unhealthy := NewHealthCondition(GPUHealthConditionType, "GPU",
+		map[string]string{"1": "unhealthy"}, nil)
+
+	// new condition
+	cond, changed := mergeHealthCondition([]v1.NodeCondition{{Type: v1.NodeReady}}, healthy, now)
+	assert.Assert(t, changed)
+	assert.Equal(t, cond.LastTransitionTime, now)
+
+	existing := []v1.NodeCondition{{Type: v1.NodeReady}, healthy}
+	existing[1].LastTransitionTime = t0
+
+	// unchanged
+	_, changed = mergeHealthCondition(existing, healthy, now)
+	assert.Assert(t, !changed)
+
+	// status transition
+	cond, changed = mergeHealthCondition(existing, unhealthy, now)
+	assert.Assert(t, changed)
+	assert.Equal(t, cond.LastTransitionTime, now)
+
+	// message change keeps the transition time
+	existing[1] = unhealthy
+	existing[1].LastTransitionTime = t0
+	more := NewHealthCondition(GPUHealthConditionType, "GPU",
+		map[string]string{"1": "unhealthy", "2": "unhealthy"}, nil)
+	cond, changed = mergeHealthCondition(existing, more, now)
+	assert.Assert(t, changed)
+	assert.Equal(t, cond.LastTransitionTime, t0)
+	assert.Equal(t, cond.LastHeartbeatTime, now)
+}
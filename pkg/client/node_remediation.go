@@ -0,0 +1,214 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package k8sclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// NewNodeEvent builds an event on the node generated by the exporter
+func NewNodeEvent(nodeName, evtType, reason, message string) *v1.Event {
+	currTime := metav1.NewTime(time.Now().UTC())
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "amd-gpu-",
+			Namespace:    metav1.NamespaceDefault,
+		},
+		FirstTimestamp: currTime,
+		LastTimestamp:  currTime,
+		Count:          1,
+		Type:           evtType,
+		Reason:         reason,
+		Message:        message,
+		InvolvedObject: v1.ObjectReference{
+			Kind: "Node",
+			Name: nodeName,
+			// same convention as the kubelet, node events use the node name as uid
+			UID: types.UID(nodeName),
+		},
+		Source: v1.EventSource{
+			Host:      nodeName,
+			Component: globals.ExporterEventSourceComponentName,
+		},
+	}
+}
+
+// jsonPatchOp is an RFC 6902 JSON patch operation
+type jsonPatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// patchNode applies the JSON patch operations to the node
+func (k *K8sClient) patchNode(ctx context.Context, nodeName string, ops []jsonPatchOp) error {
+	patchBytes, err := json.Marshal(ops)
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch %v: %v", ops, err)
+	}
+	_, err = k.clientset.CoreV1().Nodes().Patch(ctx, nodeName, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// annotationPath returns the JSON pointer of the annotation
+func annotationPath(annotation string) string {
+	return "/metadata/annotations/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(annotation)
+}
+
+// addAnnotationOp returns the operation setting the annotation on the node
+func addAnnotationOp(node *v1.Node, annotation, value string) jsonPatchOp {
+	if node.Annotations == nil {
+		return jsonPatchOp{Op: "add", Path: "/metadata/annotations", Value: map[string]string{annotation: value}}
+	}
+	return jsonPatchOp{Op: "add", Path: annotationPath(annotation), Value: value}
+}
+
+// AddNodeTaint adds the taint to the node if not present along with the
+// owner annotation, returns true if the node was updated. A taint already
+// present is left to its owner, a taint with the same key and effect but
+// another value is reported as an error
+func (k *K8sClient) AddNodeTaint(nodeName string, taint v1.Taint, ownerAnnotation string) (bool, error) {
+	k.Lock()
+	defer k.Unlock()
+	ctx, cancel := context.WithCancel(k.ctx)
+	defer cancel()
+
+	node, err := k.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		logger.Log.Printf("k8s internal node get failed %v", err)
+		return false, err
+	}
+	for _, t := range node.Spec.Taints {
+		if !t.MatchTaint(&taint) {
+			continue
+		}
+		if t.Value == taint.Value {
+			return false, nil
+		}
+		return false, fmt.Errorf("node %v already has taint %v=%v:%v", nodeName, t.Key, t.Value, t.Effect)
+	}
+	if taint.TimeAdded == nil && taint.Effect == v1.TaintEffectNoExecute {
+		now := metav1.Now()
+		taint.TimeAdded = &now
+	}
+	// the taint is appended so concurrent changes of the other taints are
+	// kept, the list is only created if the node had no taint when read
+	ops := []jsonPatchOp{{Op: "add", Path: "/spec/taints/-", Value: taint}}
+	if len(node.Spec.Taints) == 0 {
+		ops = []jsonPatchOp{
+			{Op: "test", Path: "/metadata/resourceVersion", Value: node.ResourceVersion},
+			{Op: "add", Path: "/spec/taints", Value: []v1.Taint{taint}},
+		}
+	}
+	ops = append(ops, addAnnotationOp(node, ownerAnnotation, time.Now().UTC().Format(time.RFC3339)))
+	if err = k.patchNode(ctx, nodeName, ops); err != nil {
+		logger.Log.Printf("failed to add taint %v to node %v err %v", taint.Key, nodeName, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// RemoveNodeTaint removes the taints matching the key, value and effect of
+// the taint and the owner annotation from the node, a node without the
+// owner annotation is not modified. Returns true if the node was updated
+func (k *K8sClient) RemoveNodeTaint(nodeName string, taint v1.Taint, ownerAnnotation string) (bool, error) {
+	k.Lock()
+	defer k.Unlock()
+	ctx, cancel := context.WithCancel(k.ctx)
+	defer cancel()
+
+	node, err := k.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		logger.Log.Printf("k8s internal node get failed %v", err)
+		return false, err
+	}
+	if _, ok := node.Annotations[ownerAnnotation]; !ok {
+		return false, nil
+	}
+	// remove from the last index so the earlier indexes stay valid, each
+	// removal is guarded by a test of the taint at the index
+	ops := []jsonPatchOp{}
+	for i := len(node.Spec.Taints) - 1; i >= 0; i-- {
+		t := node.Spec.Taints[i]
+		if !t.MatchTaint(&taint) || t.Value != taint.Value {
+			continue
+		}
+		path := fmt.Sprintf("/spec/taints/%d", i)
+		ops = append(ops,
+			jsonPatchOp{Op: "test", Path: path, Value: t},
+			jsonPatchOp{Op: "remove", Path: path})
+	}
+	ops = append(ops, jsonPatchOp{Op: "remove", Path: annotationPath(ownerAnnotation)})
+	if err = k.patchNode(ctx, nodeName, ops); err != nil {
+		logger.Log.Printf("failed to remove taint %v from node %v err %v", taint.Key, nodeName, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// SetNodeUnschedulable cordons or uncordons the node, returns true if the
+// node was updated. A node already unschedulable is not cordoned and the
+// owner annotation is only set when the exporter cordons the node, a node
+// without the owner annotation is never uncordoned
+func (k *K8sClient) SetNodeUnschedulable(nodeName string, unschedulable bool, ownerAnnotation string) (bool, error) {
+	k.Lock()
+	defer k.Unlock()
+	ctx, cancel := context.WithCancel(k.ctx)
+	defer cancel()
+
+	node, err := k.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		logger.Log.Printf("k8s internal node get failed %v", err)
+		return false, err
+	}
+	var ops []jsonPatchOp
+	if unschedulable {
+		if node.Spec.Unschedulable {
+			return false, nil
+		}
+		// fails if the node was cordoned since it was read
+		ops = []jsonPatchOp{
+			{Op: "test", Path: "/metadata/resourceVersion", Value: node.ResourceVersion},
+			{Op: "add", Path: "/spec/unschedulable", Value: true},
+			addAnnotationOp(node, ownerAnnotation, time.Now().UTC().Format(time.RFC3339)),
+		}
+	} else {
+		if _, ok := node.Annotations[ownerAnnotation]; !ok {
+			return false, nil
+		}
+		ops = []jsonPatchOp{
+			{Op: "add", Path: "/spec/unschedulable", Value: false},
+			{Op: "remove", Path: annotationPath(ownerAnnotation)},
+		}
+	}
+	if err = k.patchNode(ctx, nodeName, ops); err != nil {
+		logger.Log.Printf("failed to set unschedulable %v on node %v err %v", unschedulable, nodeName, err)
+		return false, err
+	}
+	return true, nil
+}
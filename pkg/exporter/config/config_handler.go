@@ -22,6 +22,8 @@ import (
 	"os"
 	"sync"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 )
@@ -40,6 +42,9 @@ type ConfigHandler struct {
 	configPath    string
 	// running config can change keep updating states
 	runningConfig *Config
+	// config from the MetricsExporterConfig resource, takes precedence
+	// over the config file when set
+	crdConfig *exportermetrics.MetricConfig
 }
 
 func NewConfigHandler(configPath string, port int) *ConfigHandler {
@@ -55,6 +60,9 @@ func NewConfigHandler(configPath string, port int) *ConfigHandler {
 func (c *ConfigHandler) RefreshConfig() error {
 	c.Lock()
 	defer c.Unlock()
+	if c.crdConfig != nil {
+		return c.runningConfig.Update(proto.Clone(c.crdConfig).(*exportermetrics.MetricConfig))
+	}
 	newConfig, err := readConfig(c.configPath)
 	if err != nil {
 		logger.Log.Printf("config read err: %v, reverting to defaults", err)
@@ -63,6 +71,24 @@ func (c *ConfigHandler) RefreshConfig() error {
 	return c.runningConfig.Update(newConfig)
 }
 
+// SetCRDConfig sets the config built from the MetricsExporterConfig resource,
+// nil falls back to the config file, applied on the next refresh
+func (c *ConfigHandler) SetCRDConfig(cfg *exportermetrics.MetricConfig) {
+	c.Lock()
+	defer c.Unlock()
+	c.crdConfig = cfg
+}
+
+// GetConfigSource returns the source of the config, crd or file
+func (c *ConfigHandler) GetConfigSource() string {
+	c.Lock()
+	defer c.Unlock()
+	if c.crdConfig != nil {
+		return "crd"
+	}
+	return "file"
+}
+
 // GetHealthServiceState returns the health service state
 // if not set, it returns true
 // if set, it returns the value
@@ -79,6 +105,17 @@ func (c *ConfigHandler) GetHealthServiceState() bool {
 	return true
 }
 
+// GetHealthServiceConfig returns the health service settings, nil if not set
+func (c *ConfigHandler) GetHealthServiceConfig() *exportermetrics.HealthServiceConfig {
+	c.Lock()
+	defer c.Unlock()
+	cfg := c.runningConfig.GetConfig()
+	if cfg != nil && cfg.GetCommonConfig() != nil {
+		return cfg.GetCommonConfig().GetHealthService()
+	}
+	return nil
+}
+
 func (c *ConfigHandler) GetMetricsConfigPath() string {
 	return c.configPath
 }
@@ -0,0 +1,123 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+const (
+	// ConfigCRDGroup is the API group of the MetricsExporterConfig resource
+	ConfigCRDGroup = "metrics.amd.com"
+	// ConfigCRDVersion is the API version of the MetricsExporterConfig resource
+	ConfigCRDVersion = "v1alpha1"
+	// ConfigCRDResource is the plural resource name of MetricsExporterConfig
+	ConfigCRDResource = "metricsexporterconfigs"
+)
+
+// ConfigOverride is a partial config applied on the nodes matching the selector
+type ConfigOverride struct {
+	// Name identifies the override in the status, defaults to its index
+	Name string `json:"name,omitempty"`
+	// NodeSelector selects the nodes, nil or empty matches all the nodes
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+	// Config is merged on top of the default config, objects are merged
+	// key by key while lists and values are replaced
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+// ConfigSpec is the spec of the MetricsExporterConfig resource
+type ConfigSpec struct {
+	// Config is the default config of all the nodes, same format as the
+	// config file
+	Config map[string]interface{} `json:"config,omitempty"`
+	// Overrides are applied in order on the matching nodes
+	Overrides []ConfigOverride `json:"overrides,omitempty"`
+}
+
+// NodeConfigStatus is the config applied on a node reported in the
+// MetricsExporterConfig status
+type NodeConfigStatus struct {
+	ObservedGeneration int64    `json:"observedGeneration"`
+	AppliedOverrides   []string `json:"appliedOverrides,omitempty"`
+	// Source of the running config, crd or file on error
+	Source         string `json:"source"`
+	Error          string `json:"error,omitempty"`
+	LastUpdateTime string `json:"lastUpdateTime"`
+}
+
+// BuildNodeConfig merges the default config with the overrides matching the
+// node labels, returns the config and the applied override names
+func BuildNodeConfig(spec *ConfigSpec, nodeLabels map[string]string) (*exportermetrics.MetricConfig, []string, error) {
+	merged := map[string]interface{}{}
+	mergeConfigJSON(merged, spec.Config)
+
+	applied := []string{}
+	for i, o := range spec.Overrides {
+		name := o.Name
+		if name == "" {
+			name = fmt.Sprintf("%d", i)
+		}
+		selector := labels.Everything()
+		if o.NodeSelector != nil {
+			var err error
+			selector, err = metav1.LabelSelectorAsSelector(o.NodeSelector)
+			if err != nil {
+				return nil, nil, fmt.Errorf("override %v invalid node selector: %v", name, err)
+			}
+		}
+		if !selector.Matches(labels.Set(nodeLabels)) {
+			continue
+		}
+		mergeConfigJSON(merged, o.Config)
+		applied = append(applied, name)
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := &exportermetrics.MetricConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, nil, fmt.Errorf("invalid config: %v", err)
+	}
+	return cfg, applied, nil
+}
+
+// mergeConfigJSON merges src into dst, nested objects are merged and any
+// other value replaces the dst one
+func mergeConfigJSON(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, ok := v.(map[string]interface{})
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		dstMap, ok := dst[k].(map[string]interface{})
+		if !ok {
+			dstMap = map[string]interface{}{}
+			dst[k] = dstMap
+		}
+		mergeConfigJSON(dstMap, srcMap)
+	}
+}
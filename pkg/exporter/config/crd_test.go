@@ -0,0 +1,115 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const testConfigSpec = `{
+	"config": {
+		"ServerPort": 5000,
+		"GPUConfig": {
+			"Fields": ["GPU_CLOCK", "GPU_POWER_USAGE"],
+			"HealthThresholds": {"GPU_ECC_UNCORRECT_UMC": 10}
+		}
+	},
+	"overrides": [
+		{
+			"name": "mi300",
+			"nodeSelector": {"matchLabels": {"gpu": "mi300"}},
+			"config": {
+				"GPUConfig": {
+					"Fields": ["GPU_TEMPERATURE"],
+					"HealthThresholds": {"GPU_ECC_UNCORRECT_SDMA": 1}
+				}
+			}
+		},
+		{
+			"nodeSelector": {"matchExpressions": [{"key": "zone", "operator": "In", "values": ["a"]}]},
+			"config": {"ServerPort": 5001}
+		}
+	]
+}`
+
+func TestBuildNodeConfig(t *testing.T) {
+	spec := &ConfigSpec{}
+	assert.NilError(t, json.Unmarshal([]byte(testConfigSpec), spec))
+
+	// no override matches
+	cfg, applied, err := BuildNodeConfig(spec, map[string]string{"gpu": "mi200"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(applied), 0)
+	assert.Equal(t, cfg.GetServerPort(), uint32(5000))
+	assert.DeepEqual(t, cfg.GetGPUConfig().GetFields(), []string{"GPU_CLOCK", "GPU_POWER_USAGE"})
+
+	// lists are replaced and objects merged
+	cfg, applied, err = BuildNodeConfig(spec, map[string]string{"gpu": "mi300", "zone": "a"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, applied, []string{"mi300", "1"})
+	assert.Equal(t, cfg.GetServerPort(), uint32(5001))
+	assert.DeepEqual(t, cfg.GetGPUConfig().GetFields(), []string{"GPU_TEMPERATURE"})
+	assert.Equal(t, cfg.GetGPUConfig().GetHealthThresholds().GetGPU_ECC_UNCORRECT_UMC(), uint32(10))
+	assert.Equal(t, cfg.GetGPUConfig().GetHealthThresholds().GetGPU_ECC_UNCORRECT_SDMA(), uint32(1))
+
+	// the spec is not modified by the merge
+	cfg, _, err = BuildNodeConfig(spec, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cfg.GetGPUConfig().GetFields(), []string{"GPU_CLOCK", "GPU_POWER_USAGE"})
+
+	// invalid selector
+	spec.Overrides[0].NodeSelector = &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "gpu", Operator: "Bad"}},
+	}
+	_, _, err = BuildNodeConfig(spec, nil)
+	assert.Assert(t, err != nil)
+
+	// invalid config
+	spec = &ConfigSpec{Config: map[string]interface{}{"ServerPort": "invalid"}}
+	_, _, err = BuildNodeConfig(spec, nil)
+	assert.Assert(t, err != nil)
+}
+
+func TestConfigHandlerCRDConfig(t *testing.T) {
+	logger.Init(true)
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	assert.NilError(t, os.WriteFile(configPath, []byte(`{"ServerPort": 6000}`), 0644))
+
+	c := NewConfigHandler(configPath, 50061)
+	assert.NilError(t, c.RefreshConfig())
+	assert.Equal(t, c.GetConfigSource(), "file")
+	assert.Equal(t, c.GetConfig().GetServerPort(), uint32(6000))
+
+	c.SetCRDConfig(&exportermetrics.MetricConfig{ServerPort: 7000})
+	assert.NilError(t, c.RefreshConfig())
+	assert.Equal(t, c.GetConfigSource(), "crd")
+	assert.Equal(t, c.GetConfig().GetServerPort(), uint32(7000))
+
+	// fallback to the file
+	c.SetCRDConfig(nil)
+	assert.NilError(t, c.RefreshConfig())
+	assert.Equal(t, c.GetConfig().GetServerPort(), uint32(6000))
+}
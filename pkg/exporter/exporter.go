@@ -18,24 +18,30 @@ package exporter
 
 import (
 	"context"
+	"encoding/json"
 	"expvar"
 	"fmt"
 	"log"
 	"net/http"
 	"net/http/pprof"
+	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gpuagent"
 	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/nicagent"
 	k8sclient "github.com/ROCm/device-metrics-exporter/pkg/client"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/config"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
@@ -70,6 +76,14 @@ type Exporter struct {
 	k8sApiClient        *k8sclient.K8sClient
 	svcHandler          *metricsserver.SvcHandler
 	k8sScl              scheduler.SchedulerClient
+	registrationScl     scheduler.SchedulerClient
+	configCRD           string
+	slurmDiscovery      string
+	batchScheduler      string
+	containerRuntime    string
+	sysRoot             string
+	procRoot            string
+	configReload        chan struct{}
 	ctx                 context.Context
 	cancel              context.CancelFunc
 }
@@ -86,6 +100,101 @@ func prometheusMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// handleGPUHealthHistoryQuery returns the GPU health transitions, filtered by
+// the optional query parameters id, state (comma separated lists) and start,
+// end (RFC3339 time)
+func handleGPUHealthHistoryQuery(w http.ResponseWriter, r *http.Request) {
+	if gpuclient == nil {
+		http.Error(w, "gpu monitoring is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	req, err := parseHealthHistoryQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	entries, err := gpuclient.GetHealthHistory(req)
+	if err != nil {
+		http.Error(w, "An error occured while querying health history:\n"+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := protojson.Marshal(&metricssvc.GPUHealthHistoryResponse{Entry: entries})
+	if err != nil {
+		http.Error(w, "An error occured while querying health history:\n"+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func parseHealthHistoryQuery(query url.Values) (*metricssvc.GPUHealthHistoryRequest, error) {
+	req := &metricssvc.GPUHealthHistoryRequest{}
+	req.ID = splitQueryList(query, "id")
+	req.Health = splitQueryList(query, "state")
+	for key, ts := range map[string]**timestamppb.Timestamp{"start": &req.StartTime, "end": &req.EndTime} {
+		if v := query.Get(key); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %v time %v, expected RFC3339 format", key, v)
+			}
+			*ts = timestamppb.New(t)
+		}
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit %v", v)
+		}
+		req.Limit = uint32(limit)
+	}
+	return req, nil
+}
+
+// splitQueryList returns the comma separated values of the query parameter
+func splitQueryList(query url.Values, key string) []string {
+	list := []string{}
+	for _, v := range query[key] {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+	return list
+}
+
+// handleGPUJobSummaryQuery returns the GPU accounting summaries of the ended
+// jobs, filtered by the id query parameter and limited to the latest limit
+// summaries
+func handleGPUJobSummaryQuery(w http.ResponseWriter, r *http.Request) {
+	if gpuclient == nil {
+		http.Error(w, "gpu monitoring is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	query := r.URL.Query()
+	limit := 0
+	if v := query.Get("limit"); v != "" {
+		l, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid limit %v", v), http.StatusBadRequest)
+			return
+		}
+		limit = int(l)
+	}
+	summaries, err := gpuclient.GetJobSummaries(splitQueryList(query, "id"), limit)
+	if err != nil {
+		http.Error(w, "An error occured while querying job summaries:\n"+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := json.Marshal(summaries)
+	if err != nil {
+		http.Error(w, "An error occured while querying job summaries:\n"+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
 func startMetricsServer(c *config.ConfigHandler, bindAddr string) *http.Server {
 
 	serverPort := c.GetServerPort()
@@ -97,6 +206,8 @@ func startMetricsServer(c *config.ConfigHandler, bindAddr string) *http.Server {
 	router.Handle(globals.MetricsHandlerPrefix, promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}))
 	// below route is for daemons like node-problem-detector that need all the metrics
 	router.Methods("GET").Subrouter().HandleFunc(globals.AMDGPUHandlerPrefix, mh.HandleGPUMetricsQuery)
+	router.Methods("GET").Subrouter().HandleFunc(globals.AMDGPUHealthHistoryHandlerPrefix, handleGPUHealthHistoryQuery)
+	router.Methods("GET").Subrouter().HandleFunc(globals.AMDGPUJobSummaryHandlerPrefix, handleGPUJobSummaryQuery)
 	// pprof
 	router.Methods("GET").Subrouter().Handle("/debug/vars", expvar.Handler())
 	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/", pprof.Index)
@@ -205,8 +316,16 @@ func foreverWatcher(e *Exporter) {
 					}
 					debounce.Reset(debounceDuration)
 				}
+			case <-e.configReload:
+				if !debounce.Stop() {
+					select {
+					case <-debounce.C:
+					default:
+					}
+				}
+				debounce.Reset(debounceDuration)
 			case <-debounce.C:
-				logger.Log.Printf("loading new config on %v", configPath)
+				logger.Log.Printf("loading new config from %v", runConf.GetConfigSource())
 				stopServer()
 				startServer()
 			case err, ok := <-watcher.Errors:
@@ -242,6 +361,9 @@ func NewExporter(agentGrpcport int, configFile string, opts ...ExporterOption) *
 		cancel:        cancel,
 		k8sApiClient:  nil,
 		disableK8sApi: false, // by default k8s api is enabled
+		sysRoot:       globals.SysRoot,
+		procRoot:      globals.ProcRoot,
+		configReload:  make(chan struct{}, 1),
 	}
 
 	for _, o := range opts {
@@ -321,6 +443,81 @@ func WithSRIOV(enableSriov bool) ExporterOption {
 	}
 }
 
+// WithConfigCRD watches the MetricsExporterConfig resource with the name,
+// the config file is used when the resource is not available
+func WithConfigCRD(name string) ExporterOption {
+	return func(e *Exporter) {
+		if name != "" {
+			logger.Log.Printf("config resource set to %v", name)
+		}
+		e.configCRD = name
+	}
+}
+
+// WithSlurmDiscovery sets how the slurm jobs are discovered, from the
+// prolog job files or from the cgroup hierarchy
+func WithSlurmDiscovery(mode string) ExporterOption {
+	return func(e *Exporter) {
+		logger.Log.Printf("slurm job discovery set to %v", mode)
+		e.slurmDiscovery = mode
+	}
+}
+
+// WithBatchScheduler sets the batch scheduler of the jobs, slurm or pbs
+func WithBatchScheduler(name string) ExporterOption {
+	return func(e *Exporter) {
+		logger.Log.Printf("batch scheduler set to %v", name)
+		e.batchScheduler = name
+	}
+}
+
+// WithContainerRuntime attributes the GPUs to the containers of the docker
+// or containerd runtime outside kubernetes
+func WithContainerRuntime(runtime string) ExporterOption {
+	return func(e *Exporter) {
+		if runtime != "" {
+			logger.Log.Printf("container runtime set to %v", runtime)
+		}
+		e.containerRuntime = runtime
+	}
+}
+
+// WithSysRoot sets the sysfs root read by the GPU monitoring, ex: the host
+// sysfs mounted in the container
+func WithSysRoot(root string) ExporterOption {
+	return func(e *Exporter) {
+		logger.Log.Printf("sysfs root set to %v", root)
+		e.sysRoot = root
+	}
+}
+
+// WithProcRoot sets the procfs root read by the GPU monitoring
+func WithProcRoot(root string) ExporterOption {
+	return func(e *Exporter) {
+		logger.Log.Printf("procfs root set to %v", root)
+		e.procRoot = root
+	}
+}
+
+// startConfigCRDWatcher applies the config from the MetricsExporterConfig
+// resource, a change reloads the config as a config file update does
+func (e *Exporter) startConfigCRDWatcher() {
+	if e.configCRD == "" || e.k8sApiClient == nil {
+		return
+	}
+	err := e.k8sApiClient.WatchConfigCRD(e.configCRD, func(cfg *exportermetrics.MetricConfig) {
+		runConf.SetCRDConfig(cfg)
+		select {
+		case e.configReload <- struct{}{}:
+		default:
+			// reload already pending
+		}
+	})
+	if err != nil {
+		logger.Log.Printf("failed to watch config resource %v: %v", e.configCRD, err)
+	}
+}
+
 func WithNoK8sApiclient() ExporterOption {
 	return func(e *Exporter) {
 		e.disableK8sApi = true
@@ -346,21 +543,43 @@ func (e *Exporter) StartMain(enableDebugAPI bool) {
 
 	// create scheduler client
 	if utils.IsKubernetes() && !e.disableK8sApi {
-		k8sScl, err := scheduler.NewKubernetesClient(e.ctx)
+		sclOpts := []scheduler.KubernetesClientOption{}
+		if e.k8sApiClient != nil {
+			sclOpts = append(sclOpts, scheduler.WithDRADeviceResolver(e.k8sApiClient))
+		}
+		k8sScl, err := scheduler.NewKubernetesClient(e.ctx, sclOpts...)
 		if err != nil {
 			logger.Log.Printf("failed to create k8s scheduler client: %v", err)
 		} else {
 			e.k8sScl = k8sScl
 		}
 		e.startWatchers()
+		e.startConfigCRDWatcher()
+	}
+
+	// workload registration of the external schedulers
+	if runConf.GetConfig().GetCommonConfig().GetWorkloadRegistration().GetEnable() {
+		registrationScl, err := scheduler.NewRegistrationClient(e.ctx)
+		if err != nil {
+			logger.Log.Printf("failed to create workload registration client: %v", err)
+		} else {
+			e.registrationScl = registrationScl
+		}
 	}
 
 	if e.enableGPUMonitoring {
 		gpuclient = gpuagent.NewAgent(mh,
 			gpuagent.WithZmq(!e.zmqDisable),
+			gpuagent.WithSlurmDiscovery(e.slurmDiscovery),
+			gpuagent.WithBatchScheduler(e.batchScheduler),
+			gpuagent.WithContainerRuntime(e.containerRuntime),
+			gpuagent.WithSysRoot(e.sysRoot),
+			gpuagent.WithProcRoot(e.procRoot),
 			gpuagent.WithK8sClient(e.GetK8sApiClient()),
 			gpuagent.WithSRIOV(e.enableSriov),
 			gpuagent.WithK8sSchedulerClient(e.k8sScl),
+			gpuagent.WithRegistrationClient(e.registrationScl),
+			gpuagent.WithHealthHistory(true),
 		)
 		if err := gpuclient.Init(); err != nil {
 			logger.Log.Printf("gpuclient init err :%+v", err)
@@ -374,6 +593,7 @@ func (e *Exporter) StartMain(enableDebugAPI bool) {
 	if e.enableNICMonitoring {
 		nicAgent = nicagent.NewAgent(mh,
 			nicagent.WithK8sSchedulerClient(e.k8sScl),
+			nicagent.WithRegistrationClient(e.registrationScl),
 			nicagent.WithK8sClient(e.GetK8sApiClient()),
 		)
 		if err := nicAgent.Init(); err != nil {
@@ -438,6 +658,11 @@ func (e *Exporter) Close() error {
 		nicAgent = nil
 	}
 
+	if e.registrationScl != nil {
+		e.registrationScl.Close()
+		e.registrationScl = nil
+	}
+
 	if e.k8sApiClient != nil {
 		e.k8sApiClient.Stop()
 		e.k8sApiClient = nil
@@ -251,6 +251,10 @@ const (
 	GPUMetricField_PCIE_RX                      GPUMetricField = 101
 	GPUMetricField_PCIE_TX                      GPUMetricField = 102
 	GPUMetricField_PCIE_BIDIRECTIONAL_BANDWIDTH GPUMetricField = 103
+	// current fan speed in RPM
+	GPUMetricField_GPU_FAN_SPEED GPUMetricField = 104
+	// age of the exported data per field group in seconds
+	GPUMetricField_GPU_DATA_AGE GPUMetricField = 105
 	// Profiler Metrics (reserving 801 to 1200)
 	GPUMetricField_GPU_PROF_GRBM_GUI_ACTIVE                    GPUMetricField = 801
 	GPUMetricField_GPU_PROF_SQ_WAVES                           GPUMetricField = 802
@@ -422,6 +426,8 @@ var (
 		101:  "PCIE_RX",
 		102:  "PCIE_TX",
 		103:  "PCIE_BIDIRECTIONAL_BANDWIDTH",
+		104:  "GPU_FAN_SPEED",
+		105:  "GPU_DATA_AGE",
 		801:  "GPU_PROF_GRBM_GUI_ACTIVE",
 		802:  "GPU_PROF_SQ_WAVES",
 		803:  "GPU_PROF_GRBM_COUNT",
@@ -580,6 +586,8 @@ var (
 		"PCIE_RX":                                            101,
 		"PCIE_TX":                                            102,
 		"PCIE_BIDIRECTIONAL_BANDWIDTH":                       103,
+		"GPU_FAN_SPEED":                                      104,
+		"GPU_DATA_AGE":                                       105,
 		"GPU_PROF_GRBM_GUI_ACTIVE":                           801,
 		"GPU_PROF_SQ_WAVES":                                  802,
 		"GPU_PROF_GRBM_COUNT":                                803,
@@ -683,18 +691,37 @@ const (
 	// VM_VF      - Virtual Machine with VF passthrough
 	// VM_PF      - Virtual Machine with PF passthrough
 	GPUMetricLabel_DEPLOYMENT_MODE GPUMetricLabel = 6
+	// slurm job step id, optional
+	GPUMetricLabel_JOB_STEP_ID GPUMetricLabel = 7
+	// slurm job array id, optional
+	GPUMetricLabel_JOB_ARRAY_JOB_ID GPUMetricLabel = 8
+	// slurm job array task id, optional
+	GPUMetricLabel_JOB_ARRAY_TASK_ID GPUMetricLabel = 9
+	// docker/containerd container image, optional
+	GPUMetricLabel_CONTAINER_IMAGE GPUMetricLabel = 10
+	// docker compose project of the container, optional
+	GPUMetricLabel_COMPOSE_PROJECT GPUMetricLabel = 11
+	// source of the metrics, gpuagent or sysfs, set when the sysfs
+	// fallback is enabled
+	GPUMetricLabel_SOURCE GPUMetricLabel = 12
 )
 
 // Enum value maps for GPUMetricLabel.
 var (
 	GPUMetricLabel_name = map[int32]string{
-		0: "GPU_UUID",
-		1: "GPU_ID",
-		2: "GPU_PARTITION_ID",
-		3: "GPU_COMPUTE_PARTITION_TYPE",
-		4: "GPU_MEMORY_PARTITION_TYPE",
-		5: "KFD_PROCESS_ID",
-		6: "DEPLOYMENT_MODE",
+		0:  "GPU_UUID",
+		1:  "GPU_ID",
+		2:  "GPU_PARTITION_ID",
+		3:  "GPU_COMPUTE_PARTITION_TYPE",
+		4:  "GPU_MEMORY_PARTITION_TYPE",
+		5:  "KFD_PROCESS_ID",
+		6:  "DEPLOYMENT_MODE",
+		7:  "JOB_STEP_ID",
+		8:  "JOB_ARRAY_JOB_ID",
+		9:  "JOB_ARRAY_TASK_ID",
+		10: "CONTAINER_IMAGE",
+		11: "COMPOSE_PROJECT",
+		12: "SOURCE",
 	}
 	GPUMetricLabel_value = map[string]int32{
 		"GPU_UUID":                   0,
@@ -704,6 +731,12 @@ var (
 		"GPU_MEMORY_PARTITION_TYPE":  4,
 		"KFD_PROCESS_ID":             5,
 		"DEPLOYMENT_MODE":            6,
+		"JOB_STEP_ID":                7,
+		"JOB_ARRAY_JOB_ID":           8,
+		"JOB_ARRAY_TASK_ID":          9,
+		"CONTAINER_IMAGE":            10,
+		"COMPOSE_PROJECT":            11,
+		"SOURCE":                     12,
 	}
 )
 
@@ -1643,6 +1676,199 @@ func (x *GPUHealthThresholds) GetGPU_ECC_UNCORRECT_MPIO() uint32 {
 	return 0
 }
 
+type GPUHealthHysteresis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of consecutive unhealthy evaluation cycles before the GPU is
+	// reported unhealthy, default/0 - 1
+	UnhealthyCycles uint32 `protobuf:"varint,1,opt,name=UnhealthyCycles,proto3" json:"UnhealthyCycles,omitempty"`
+	// number of consecutive healthy evaluation cycles before an unhealthy
+	// GPU is reported healthy again, default/0 - 1
+	HealthyCycles uint32 `protobuf:"varint,2,opt,name=HealthyCycles,proto3" json:"HealthyCycles,omitempty"`
+	// once reported unhealthy the GPU stays unhealthy until it is cleared
+	// by the operator through the ClearGPUHealth API
+	StickyUnhealthy bool `protobuf:"varint,3,opt,name=StickyUnhealthy,proto3" json:"StickyUnhealthy,omitempty"`
+}
+
+func (x *GPUHealthHysteresis) Reset() {
+	*x = GPUHealthHysteresis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUHealthHysteresis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUHealthHysteresis) ProtoMessage() {}
+
+func (x *GPUHealthHysteresis) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUHealthHysteresis.ProtoReflect.Descriptor instead.
+func (*GPUHealthHysteresis) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GPUHealthHysteresis) GetUnhealthyCycles() uint32 {
+	if x != nil {
+		return x.UnhealthyCycles
+	}
+	return 0
+}
+
+func (x *GPUHealthHysteresis) GetHealthyCycles() uint32 {
+	if x != nil {
+		return x.HealthyCycles
+	}
+	return 0
+}
+
+func (x *GPUHealthHysteresis) GetStickyUnhealthy() bool {
+	if x != nil {
+		return x.StickyUnhealthy
+	}
+	return false
+}
+
+type GPUHealthRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// GPU metric field the rule is evaluated on, ex: GPU_ECC_CORRECT_UMC
+	Field string `protobuf:"bytes,1,opt,name=Field,proto3" json:"Field,omitempty"`
+	// rule is triggered when the field value crosses the threshold
+	Threshold float64 `protobuf:"fixed64,2,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	// health level reported when triggered, DEGRADED or UNHEALTHY
+	// default/empty - DEGRADED
+	Level string `protobuf:"bytes,3,opt,name=Level,proto3" json:"Level,omitempty"`
+}
+
+func (x *GPUHealthRule) Reset() {
+	*x = GPUHealthRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUHealthRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUHealthRule) ProtoMessage() {}
+
+func (x *GPUHealthRule) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUHealthRule.ProtoReflect.Descriptor instead.
+func (*GPUHealthRule) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GPUHealthRule) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *GPUHealthRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GPUHealthRule) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type GPUDegradedHealthConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// report DEGRADED GPUs as healthy through the health service
+	HealthServiceIgnore bool `protobuf:"varint,1,opt,name=HealthServiceIgnore,proto3" json:"HealthServiceIgnore,omitempty"`
+	// do not publish DEGRADED GPUs in the node health labels
+	NodeLabelIgnore bool `protobuf:"varint,2,opt,name=NodeLabelIgnore,proto3" json:"NodeLabelIgnore,omitempty"`
+}
+
+func (x *GPUDegradedHealthConfig) Reset() {
+	*x = GPUDegradedHealthConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUDegradedHealthConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUDegradedHealthConfig) ProtoMessage() {}
+
+func (x *GPUDegradedHealthConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUDegradedHealthConfig.ProtoReflect.Descriptor instead.
+func (*GPUDegradedHealthConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GPUDegradedHealthConfig) GetHealthServiceIgnore() bool {
+	if x != nil {
+		return x.HealthServiceIgnore
+	}
+	return false
+}
+
+func (x *GPUDegradedHealthConfig) GetNodeLabelIgnore() bool {
+	if x != nil {
+		return x.NodeLabelIgnore
+	}
+	return false
+}
+
 type GPUMetricConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1668,12 +1894,46 @@ type GPUMetricConfig struct {
 	// if disabled all profiler related fields will not be exported to avoid reporting
 	// wrong values as 0
 	ProfilerMetrics map[string]bool `protobuf:"bytes,7,rep,name=ProfilerMetrics,proto3" json:"ProfilerMetrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// GPU health state transition damping
+	HealthHysteresis *GPUHealthHysteresis `protobuf:"bytes,8,opt,name=HealthHysteresis,proto3" json:"HealthHysteresis,omitempty"`
+	// additional health rules on GPU metric fields
+	HealthRules []*GPUHealthRule `protobuf:"bytes,9,rep,name=HealthRules,proto3" json:"HealthRules,omitempty"`
+	// handling of the DEGRADED health level by the consumers
+	DegradedHealth *GPUDegradedHealthConfig `protobuf:"bytes,10,opt,name=DegradedHealth,proto3" json:"DegradedHealth,omitempty"`
+	// kubernetes node health reporting
+	NodeHealthReporting *NodeHealthReportingConfig `protobuf:"bytes,11,opt,name=NodeHealthReporting,proto3" json:"NodeHealthReporting,omitempty"`
+	// kubernetes node remediation on unhealthy GPUs
+	Remediation *GPURemediationConfig `protobuf:"bytes,12,opt,name=Remediation,proto3" json:"Remediation,omitempty"`
+	// export of the workload labels of a GPU shared by several workloads
+	// "pair" (default) - metrics are exported once per GPU and workload
+	// "join" - metrics are exported once with the workload label values
+	//          comma separated
+	WorkloadLabelMode string `protobuf:"bytes,13,opt,name=WorkloadLabelMode,proto3" json:"WorkloadLabelMode,omitempty"`
+	// Map of node labels to be exported (prometheus metric name as Key, node label as value)
+	NodeLabels map[string]string `protobuf:"bytes,14,rep,name=NodeLabels,proto3" json:"NodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of pod annotations to be exported (prometheus metric name as Key, pod annotation as value)
+	ExtraPodAnnotations map[string]string `protobuf:"bytes,15,rep,name=ExtraPodAnnotations,proto3" json:"ExtraPodAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// per job GPU accounting of the slurm and pbs jobs
+	JobAccounting *JobAccountingConfig `protobuf:"bytes,16,opt,name=JobAccounting,proto3" json:"JobAccounting,omitempty"`
+	// Map of registered workload labels to be exported (prometheus metric name as Key,
+	// workload registration label as value)
+	WorkloadLabels map[string]string `protobuf:"bytes,17,rep,name=WorkloadLabels,proto3" json:"WorkloadLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// per process GPU metrics from the KFD process accounting
+	ProcessMetrics *ProcessMetricsConfig `protobuf:"bytes,18,opt,name=ProcessMetrics,proto3" json:"ProcessMetrics,omitempty"`
+	// sysfs fallback collector when gpuagent is unavailable
+	SysfsFallback *SysfsFallbackConfig `protobuf:"bytes,19,opt,name=SysfsFallback,proto3" json:"SysfsFallback,omitempty"`
+	// high frequency sampling of GPU metric fields between the scrapes
+	HighFrequencySampling *HighFrequencySamplingConfig `protobuf:"bytes,20,opt,name=HighFrequencySampling,proto3" json:"HighFrequencySampling,omitempty"`
+	// polling of gpuagent and rocprofiler
+	Polling *GPUAgentPollingConfig `protobuf:"bytes,21,opt,name=Polling,proto3" json:"Polling,omitempty"`
+	// file backed history of the GPU health transitions
+	HealthHistory *GPUHealthHistoryConfig `protobuf:"bytes,22,opt,name=HealthHistory,proto3" json:"HealthHistory,omitempty"`
 }
 
 func (x *GPUMetricConfig) Reset() {
 	*x = GPUMetricConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[1]
+		mi := &file_exporterconfig_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1686,7 +1946,7 @@ func (x *GPUMetricConfig) String() string {
 func (*GPUMetricConfig) ProtoMessage() {}
 
 func (x *GPUMetricConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[1]
+	mi := &file_exporterconfig_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +1959,7 @@ func (x *GPUMetricConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUMetricConfig.ProtoReflect.Descriptor instead.
 func (*GPUMetricConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{1}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GPUMetricConfig) GetSelector() string {
@@ -1751,31 +2011,140 @@ func (x *GPUMetricConfig) GetProfilerMetrics() map[string]bool {
 	return nil
 }
 
-type HealthServiceConfig struct {
+func (x *GPUMetricConfig) GetHealthHysteresis() *GPUHealthHysteresis {
+	if x != nil {
+		return x.HealthHysteresis
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetHealthRules() []*GPUHealthRule {
+	if x != nil {
+		return x.HealthRules
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetDegradedHealth() *GPUDegradedHealthConfig {
+	if x != nil {
+		return x.DegradedHealth
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetNodeHealthReporting() *NodeHealthReportingConfig {
+	if x != nil {
+		return x.NodeHealthReporting
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetRemediation() *GPURemediationConfig {
+	if x != nil {
+		return x.Remediation
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetWorkloadLabelMode() string {
+	if x != nil {
+		return x.WorkloadLabelMode
+	}
+	return ""
+}
+
+func (x *GPUMetricConfig) GetNodeLabels() map[string]string {
+	if x != nil {
+		return x.NodeLabels
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetExtraPodAnnotations() map[string]string {
+	if x != nil {
+		return x.ExtraPodAnnotations
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetJobAccounting() *JobAccountingConfig {
+	if x != nil {
+		return x.JobAccounting
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetWorkloadLabels() map[string]string {
+	if x != nil {
+		return x.WorkloadLabels
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetProcessMetrics() *ProcessMetricsConfig {
+	if x != nil {
+		return x.ProcessMetrics
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetSysfsFallback() *SysfsFallbackConfig {
+	if x != nil {
+		return x.SysfsFallback
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetHighFrequencySampling() *HighFrequencySamplingConfig {
+	if x != nil {
+		return x.HighFrequencySampling
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetPolling() *GPUAgentPollingConfig {
+	if x != nil {
+		return x.Polling
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetHealthHistory() *GPUHealthHistoryConfig {
+	if x != nil {
+		return x.HealthHistory
+	}
+	return nil
+}
+
+// history of the GPU health transitions served by the GetHealthHistory API
+type GPUHealthHistoryConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+	// file of the history, default /var/lib/amd-metrics-exporter/gpu_health_history.log
+	FilePath string `protobuf:"bytes,1,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	// number of the latest transitions kept, default 10000
+	MaxEntries uint32 `protobuf:"varint,2,opt,name=MaxEntries,proto3" json:"MaxEntries,omitempty"`
 }
 
-func (x *HealthServiceConfig) Reset() {
-	*x = HealthServiceConfig{}
+func (x *GPUHealthHistoryConfig) Reset() {
+	*x = GPUHealthHistoryConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[2]
+		mi := &file_exporterconfig_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthServiceConfig) String() string {
+func (x *GPUHealthHistoryConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthServiceConfig) ProtoMessage() {}
+func (*GPUHealthHistoryConfig) ProtoMessage() {}
 
-func (x *HealthServiceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[2]
+func (x *GPUHealthHistoryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,46 +2155,53 @@ func (x *HealthServiceConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthServiceConfig.ProtoReflect.Descriptor instead.
-func (*HealthServiceConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GPUHealthHistoryConfig.ProtoReflect.Descriptor instead.
+func (*GPUHealthHistoryConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *HealthServiceConfig) GetEnable() bool {
+func (x *GPUHealthHistoryConfig) GetFilePath() string {
 	if x != nil {
-		return x.Enable
+		return x.FilePath
 	}
-	return false
+	return ""
 }
 
-type CommonConfig struct {
+func (x *GPUHealthHistoryConfig) GetMaxEntries() uint32 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+// GPU metrics read from the amdgpu sysfs and hwmon when gpuagent is
+// unavailable, the metrics are exported with the source label
+type SysfsFallbackConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// string to add as perfix to all exported fields
-	MetricsFieldPrefix string `protobuf:"bytes,1,opt,name=MetricsFieldPrefix,proto3" json:"MetricsFieldPrefix,omitempty"`
-	// Health Service config
-	HealthService *HealthServiceConfig `protobuf:"bytes,2,opt,name=HealthService,proto3" json:"HealthService,omitempty"`
+	// true to enable the fallback collector, disabled by default
+	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
 }
 
-func (x *CommonConfig) Reset() {
-	*x = CommonConfig{}
+func (x *SysfsFallbackConfig) Reset() {
+	*x = SysfsFallbackConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[3]
+		mi := &file_exporterconfig_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommonConfig) String() string {
+func (x *SysfsFallbackConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommonConfig) ProtoMessage() {}
+func (*SysfsFallbackConfig) ProtoMessage() {}
 
-func (x *CommonConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[3]
+func (x *SysfsFallbackConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1836,9 +2212,896 @@ func (x *CommonConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommonConfig.ProtoReflect.Descriptor instead.
-func (*CommonConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SysfsFallbackConfig.ProtoReflect.Descriptor instead.
+func (*SysfsFallbackConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SysfsFallbackConfig) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+// polling intervals, cache and timeouts of the gpuagent and rocprofiler
+// queries, applied on config reload
+type GPUAgentPollingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// interval of the health, node label, remediation and job accounting
+	// updates in milliseconds, default 30000
+	RefreshIntervalMs uint32 `protobuf:"varint,1,opt,name=RefreshIntervalMs,proto3" json:"RefreshIntervalMs,omitempty"`
+	// timeout of the gpuagent GPU queries in milliseconds, default 15000
+	QueryTimeoutMs uint32 `protobuf:"varint,2,opt,name=QueryTimeoutMs,proto3" json:"QueryTimeoutMs,omitempty"`
+	// time the gpuagent GPU response is reused by the scrapes and the
+	// updates in milliseconds, default 15000
+	CacheTTLMs uint32 `protobuf:"varint,3,opt,name=CacheTTLMs,proto3" json:"CacheTTLMs,omitempty"`
+	// time the rocprofiler metrics are reused in milliseconds, default 10000
+	ProfilerCacheTTLMs uint32 `protobuf:"varint,4,opt,name=ProfilerCacheTTLMs,proto3" json:"ProfilerCacheTTLMs,omitempty"`
+	// timeout of the rocprofiler queries in milliseconds, default 15000
+	ProfilerTimeoutMs uint32 `protobuf:"varint,5,opt,name=ProfilerTimeoutMs,proto3" json:"ProfilerTimeoutMs,omitempty"`
+}
+
+func (x *GPUAgentPollingConfig) Reset() {
+	*x = GPUAgentPollingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUAgentPollingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUAgentPollingConfig) ProtoMessage() {}
+
+func (x *GPUAgentPollingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUAgentPollingConfig.ProtoReflect.Descriptor instead.
+func (*GPUAgentPollingConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GPUAgentPollingConfig) GetRefreshIntervalMs() uint32 {
+	if x != nil {
+		return x.RefreshIntervalMs
+	}
+	return 0
+}
+
+func (x *GPUAgentPollingConfig) GetQueryTimeoutMs() uint32 {
+	if x != nil {
+		return x.QueryTimeoutMs
+	}
+	return 0
+}
+
+func (x *GPUAgentPollingConfig) GetCacheTTLMs() uint32 {
+	if x != nil {
+		return x.CacheTTLMs
+	}
+	return 0
+}
+
+func (x *GPUAgentPollingConfig) GetProfilerCacheTTLMs() uint32 {
+	if x != nil {
+		return x.ProfilerCacheTTLMs
+	}
+	return 0
+}
+
+func (x *GPUAgentPollingConfig) GetProfilerTimeoutMs() uint32 {
+	if x != nil {
+		return x.ProfilerTimeoutMs
+	}
+	return 0
+}
+
+// sampling of GPU metric fields from the gpu_metrics table of the driver
+// at a higher rate than the scrapes, the min, max, average and quantiles
+// of the latest samples are exported with the histograms of all the samples
+type HighFrequencySamplingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// true to enable the sampling, disabled by default
+	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+	// list of GPUMetricField sampled, default GPU_PACKAGE_POWER,
+	// GPU_GFX_ACTIVITY and GPU_JUNCTION_TEMPERATURE
+	Fields []string `protobuf:"bytes,2,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// sampling interval in milliseconds, default 100
+	IntervalMs uint32 `protobuf:"varint,3,opt,name=IntervalMs,proto3" json:"IntervalMs,omitempty"`
+	// number of the latest samples kept per GPU and field for the min,
+	// max, average and quantiles, default 1000
+	MaxSamples uint32 `protobuf:"varint,4,opt,name=MaxSamples,proto3" json:"MaxSamples,omitempty"`
+	// quantiles exported per field, default 0.5, 0.9 and 0.99
+	Quantiles []float64 `protobuf:"fixed64,5,rep,packed,name=Quantiles,proto3" json:"Quantiles,omitempty"`
+	// histogram bucket upper bounds per GPUMetricField, replacing the
+	// default buckets of the field
+	Buckets map[string]*HistogramBuckets `protobuf:"bytes,6,rep,name=Buckets,proto3" json:"Buckets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// true to export native histograms along with the buckets
+	NativeHistograms bool `protobuf:"varint,7,opt,name=NativeHistograms,proto3" json:"NativeHistograms,omitempty"`
+}
+
+func (x *HighFrequencySamplingConfig) Reset() {
+	*x = HighFrequencySamplingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HighFrequencySamplingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighFrequencySamplingConfig) ProtoMessage() {}
+
+func (x *HighFrequencySamplingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighFrequencySamplingConfig.ProtoReflect.Descriptor instead.
+func (*HighFrequencySamplingConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HighFrequencySamplingConfig) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *HighFrequencySamplingConfig) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *HighFrequencySamplingConfig) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *HighFrequencySamplingConfig) GetMaxSamples() uint32 {
+	if x != nil {
+		return x.MaxSamples
+	}
+	return 0
+}
+
+func (x *HighFrequencySamplingConfig) GetQuantiles() []float64 {
+	if x != nil {
+		return x.Quantiles
+	}
+	return nil
+}
+
+func (x *HighFrequencySamplingConfig) GetBuckets() map[string]*HistogramBuckets {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *HighFrequencySamplingConfig) GetNativeHistograms() bool {
+	if x != nil {
+		return x.NativeHistograms
+	}
+	return false
+}
+
+// upper bounds of histogram buckets
+type HistogramBuckets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bounds []float64 `protobuf:"fixed64,1,rep,packed,name=Bounds,proto3" json:"Bounds,omitempty"`
+}
+
+func (x *HistogramBuckets) Reset() {
+	*x = HistogramBuckets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistogramBuckets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistogramBuckets) ProtoMessage() {}
+
+func (x *HistogramBuckets) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistogramBuckets.ProtoReflect.Descriptor instead.
+func (*HistogramBuckets) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HistogramBuckets) GetBounds() []float64 {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+// gpu_process_* metrics of the processes using the GPUs
+type ProcessMetricsConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// true to export the process metrics, disabled by default
+	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+	// number of the processes using the most VRAM exported per GPU,
+	// default 10
+	TopN uint32 `protobuf:"varint,2,opt,name=TopN,proto3" json:"TopN,omitempty"`
+}
+
+func (x *ProcessMetricsConfig) Reset() {
+	*x = ProcessMetricsConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessMetricsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessMetricsConfig) ProtoMessage() {}
+
+func (x *ProcessMetricsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessMetricsConfig.ProtoReflect.Descriptor instead.
+func (*ProcessMetricsConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProcessMetricsConfig) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *ProcessMetricsConfig) GetTopN() uint32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+// accounting of the GPU usage of the slurm and pbs jobs, a summary is written
+// when the job ends
+type JobAccountingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// true to enable the accounting, disabled by default
+	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+	// directory of the json job summaries, default /var/lib/amd-metrics-exporter/jobs
+	Directory string `protobuf:"bytes,2,opt,name=Directory,proto3" json:"Directory,omitempty"`
+	// number of the latest job summaries kept for the http endpoint
+	// and the metrics, the files of the older summaries are removed,
+	// default 100
+	MaxSummaries uint32 `protobuf:"varint,3,opt,name=MaxSummaries,proto3" json:"MaxSummaries,omitempty"`
+	// true to export the kept job summaries as job_* metrics
+	ExportMetrics bool `protobuf:"varint,4,opt,name=ExportMetrics,proto3" json:"ExportMetrics,omitempty"`
+}
+
+func (x *JobAccountingConfig) Reset() {
+	*x = JobAccountingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobAccountingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobAccountingConfig) ProtoMessage() {}
+
+func (x *JobAccountingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobAccountingConfig.ProtoReflect.Descriptor instead.
+func (*JobAccountingConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *JobAccountingConfig) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *JobAccountingConfig) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *JobAccountingConfig) GetMaxSummaries() uint32 {
+	if x != nil {
+		return x.MaxSummaries
+	}
+	return 0
+}
+
+func (x *JobAccountingConfig) GetExportMetrics() bool {
+	if x != nil {
+		return x.ExportMetrics
+	}
+	return false
+}
+
+// node remediation policy applied when GPUs turn unhealthy, the action is
+// reverted once all the GPUs are healthy again
+type GPURemediationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// true to enable the policy, disabled by default
+	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+	// "taint" (default) to taint the node or "cordon" to mark it unschedulable
+	Action string `protobuf:"bytes,2,opt,name=Action,proto3" json:"Action,omitempty"`
+	// number of unhealthy GPUs to apply the action, default 1
+	UnhealthyThreshold uint32 `protobuf:"varint,3,opt,name=UnhealthyThreshold,proto3" json:"UnhealthyThreshold,omitempty"`
+	// taint key, default amd.com/gpu-unhealthy
+	TaintKey string `protobuf:"bytes,4,opt,name=TaintKey,proto3" json:"TaintKey,omitempty"`
+	// taint effect, NoSchedule (default), PreferNoSchedule or NoExecute
+	TaintEffect string `protobuf:"bytes,5,opt,name=TaintEffect,proto3" json:"TaintEffect,omitempty"`
+	// true to only log and report the actions as events without
+	// modifying the node
+	DryRun bool `protobuf:"varint,6,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	// minimum interval between two actions in seconds, default 300
+	MinActionInterval uint32 `protobuf:"varint,7,opt,name=MinActionInterval,proto3" json:"MinActionInterval,omitempty"`
+}
+
+func (x *GPURemediationConfig) Reset() {
+	*x = GPURemediationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPURemediationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPURemediationConfig) ProtoMessage() {}
+
+func (x *GPURemediationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPURemediationConfig.ProtoReflect.Descriptor instead.
+func (*GPURemediationConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GPURemediationConfig) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *GPURemediationConfig) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *GPURemediationConfig) GetUnhealthyThreshold() uint32 {
+	if x != nil {
+		return x.UnhealthyThreshold
+	}
+	return 0
+}
+
+func (x *GPURemediationConfig) GetTaintKey() string {
+	if x != nil {
+		return x.TaintKey
+	}
+	return ""
+}
+
+func (x *GPURemediationConfig) GetTaintEffect() string {
+	if x != nil {
+		return x.TaintEffect
+	}
+	return ""
+}
+
+func (x *GPURemediationConfig) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *GPURemediationConfig) GetMinActionInterval() uint32 {
+	if x != nil {
+		return x.MinActionInterval
+	}
+	return 0
+}
+
+// kubernetes node health reporting of the devices
+type NodeHealthReportingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// true to stop reporting unhealthy devices as node labels
+	// default/empty - labels are reported
+	DisableNodeLabels bool `protobuf:"varint,1,opt,name=DisableNodeLabels,proto3" json:"DisableNodeLabels,omitempty"`
+	// true to maintain a node condition with the devices health
+	EnableNodeCondition bool `protobuf:"varint,2,opt,name=EnableNodeCondition,proto3" json:"EnableNodeCondition,omitempty"`
+	// node condition type, defaults to AMDGPUHealthy for GPUs
+	// and AMDNICHealthy for NICs
+	ConditionType string `protobuf:"bytes,3,opt,name=ConditionType,proto3" json:"ConditionType,omitempty"`
+	// true to stop posting events on the node and the affected pods
+	// on health transitions
+	DisableEvents bool `protobuf:"varint,4,opt,name=DisableEvents,proto3" json:"DisableEvents,omitempty"`
+}
+
+func (x *NodeHealthReportingConfig) Reset() {
+	*x = NodeHealthReportingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeHealthReportingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHealthReportingConfig) ProtoMessage() {}
+
+func (x *NodeHealthReportingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHealthReportingConfig.ProtoReflect.Descriptor instead.
+func (*NodeHealthReportingConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NodeHealthReportingConfig) GetDisableNodeLabels() bool {
+	if x != nil {
+		return x.DisableNodeLabels
+	}
+	return false
+}
+
+func (x *NodeHealthReportingConfig) GetEnableNodeCondition() bool {
+	if x != nil {
+		return x.EnableNodeCondition
+	}
+	return false
+}
+
+func (x *NodeHealthReportingConfig) GetConditionType() string {
+	if x != nil {
+		return x.ConditionType
+	}
+	return ""
+}
+
+func (x *NodeHealthReportingConfig) GetDisableEvents() bool {
+	if x != nil {
+		return x.DisableEvents
+	}
+	return false
+}
+
+// unix socket permissions for the health service
+type HealthServiceSocketConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// socket file mode in octal, ex: "0666"
+	// default/empty - 0660
+	Mode string `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	// group name or gid owning the socket, empty leaves the process group
+	Group string `protobuf:"bytes,2,opt,name=Group,proto3" json:"Group,omitempty"`
+}
+
+func (x *HealthServiceSocketConfig) Reset() {
+	*x = HealthServiceSocketConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthServiceSocketConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthServiceSocketConfig) ProtoMessage() {}
+
+func (x *HealthServiceSocketConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthServiceSocketConfig.ProtoReflect.Descriptor instead.
+func (*HealthServiceSocketConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HealthServiceSocketConfig) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *HealthServiceSocketConfig) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// remote access to the health service over TCP with mutual TLS
+type HealthServiceTCPConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// listen address, ex: ":50062", empty disables the TCP listener
+	ListenAddress string `protobuf:"bytes,1,opt,name=ListenAddress,proto3" json:"ListenAddress,omitempty"`
+	// server certificate and key in PEM format
+	CertFile string `protobuf:"bytes,2,opt,name=CertFile,proto3" json:"CertFile,omitempty"`
+	KeyFile  string `protobuf:"bytes,3,opt,name=KeyFile,proto3" json:"KeyFile,omitempty"`
+	// CA bundle used to verify client certificates
+	ClientCAFile string `protobuf:"bytes,4,opt,name=ClientCAFile,proto3" json:"ClientCAFile,omitempty"`
+	// client identities (certificate CN or DNS SAN) allowed read-only
+	// methods, empty allows any client with a verified certificate
+	ReadOnlyClients []string `protobuf:"bytes,5,rep,name=ReadOnlyClients,proto3" json:"ReadOnlyClients,omitempty"`
+	// client identities allowed mutating methods (SetError, ClearGPUHealth)
+	// in addition to the read-only ones, empty denies mutating methods
+	ReadWriteClients []string `protobuf:"bytes,6,rep,name=ReadWriteClients,proto3" json:"ReadWriteClients,omitempty"`
+}
+
+func (x *HealthServiceTCPConfig) Reset() {
+	*x = HealthServiceTCPConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthServiceTCPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthServiceTCPConfig) ProtoMessage() {}
+
+func (x *HealthServiceTCPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthServiceTCPConfig.ProtoReflect.Descriptor instead.
+func (*HealthServiceTCPConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HealthServiceTCPConfig) GetListenAddress() string {
+	if x != nil {
+		return x.ListenAddress
+	}
+	return ""
+}
+
+func (x *HealthServiceTCPConfig) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *HealthServiceTCPConfig) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *HealthServiceTCPConfig) GetClientCAFile() string {
+	if x != nil {
+		return x.ClientCAFile
+	}
+	return ""
+}
+
+func (x *HealthServiceTCPConfig) GetReadOnlyClients() []string {
+	if x != nil {
+		return x.ReadOnlyClients
+	}
+	return nil
+}
+
+func (x *HealthServiceTCPConfig) GetReadWriteClients() []string {
+	if x != nil {
+		return x.ReadWriteClients
+	}
+	return nil
+}
+
+type HealthServiceConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+	// unix socket permissions
+	Socket *HealthServiceSocketConfig `protobuf:"bytes,2,opt,name=Socket,proto3" json:"Socket,omitempty"`
+	// optional TCP listener with mTLS
+	TCP *HealthServiceTCPConfig `protobuf:"bytes,3,opt,name=TCP,proto3" json:"TCP,omitempty"`
+}
+
+func (x *HealthServiceConfig) Reset() {
+	*x = HealthServiceConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthServiceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthServiceConfig) ProtoMessage() {}
+
+func (x *HealthServiceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthServiceConfig.ProtoReflect.Descriptor instead.
+func (*HealthServiceConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HealthServiceConfig) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *HealthServiceConfig) GetSocket() *HealthServiceSocketConfig {
+	if x != nil {
+		return x.Socket
+	}
+	return nil
+}
+
+func (x *HealthServiceConfig) GetTCP() *HealthServiceTCPConfig {
+	if x != nil {
+		return x.TCP
+	}
+	return nil
+}
+
+// registration of the workloads of the external schedulers on a unix socket,
+// read at startup
+type WorkloadRegistrationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// true to serve the registration socket, disabled by default
+	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+}
+
+func (x *WorkloadRegistrationConfig) Reset() {
+	*x = WorkloadRegistrationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadRegistrationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadRegistrationConfig) ProtoMessage() {}
+
+func (x *WorkloadRegistrationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadRegistrationConfig.ProtoReflect.Descriptor instead.
+func (*WorkloadRegistrationConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WorkloadRegistrationConfig) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+type CommonConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// string to add as perfix to all exported fields
+	MetricsFieldPrefix string `protobuf:"bytes,1,opt,name=MetricsFieldPrefix,proto3" json:"MetricsFieldPrefix,omitempty"`
+	// Health Service config
+	HealthService *HealthServiceConfig `protobuf:"bytes,2,opt,name=HealthService,proto3" json:"HealthService,omitempty"`
+	// workload registration service of the external schedulers
+	WorkloadRegistration *WorkloadRegistrationConfig `protobuf:"bytes,3,opt,name=WorkloadRegistration,proto3" json:"WorkloadRegistration,omitempty"`
+}
+
+func (x *CommonConfig) Reset() {
+	*x = CommonConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommonConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommonConfig) ProtoMessage() {}
+
+func (x *CommonConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommonConfig.ProtoReflect.Descriptor instead.
+func (*CommonConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CommonConfig) GetMetricsFieldPrefix() string {
@@ -1848,9 +3111,16 @@ func (x *CommonConfig) GetMetricsFieldPrefix() string {
 	return ""
 }
 
-func (x *CommonConfig) GetHealthService() *HealthServiceConfig {
+func (x *CommonConfig) GetHealthService() *HealthServiceConfig {
+	if x != nil {
+		return x.HealthService
+	}
+	return nil
+}
+
+func (x *CommonConfig) GetWorkloadRegistration() *WorkloadRegistrationConfig {
 	if x != nil {
-		return x.HealthService
+		return x.WorkloadRegistration
 	}
 	return nil
 }
@@ -1870,12 +3140,21 @@ type NICMetricConfig struct {
 	HealthCheckConfig *NICHealthCheckConfig `protobuf:"bytes,4,opt,name=HealthCheckConfig,proto3" json:"HealthCheckConfig,omitempty"`
 	// Map of extra pod labels to be exported (prometheus metric name as Key, pod label as value)
 	ExtraPodLabels map[string]string `protobuf:"bytes,5,rep,name=ExtraPodLabels,proto3" json:"ExtraPodLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// kubernetes node health reporting
+	NodeHealthReporting *NodeHealthReportingConfig `protobuf:"bytes,6,opt,name=NodeHealthReporting,proto3" json:"NodeHealthReporting,omitempty"`
+	// Map of node labels to be exported (prometheus metric name as Key, node label as value)
+	NodeLabels map[string]string `protobuf:"bytes,7,rep,name=NodeLabels,proto3" json:"NodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of pod annotations to be exported (prometheus metric name as Key, pod annotation as value)
+	ExtraPodAnnotations map[string]string `protobuf:"bytes,8,rep,name=ExtraPodAnnotations,proto3" json:"ExtraPodAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of registered workload labels to be exported (prometheus metric name as Key,
+	// workload registration label as value)
+	WorkloadLabels map[string]string `protobuf:"bytes,9,rep,name=WorkloadLabels,proto3" json:"WorkloadLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *NICMetricConfig) Reset() {
 	*x = NICMetricConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[4]
+		mi := &file_exporterconfig_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1888,7 +3167,7 @@ func (x *NICMetricConfig) String() string {
 func (*NICMetricConfig) ProtoMessage() {}
 
 func (x *NICMetricConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[4]
+	mi := &file_exporterconfig_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1901,7 +3180,7 @@ func (x *NICMetricConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NICMetricConfig.ProtoReflect.Descriptor instead.
 func (*NICMetricConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{4}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *NICMetricConfig) GetFields() []string {
@@ -1939,6 +3218,34 @@ func (x *NICMetricConfig) GetExtraPodLabels() map[string]string {
 	return nil
 }
 
+func (x *NICMetricConfig) GetNodeHealthReporting() *NodeHealthReportingConfig {
+	if x != nil {
+		return x.NodeHealthReporting
+	}
+	return nil
+}
+
+func (x *NICMetricConfig) GetNodeLabels() map[string]string {
+	if x != nil {
+		return x.NodeLabels
+	}
+	return nil
+}
+
+func (x *NICMetricConfig) GetExtraPodAnnotations() map[string]string {
+	if x != nil {
+		return x.ExtraPodAnnotations
+	}
+	return nil
+}
+
+func (x *NICMetricConfig) GetWorkloadLabels() map[string]string {
+	if x != nil {
+		return x.WorkloadLabels
+	}
+	return nil
+}
+
 type NICHealthCheckConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1951,7 +3258,7 @@ type NICHealthCheckConfig struct {
 func (x *NICHealthCheckConfig) Reset() {
 	*x = NICHealthCheckConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[5]
+		mi := &file_exporterconfig_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1964,7 +3271,7 @@ func (x *NICHealthCheckConfig) String() string {
 func (*NICHealthCheckConfig) ProtoMessage() {}
 
 func (x *NICHealthCheckConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[5]
+	mi := &file_exporterconfig_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1977,7 +3284,7 @@ func (x *NICHealthCheckConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NICHealthCheckConfig.ProtoReflect.Descriptor instead.
 func (*NICHealthCheckConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{5}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *NICHealthCheckConfig) GetInterfaceAdminDownAsUnhealthy() bool {
@@ -2005,7 +3312,7 @@ type MetricConfig struct {
 func (x *MetricConfig) Reset() {
 	*x = MetricConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[6]
+		mi := &file_exporterconfig_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2018,7 +3325,7 @@ func (x *MetricConfig) String() string {
 func (*MetricConfig) ProtoMessage() {}
 
 func (x *MetricConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[6]
+	mi := &file_exporterconfig_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2031,7 +3338,7 @@ func (x *MetricConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetricConfig.ProtoReflect.Descriptor instead.
 func (*MetricConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{6}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MetricConfig) GetServerPort() uint32 {
@@ -2131,842 +3438,1129 @@ var file_exporterconfig_proto_rawDesc = []byte{
 	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
 	0x4d, 0x50, 0x49, 0x4f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x47, 0x50, 0x55, 0x45,
 	0x43, 0x43, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x4d, 0x50, 0x49, 0x4f, 0x22,
-	0x8e, 0x05, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
-	0x50, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52,
-	0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x73, 0x12, 0x56, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5c, 0x0a, 0x0e, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f,
-	0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5f, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x35, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x2d, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22,
-	0x8a, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x2e, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x12, 0x4a, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xd0, 0x03, 0x0a,
-	0x0f, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x12, 0x56, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x53, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a,
+	0x8f, 0x01, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x69, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x79, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x43, 0x79, 0x63, 0x6c, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x43, 0x79, 0x63, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x74, 0x69, 0x63, 0x6b,
+	0x79, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x22, 0x59, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x75, 0x0a, 0x17,
+	0x47, 0x50, 0x55, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x4e, 0x6f, 0x64,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x22, 0x8c, 0x10, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x52, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50,
+	0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5c, 0x0a,
 	0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69,
 	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64,
 	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13,
-	0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5f, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x50, 0x0a, 0x10,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x79, 0x73, 0x74, 0x65, 0x72, 0x65, 0x73, 0x69, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x48, 0x79, 0x73, 0x74, 0x65, 0x72, 0x65, 0x73, 0x69, 0x73, 0x52, 0x10, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x48, 0x79, 0x73, 0x74, 0x65, 0x72, 0x65, 0x73, 0x69, 0x73, 0x12, 0x40,
+	0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x50, 0x0a, 0x0e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x44, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x5c, 0x0a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x13, 0x4e, 0x6f, 0x64,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x47, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x52, 0x65, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x52, 0x65,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x57, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50,
+	0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x4e,
+	0x6f, 0x64, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f,
+	0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x4a, 0x6f, 0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0d, 0x4a, 0x6f, 0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x5c, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x4d, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x4a, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x66, 0x73, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x79, 0x73, 0x66, 0x73, 0x46, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x53, 0x79,
+	0x73, 0x66, 0x73, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x15, 0x48,
+	0x69, 0x67, 0x68, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x69, 0x67,
+	0x68, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x15, 0x48, 0x69, 0x67, 0x68, 0x46, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x40, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x12, 0x4d, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x5c, 0x0a, 0x14, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x1d, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x55,
-	0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x6f,
-	0x77, 0x6e, 0x41, 0x73, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x22, 0xf1, 0x01,
-	0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e,
-	0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3e,
-	0x0a, 0x09, 0x47, 0x50, 0x55, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x09, 0x47, 0x50, 0x55, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41,
-	0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x3e, 0x0a, 0x09, 0x4e, 0x49, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x41, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x54, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x78, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4d, 0x61,
+	0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x66,
+	0x73, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x54, 0x54, 0x4c, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x54, 0x54, 0x4c, 0x4d, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x54, 0x4c, 0x4d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x12, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x54, 0x54, 0x4c, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x52, 0x13, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x73,
+	0x22, 0x8b, 0x03, 0x0a, 0x1b, 0x48, 0x69, 0x67, 0x68, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x78, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4d, 0x61, 0x78, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x09, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x53,
+	0x0a, 0x07, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x39, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x4e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x5d, 0x0a, 0x0c, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2a,
+	0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x06, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x42, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x6f,
+	0x70, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x6f, 0x70, 0x4e, 0x22, 0x95,
+	0x01, 0x0a, 0x13, 0x4a, 0x6f, 0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c,
+	0x4d, 0x61, 0x78, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x52, 0x65,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x12, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x55, 0x6e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x54,
+	0x61, 0x69, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x4d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x22, 0xc7, 0x01, 0x0a, 0x19, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x2c, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x30, 0x0a, 0x13, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x45, 0x0a,
+	0x19, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x22, 0xee, 0x01, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x24, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x41, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x41, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x61,
+	0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x03, 0x54, 0x43, 0x50,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x03, 0x54, 0x43, 0x50, 0x22, 0x34, 0x0a, 0x1a, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x4a, 0x0a, 0x0d, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x95, 0x08, 0x0a, 0x0f, 0x4e, 0x49, 0x43,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x0c,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x53, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0e, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f,
+	0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5c, 0x0a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x50, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x4e, 0x6f, 0x64,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
 	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x4e, 0x49, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2a, 0xed, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d,
-	0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x4f, 0x53, 0x54, 0x4e, 0x41, 0x4d, 0x45, 0x10,
-	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x4e, 0x55, 0x4d, 0x42,
-	0x45, 0x52, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a,
-	0x09, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
-	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4a,
-	0x4f, 0x42, 0x5f, 0x49, 0x44, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x55,
-	0x53, 0x45, 0x52, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x50, 0x41, 0x52,
-	0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44,
-	0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x10, 0x09, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x41, 0x52,
-	0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x0a, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52,
-	0x44, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x52,
-	0x49, 0x56, 0x45, 0x52, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0c, 0x12, 0x11,
-	0x0a, 0x0d, 0x56, 0x42, 0x49, 0x4f, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10,
-	0x0d, 0x2a, 0xe9, 0x23, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x4e, 0x4f, 0x44, 0x45,
-	0x53, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x01,
-	0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x02, 0x12,
-	0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50,
-	0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55,
-	0x5f, 0x4a, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52,
-	0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x4d,
-	0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52,
-	0x45, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x48, 0x42, 0x4d, 0x5f, 0x54,
-	0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10,
-	0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59,
-	0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x4d, 0x43, 0x5f, 0x41, 0x43,
-	0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f,
-	0x4d, 0x4d, 0x41, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x09, 0x12, 0x14,
-	0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x43, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49,
-	0x54, 0x59, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x50, 0x45, 0x47,
-	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47,
-	0x50, 0x55, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0c, 0x12, 0x13, 0x0a, 0x0f,
-	0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10,
-	0x0d, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f,
-	0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x43, 0x49,
-	0x45, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x49,
-	0x45, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x10, 0x12, 0x12, 0x0a,
-	0x0e, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10,
-	0x11, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x4e, 0x45, 0x52, 0x47, 0x59, 0x5f,
-	0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x44, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x43,
-	0x49, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10,
-	0x13, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45,
-	0x52, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x14, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x43,
-	0x49, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x56,
-	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x15, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x43,
-	0x49, 0x45, 0x5f, 0x4e, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x55,
-	0x4e, 0x54, 0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4e, 0x41, 0x43,
-	0x4b, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
-	0x10, 0x17, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
-	0x18, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55,
-	0x53, 0x41, 0x47, 0x45, 0x10, 0x19, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f,
-	0x54, 0x41, 0x4c, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x1a, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50,
-	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x4f,
-	0x54, 0x41, 0x4c, 0x10, 0x1b, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
-	0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c,
-	0x10, 0x1c, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
-	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x10, 0x1d, 0x12, 0x1a, 0x0a, 0x16,
-	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
-	0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x10, 0x1e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f,
-	0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x47, 0x46, 0x58, 0x10,
-	0x1f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43,
-	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x47, 0x46, 0x58, 0x10, 0x20, 0x12, 0x19, 0x0a, 0x15,
-	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x21, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4d, 0x48,
-	0x55, 0x42, 0x10, 0x22, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x23, 0x12,
-	0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52,
-	0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x24, 0x12, 0x17, 0x0a, 0x13,
-	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x42, 0x49, 0x46, 0x10, 0x25, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
-	0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x26,
-	0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52,
-	0x45, 0x43, 0x54, 0x5f, 0x48, 0x44, 0x50, 0x10, 0x27, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55,
-	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x48,
-	0x44, 0x50, 0x10, 0x28, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41, 0x46,
-	0x4c, 0x10, 0x29, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
-	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41,
-	0x46, 0x4c, 0x10, 0x2a, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x46, 0x10, 0x2b, 0x12, 0x18, 0x0a, 0x14,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5c, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e,
+	0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f,
+	0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a,
+	0x13, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x5c, 0x0a, 0x14, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x1d, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x41, 0x73,
+	0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x1d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x6f, 0x77, 0x6e, 0x41, 0x73, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x22, 0xf1,
+	0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x3e, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x47, 0x50, 0x55, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x41, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x3e, 0x0a, 0x09, 0x4e, 0x49, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x4e, 0x49, 0x43, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2a, 0xed, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x4f, 0x53, 0x54, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x4e, 0x55, 0x4d,
+	0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x0d,
+	0x0a, 0x09, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a,
+	0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06,
+	0x4a, 0x4f, 0x42, 0x5f, 0x49, 0x44, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x42, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52,
+	0x44, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x10, 0x09, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x41,
+	0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x0a, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41,
+	0x52, 0x44, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x44,
+	0x52, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0c, 0x12,
+	0x11, 0x0a, 0x0d, 0x56, 0x42, 0x49, 0x4f, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x0d, 0x2a, 0x8e, 0x24, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x4e, 0x4f, 0x44,
+	0x45, 0x53, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10,
+	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45,
+	0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x02,
+	0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x54, 0x45, 0x4d,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50,
+	0x55, 0x5f, 0x4a, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f,
+	0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x48, 0x42, 0x4d, 0x5f,
+	0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a,
+	0x10, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
+	0x59, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x4d, 0x43, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55,
+	0x5f, 0x4d, 0x4d, 0x41, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x09, 0x12,
+	0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x43, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56,
+	0x49, 0x54, 0x59, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x50, 0x45,
+	0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b,
+	0x47, 0x50, 0x55, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0c, 0x12, 0x13, 0x0a,
+	0x0f, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45,
+	0x10, 0x0d, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59,
+	0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x43,
+	0x49, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43,
+	0x49, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x10, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48,
+	0x10, 0x11, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x4e, 0x45, 0x52, 0x47, 0x59,
+	0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x44, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x50,
+	0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x10, 0x13, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56,
+	0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x14, 0x12, 0x1e, 0x0a, 0x1a, 0x50,
+	0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f,
+	0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x15, 0x12, 0x18, 0x0a, 0x14, 0x50,
+	0x43, 0x49, 0x45, 0x5f, 0x4e, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4e, 0x41,
+	0x43, 0x4b, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x10, 0x17, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x18, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f,
+	0x55, 0x53, 0x41, 0x47, 0x45, 0x10, 0x19, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x5f, 0x54,
+	0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x1a, 0x12, 0x19, 0x0a, 0x15, 0x47,
+	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54,
+	0x4f, 0x54, 0x41, 0x4c, 0x10, 0x1b, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
+	0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41,
+	0x4c, 0x10, 0x1c, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43,
+	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x10, 0x1d, 0x12, 0x1a, 0x0a,
+	0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
+	0x43, 0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x10, 0x1e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x47, 0x46, 0x58,
+	0x10, 0x1f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e,
+	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x47, 0x46, 0x58, 0x10, 0x20, 0x12, 0x19, 0x0a,
+	0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
+	0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x21, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4d,
+	0x48, 0x55, 0x42, 0x10, 0x22, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x23,
+	0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x24, 0x12, 0x17, 0x0a,
+	0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
+	0x5f, 0x42, 0x49, 0x46, 0x10, 0x25, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
+	0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x49, 0x46, 0x10,
+	0x26, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52,
+	0x52, 0x45, 0x43, 0x54, 0x5f, 0x48, 0x44, 0x50, 0x10, 0x27, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50,
+	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
+	0x48, 0x44, 0x50, 0x10, 0x28, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41,
+	0x46, 0x4c, 0x10, 0x29, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
+	0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57,
+	0x41, 0x46, 0x4c, 0x10, 0x2a, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x46, 0x10, 0x2b, 0x12, 0x18, 0x0a,
+	0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
+	0x43, 0x54, 0x5f, 0x44, 0x46, 0x10, 0x2c, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45,
+	0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x2d,
+	0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x2e, 0x12, 0x17, 0x0a, 0x13, 0x47,
+	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53,
+	0x45, 0x4d, 0x10, 0x2f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
+	0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x4d, 0x10, 0x30, 0x12,
+	0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45,
+	0x43, 0x54, 0x5f, 0x4d, 0x50, 0x30, 0x10, 0x31, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50,
+	0x30, 0x10, 0x32, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43,
+	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x31, 0x10, 0x33, 0x12, 0x19, 0x0a, 0x15,
 	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
-	0x54, 0x5f, 0x44, 0x46, 0x10, 0x2c, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
-	0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x2d, 0x12,
-	0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52,
-	0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x2e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50,
-	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x45,
-	0x4d, 0x10, 0x2f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
-	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x4d, 0x10, 0x30, 0x12, 0x17,
-	0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
-	0x54, 0x5f, 0x4d, 0x50, 0x30, 0x10, 0x31, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x30,
-	0x10, 0x32, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
-	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x31, 0x10, 0x33, 0x12, 0x19, 0x0a, 0x15, 0x47,
-	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
-	0x5f, 0x4d, 0x50, 0x31, 0x10, 0x34, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
-	0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x35,
-	0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f,
-	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x36, 0x12, 0x17, 0x0a, 0x13,
-	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x55, 0x4d, 0x43, 0x10, 0x37, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
-	0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4d, 0x43, 0x10, 0x38,
+	0x54, 0x5f, 0x4d, 0x50, 0x31, 0x10, 0x34, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45,
+	0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10,
+	0x35, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43,
+	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x36, 0x12, 0x17, 0x0a,
+	0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
+	0x5f, 0x55, 0x4d, 0x43, 0x10, 0x37, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
+	0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4d, 0x43, 0x10,
+	0x38, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42,
+	0x52, 0x5f, 0x30, 0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x39, 0x12, 0x19, 0x0a, 0x15,
+	0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x52,
+	0x45, 0x51, 0x5f, 0x54, 0x58, 0x10, 0x3a, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x58,
+	0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54,
+	0x58, 0x10, 0x3b, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f,
+	0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x42, 0x45, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x10, 0x3c,
 	0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52,
-	0x5f, 0x30, 0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x39, 0x12, 0x19, 0x0a, 0x15, 0x47,
-	0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x52, 0x45,
-	0x51, 0x5f, 0x54, 0x58, 0x10, 0x3a, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47,
-	0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58,
-	0x10, 0x3b, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e,
-	0x42, 0x52, 0x5f, 0x30, 0x5f, 0x42, 0x45, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x10, 0x3c, 0x12,
-	0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f,
-	0x31, 0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3d, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50,
-	0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x52, 0x45, 0x51,
-	0x5f, 0x54, 0x58, 0x10, 0x3e, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d,
-	0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x10,
-	0x3f, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42,
-	0x52, 0x5f, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x10, 0x40, 0x12, 0x1c,
-	0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30,
-	0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x41, 0x12, 0x1c, 0x0a, 0x18,
-	0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x54,
-	0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x42, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50,
-	0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x32, 0x5f, 0x54, 0x58, 0x5f,
-	0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x43, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f,
-	0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x33, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48,
-	0x52, 0x50, 0x55, 0x54, 0x10, 0x44, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47,
-	0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x34, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50,
-	0x55, 0x54, 0x10, 0x45, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49,
-	0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x35, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54,
-	0x10, 0x46, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x56,
-	0x52, 0x41, 0x4d, 0x10, 0x47, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45,
-	0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x48, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f,
-	0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52,
-	0x41, 0x4d, 0x10, 0x49, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44,
-	0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x4a, 0x12,
-	0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49,
-	0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x4b, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50,
-	0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4c, 0x12, 0x10, 0x0a,
-	0x0c, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4d, 0x12,
-	0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x47, 0x54, 0x54, 0x10,
-	0x4e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52,
-	0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x43, 0x41, 0x10, 0x4f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50,
-	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x4d, 0x43, 0x41, 0x10, 0x50, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
-	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x51, 0x12, 0x19,
-	0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52,
-	0x45, 0x43, 0x54, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x52, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55,
-	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x50, 0x45,
-	0x47, 0x10, 0x53, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
-	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x54, 0x12,
-	0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45,
-	0x43, 0x54, 0x5f, 0x49, 0x48, 0x10, 0x55, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x48, 0x10,
-	0x56, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52,
-	0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x57, 0x12, 0x1a, 0x0a, 0x16, 0x47,
+	0x5f, 0x31, 0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3d, 0x12, 0x19, 0x0a, 0x15, 0x47,
+	0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x52, 0x45,
+	0x51, 0x5f, 0x54, 0x58, 0x10, 0x3e, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47,
+	0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58,
+	0x10, 0x3f, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e,
+	0x42, 0x52, 0x5f, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x10, 0x40, 0x12,
+	0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f,
+	0x30, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x41, 0x12, 0x1c, 0x0a,
+	0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f,
+	0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x42, 0x12, 0x1c, 0x0a, 0x18, 0x47,
+	0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x32, 0x5f, 0x54, 0x58,
+	0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x43, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55,
+	0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x33, 0x5f, 0x54, 0x58, 0x5f, 0x54,
+	0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x44, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58,
+	0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x34, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52,
+	0x50, 0x55, 0x54, 0x10, 0x45, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d,
+	0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x35, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55,
+	0x54, 0x10, 0x46, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f,
+	0x56, 0x52, 0x41, 0x4d, 0x10, 0x47, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52,
+	0x45, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x48, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55,
+	0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56,
+	0x52, 0x41, 0x4d, 0x10, 0x49, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45,
+	0x44, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x4a,
+	0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x49, 0x53,
+	0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x4b, 0x12, 0x11, 0x0a, 0x0d, 0x47,
+	0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4c, 0x12, 0x10,
+	0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4d,
+	0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x47, 0x54, 0x54,
+	0x10, 0x4e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x43, 0x41, 0x10, 0x4f, 0x12, 0x19, 0x0a, 0x15, 0x47,
 	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
-	0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x58, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x50, 0x55, 0x5f, 0x48,
-	0x45, 0x41, 0x4c, 0x54, 0x48, 0x10, 0x59, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x58,
-	0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x58, 0x10, 0x5a, 0x12, 0x14, 0x0a,
-	0x10, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54,
-	0x58, 0x10, 0x5b, 0x12, 0x2d, 0x0a, 0x29, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x43,
-	0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52,
-	0x10, 0x5c, 0x12, 0x35, 0x0a, 0x31, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x4f, 0x52, 0x5f, 0x48, 0x4f,
-	0x54, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55,
-	0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5d, 0x12, 0x2b, 0x0a, 0x27, 0x47, 0x50, 0x55,
-	0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x50, 0x54, 0x5f, 0x52,
-	0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c,
-	0x41, 0x54, 0x45, 0x44, 0x10, 0x5e, 0x12, 0x36, 0x0a, 0x32, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49,
-	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54,
-	0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59,
-	0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5f, 0x12, 0x32,
-	0x0a, 0x2e, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x56, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44,
-	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x60, 0x12, 0x33, 0x0a, 0x2f, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x42, 0x4d, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f,
+	0x5f, 0x4d, 0x43, 0x41, 0x10, 0x50, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
+	0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x51, 0x12,
+	0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52,
+	0x52, 0x45, 0x43, 0x54, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x52, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50,
+	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x50,
+	0x45, 0x47, 0x10, 0x53, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
+	0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x54,
+	0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x5f, 0x49, 0x48, 0x10, 0x55, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x48,
+	0x10, 0x56, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x57, 0x12, 0x1a, 0x0a, 0x16,
+	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
+	0x54, 0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x58, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x50, 0x55, 0x5f,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x10, 0x59, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f,
+	0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x58, 0x10, 0x5a, 0x12, 0x14,
+	0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x54, 0x58, 0x10, 0x5b, 0x12, 0x2d, 0x0a, 0x29, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43,
+	0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45,
+	0x52, 0x10, 0x5c, 0x12, 0x35, 0x0a, 0x31, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x4f, 0x52, 0x5f, 0x48,
+	0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43,
+	0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5d, 0x12, 0x2b, 0x0a, 0x27, 0x47, 0x50,
+	0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x50, 0x54, 0x5f,
 	0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55,
-	0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x61, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x47,
-	0x46, 0x58, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41,
-	0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x62, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x56,
-	0x43, 0x4e, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41,
-	0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x63, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x4a,
-	0x50, 0x45, 0x47, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54,
-	0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x43, 0x49, 0x45,
-	0x5f, 0x52, 0x58, 0x10, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x54, 0x58,
-	0x10, 0x66, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x42, 0x49, 0x44, 0x49, 0x52,
-	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44,
-	0x54, 0x48, 0x10, 0x67, 0x12, 0x1d, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x47, 0x52, 0x42, 0x4d, 0x5f, 0x47, 0x55, 0x49, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
-	0x10, 0xa1, 0x06, 0x12, 0x16, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
-	0x53, 0x51, 0x5f, 0x57, 0x41, 0x56, 0x45, 0x53, 0x10, 0xa2, 0x06, 0x12, 0x18, 0x0a, 0x13, 0x47,
-	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x52, 0x42, 0x4d, 0x5f, 0x43, 0x4f, 0x55,
-	0x4e, 0x54, 0x10, 0xa3, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x42,
-	0x55, 0x53, 0x59, 0x10, 0xa4, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f,
-	0x49, 0x44, 0x4c, 0x45, 0x10, 0xa5, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xa6, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x43,
-	0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa7, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54,
-	0x43, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xa8, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47,
+	0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5e, 0x12, 0x36, 0x0a, 0x32, 0x47, 0x50, 0x55, 0x5f, 0x56,
+	0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f,
+	0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43,
+	0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5f, 0x12,
+	0x32, 0x0a, 0x2e, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x56, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49,
+	0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x60, 0x12, 0x33, 0x0a, 0x2f, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x42, 0x4d, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c,
+	0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d,
+	0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x61, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f,
+	0x47, 0x46, 0x58, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54,
+	0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x62, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f,
+	0x56, 0x43, 0x4e, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54,
+	0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x63, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f,
+	0x4a, 0x50, 0x45, 0x47, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e,
+	0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x43, 0x49,
+	0x45, 0x5f, 0x52, 0x58, 0x10, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x54,
+	0x58, 0x10, 0x66, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x42, 0x49, 0x44, 0x49,
+	0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49,
+	0x44, 0x54, 0x48, 0x10, 0x67, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x41, 0x4e,
+	0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x68, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f,
+	0x44, 0x41, 0x54, 0x41, 0x5f, 0x41, 0x47, 0x45, 0x10, 0x69, 0x12, 0x1d, 0x0a, 0x18, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x52, 0x42, 0x4d, 0x5f, 0x47, 0x55, 0x49, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0xa1, 0x06, 0x12, 0x16, 0x0a, 0x11, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53, 0x51, 0x5f, 0x57, 0x41, 0x56, 0x45, 0x53, 0x10, 0xa2,
+	0x06, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x52,
+	0x42, 0x4d, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xa3, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47,
 	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f,
-	0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa9, 0x06, 0x12,
-	0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
-	0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45,
-	0x10, 0xaa, 0x06, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
-	0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f,
-	0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xab, 0x06, 0x12, 0x2c, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d, 0x45, 0x31, 0x5f, 0x42, 0x55, 0x53,
-	0x59, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x43,
-	0x4f, 0x44, 0x45, 0x10, 0xac, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d, 0x45, 0x31, 0x5f, 0x44, 0x43, 0x30, 0x5f, 0x53,
-	0x50, 0x49, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xad, 0x06, 0x12, 0x2c, 0x0a, 0x27, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x31,
-	0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xae, 0x06, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x5f,
-	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xaf, 0x06, 0x12, 0x2b, 0x0a, 0x26, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x56, 0x41,
-	0x49, 0x4c, 0x10, 0xb0, 0x06, 0x12, 0x29, 0x0a, 0x24, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54,
-	0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0xb1, 0x06,
-	0x12, 0x25, 0x0a, 0x20, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43,
-	0x5f, 0x41, 0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b,
-	0x5f, 0x45, 0x4e, 0x44, 0x10, 0xb2, 0x06, 0x12, 0x26, 0x0a, 0x21, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46,
-	0x4f, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x10, 0xb3, 0x06, 0x12,
-	0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0xb4,
-	0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
-	0x43, 0x5f, 0x47, 0x44, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xb5, 0x06, 0x12, 0x19, 0x0a, 0x14,
-	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x47, 0x5f,
-	0x53, 0x45, 0x4e, 0x44, 0x10, 0xb6, 0x06, 0x12, 0x21, 0x0a, 0x1c, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x57, 0x41, 0x4c, 0x4b, 0x5f, 0x4e, 0x45, 0x58,
-	0x54, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x10, 0xb7, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c,
-	0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x30, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xb8, 0x06,
+	0x53, 0x54, 0x41, 0x54, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa4, 0x06, 0x12, 0x1f, 0x0a, 0x1a,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xa5, 0x06, 0x12, 0x20, 0x0a,
+	0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xa6, 0x06, 0x12,
+	0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
+	0x43, 0x50, 0x43, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa7, 0x06,
+	0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43,
+	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xa8,
+	0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x42, 0x55,
+	0x53, 0x59, 0x10, 0xa9, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
+	0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49,
+	0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xaa, 0x06, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54,
+	0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xab, 0x06, 0x12, 0x2c,
+	0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d,
+	0x45, 0x31, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x43, 0x4b,
+	0x45, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x4f, 0x44, 0x45, 0x10, 0xac, 0x06, 0x12, 0x22, 0x0a, 0x1d,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d, 0x45, 0x31,
+	0x5f, 0x44, 0x43, 0x30, 0x5f, 0x53, 0x50, 0x49, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xad, 0x06,
+	0x12, 0x2c, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43,
+	0x5f, 0x55, 0x54, 0x43, 0x4c, 0x31, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e, 0x5f,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xae, 0x06, 0x12, 0x1e,
+	0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41,
+	0x4c, 0x57, 0x41, 0x59, 0x53, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xaf, 0x06, 0x12, 0x2b,
+	0x0a, 0x26, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41,
+	0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x10, 0xb0, 0x06, 0x12, 0x29, 0x0a, 0x24, 0x47,
+	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f,
+	0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x5f, 0x44,
+	0x4f, 0x4e, 0x45, 0x10, 0xb1, 0x06, 0x12, 0x25, 0x0a, 0x20, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
+	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0xb2, 0x06, 0x12, 0x26, 0x0a,
+	0x21, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x10, 0xb3, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
+	0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f,
+	0x46, 0x55, 0x4c, 0x4c, 0x10, 0xb4, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x47, 0x44, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10,
+	0xb5, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
+	0x50, 0x43, 0x5f, 0x54, 0x47, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0xb6, 0x06, 0x12, 0x21, 0x0a,
+	0x1c, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x57, 0x41,
+	0x4c, 0x4b, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x10, 0xb7, 0x06,
 	0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43,
-	0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x31, 0x5f,
-	0x53, 0x50, 0x49, 0x10, 0xb9, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x30, 0x5f,
+	0x53, 0x50, 0x49, 0x10, 0xb8, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
 	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42,
-	0x59, 0x5f, 0x53, 0x45, 0x32, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xba, 0x06, 0x12, 0x24, 0x0a, 0x1f,
+	0x59, 0x5f, 0x53, 0x45, 0x31, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xb9, 0x06, 0x12, 0x24, 0x0a, 0x1f,
 	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41,
-	0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x33, 0x5f, 0x53, 0x50, 0x49, 0x10,
-	0xbb, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
-	0x50, 0x43, 0x5f, 0x4c, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0xbc, 0x06, 0x12, 0x26, 0x0a,
-	0x21, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x5f, 0x57, 0x52, 0x52, 0x45, 0x51, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f, 0x42, 0x55,
-	0x53, 0x59, 0x10, 0xbd, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10,
-	0xbe, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
-	0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xbf, 0x06,
-	0x12, 0x30, 0x0a, 0x2b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46,
-	0x5f, 0x43, 0x4d, 0x50, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x31, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c,
-	0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0xc0, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
-	0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x42, 0x55, 0x53, 0x59,
-	0x10, 0xc1, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
-	0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x4c,
-	0x45, 0x10, 0xc2, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54,
-	0x41, 0x4c, 0x4c, 0x10, 0xc3, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f,
-	0x42, 0x55, 0x53, 0x59, 0x10, 0xc4, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55,
-	0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xc5, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x54, 0x43, 0x49,
-	0x55, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc6, 0x06, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x49, 0x5a,
-	0x45, 0x10, 0xe8, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x10, 0xe9, 0x07, 0x12, 0x1a,
-	0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c,
-	0x5f, 0x31, 0x36, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xea, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x33, 0x32, 0x5f,
-	0x4f, 0x50, 0x53, 0x10, 0xeb, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x36, 0x34, 0x5f, 0x4f, 0x50, 0x53, 0x10,
-	0xec, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47,
-	0x55, 0x49, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10,
-	0xed, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f,
-	0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54,
-	0x10, 0xee, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
-	0x54, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x50, 0x45,
-	0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xef, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x5f, 0x50, 0x49, 0x50, 0x45, 0x5f, 0x49,
-	0x53, 0x53, 0x55, 0x45, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x10, 0xf0, 0x07, 0x12, 0x17, 0x0a, 0x12,
-	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53, 0x4d, 0x5f, 0x41, 0x43, 0x54, 0x49,
-	0x56, 0x45, 0x10, 0xf1, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x4c, 0x41, 0x50,
-	0x53, 0x45, 0x44, 0x10, 0xf2, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52,
-	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x55, 0x10, 0xf3, 0x07, 0x12, 0x1e, 0x0a,
-	0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41,
-	0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x43, 0x55, 0x10, 0xf4, 0x07, 0x12, 0x1e, 0x0a,
-	0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53, 0x49, 0x4d, 0x44, 0x5f, 0x55,
-	0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xf5, 0x07, 0x2a, 0xa8, 0x01,
-	0x0a, 0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x12, 0x0c, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x47, 0x50, 0x55, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x10, 0x02,
-	0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x55, 0x54, 0x45, 0x5f,
-	0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03,
-	0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50,
-	0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x04, 0x12,
-	0x12, 0x0a, 0x0e, 0x4b, 0x46, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x49,
-	0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x06, 0x2a, 0xee, 0x33, 0x0a, 0x0e, 0x4e, 0x49, 0x43,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x49, 0x43, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x49,
-	0x43, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a,
-	0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
-	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x20,
-	0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03,
+	0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x32, 0x5f, 0x53, 0x50, 0x49, 0x10,
+	0xba, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
+	0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45,
+	0x33, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xbb, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4c, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x4c,
+	0x10, 0xbc, 0x06, 0x12, 0x26, 0x0a, 0x21, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
+	0x43, 0x50, 0x43, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x57, 0x52, 0x52, 0x45, 0x51, 0x5f, 0x46,
+	0x49, 0x46, 0x4f, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xbd, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x47,
+	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45,
+	0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xbe, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x4c, 0x4c, 0x10, 0xbf, 0x06, 0x12, 0x30, 0x0a, 0x2b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
+	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x4d, 0x50, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x31,
+	0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc0, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xc1, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xc2, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc3, 0x06, 0x12, 0x1f, 0x0a, 0x1a,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46,
+	0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xc4, 0x06, 0x12, 0x1f, 0x0a,
+	0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50,
+	0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xc5, 0x06, 0x12, 0x20,
+	0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43,
+	0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc6, 0x06,
+	0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x46, 0x45, 0x54,
+	0x43, 0x48, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x10, 0xe8, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x49, 0x5a,
+	0x45, 0x10, 0xe9, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x31, 0x36, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xea, 0x07,
+	0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54,
+	0x41, 0x4c, 0x5f, 0x33, 0x32, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xeb, 0x07, 0x12, 0x1a, 0x0a, 0x15,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x36,
+	0x34, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xec, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x55, 0x49, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x5f, 0x50, 0x45,
+	0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xed, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xee, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xef, 0x07, 0x12, 0x22,
+	0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x5f,
+	0x50, 0x49, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x10,
+	0xf0, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53,
+	0x4d, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0xf1, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47,
+	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43,
+	0x59, 0x5f, 0x45, 0x4c, 0x41, 0x50, 0x53, 0x45, 0x44, 0x10, 0xf2, 0x07, 0x12, 0x25, 0x0a, 0x20,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e,
+	0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x55,
+	0x10, 0xf3, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
+	0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x43, 0x55,
+	0x10, 0xf4, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
+	0x53, 0x49, 0x4d, 0x44, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0xf5, 0x07, 0x2a, 0x9c, 0x02, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x55,
+	0x49, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x50, 0x55, 0x5f, 0x49, 0x44, 0x10, 0x01,
+	0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x4f,
+	0x4d, 0x50, 0x55, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45,
+	0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x4b, 0x46, 0x44, 0x5f, 0x50, 0x52, 0x4f,
+	0x43, 0x45, 0x53, 0x53, 0x5f, 0x49, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x45, 0x50,
+	0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x06, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x49, 0x44, 0x10, 0x07, 0x12,
+	0x14, 0x0a, 0x10, 0x4a, 0x4f, 0x42, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x4a, 0x4f, 0x42,
+	0x5f, 0x49, 0x44, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x41, 0x52, 0x52,
+	0x41, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x49, 0x44, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10,
+	0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x45, 0x5f, 0x50, 0x52, 0x4f,
+	0x4a, 0x45, 0x43, 0x54, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x10, 0x0c, 0x2a, 0xee, 0x33, 0x0a, 0x0e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x49, 0x43, 0x5f, 0x54, 0x4f, 0x54,
+	0x41, 0x4c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x49, 0x43, 0x5f, 0x4d, 0x41, 0x58, 0x5f,
+	0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
+	0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49,
+	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x46, 0x43, 0x53, 0x10, 0x04,
 	0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
 	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44,
-	0x5f, 0x46, 0x43, 0x53, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x05, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
 	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x05, 0x12, 0x22, 0x0a, 0x1e,
+	0x52, 0x58, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x06, 0x12, 0x27, 0x0a, 0x23, 0x4e, 0x49,
+	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54,
+	0x48, 0x10, 0x07, 0x12, 0x27, 0x0a, 0x23, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f,
+	0x55, 0x4e, 0x44, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x08, 0x12, 0x26, 0x0a, 0x22,
 	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x06,
-	0x12, 0x27, 0x0a, 0x23, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44,
-	0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x07, 0x12, 0x27, 0x0a, 0x23, 0x4e, 0x49, 0x43,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x5a,
+	0x45, 0x44, 0x10, 0x09, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x0a, 0x12, 0x23, 0x0a, 0x1f,
+	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4a, 0x41, 0x42, 0x42, 0x45, 0x52, 0x10,
+	0x0b, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52,
+	0x49, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x0c, 0x12, 0x28, 0x0a, 0x24, 0x4e, 0x49, 0x43, 0x5f,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x53, 0x54, 0x4f, 0x4d, 0x50, 0x45, 0x44, 0x5f, 0x43, 0x52, 0x43,
+	0x10, 0x0d, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x54,
+	0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x0e, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43,
 	0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x44,
-	0x10, 0x08, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f,
-	0x56, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x09, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49,
+	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x0f, 0x12,
+	0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x10,
+	0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c,
+	0x10, 0x11, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42,
+	0x41, 0x44, 0x10, 0x12, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
+	0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x13, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x14, 0x12,
+	0x2a, 0x0a, 0x26, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x45, 0x53, 0x53,
+	0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x36, 0x34, 0x42, 0x10, 0x15, 0x12, 0x26, 0x0a, 0x22, 0x4e,
+	0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x16, 0x12, 0x29, 0x0a, 0x25, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x53, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x17, 0x12, 0x2a,
+	0x0a, 0x26, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x52, 0x53, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x48, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c,
+	0x5f, 0x45, 0x52, 0x52, 0x5f, 0x43, 0x4e, 0x54, 0x10, 0x18, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49,
 	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x53,
-	0x10, 0x0a, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4a,
-	0x41, 0x42, 0x42, 0x45, 0x52, 0x10, 0x0b, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f, 0x50,
-	0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
-	0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x0c, 0x12, 0x28,
-	0x0a, 0x24, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x53, 0x54, 0x4f, 0x4d, 0x50,
-	0x45, 0x44, 0x5f, 0x43, 0x52, 0x43, 0x10, 0x0d, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x19,
+	0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c,
+	0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x1a, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f,
 	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x0e, 0x12,
-	0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x50, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54,
-	0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x10, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
-	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x11, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x1b,
+	0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49,
+	0x5f, 0x30, 0x10, 0x1c, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x31, 0x10, 0x1d, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f,
 	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x10, 0x12, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
-	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x13, 0x12, 0x25,
-	0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41,
-	0x55, 0x53, 0x45, 0x10, 0x14, 0x12, 0x2a, 0x0a, 0x26, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54,
-	0x58, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x36, 0x34, 0x42, 0x10,
-	0x15, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x52,
-	0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x16, 0x12, 0x29, 0x0a, 0x25, 0x4e, 0x49, 0x43,
-	0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x53, 0x46, 0x45,
-	0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x57, 0x4f,
-	0x52, 0x44, 0x10, 0x17, 0x12, 0x2a, 0x0a, 0x26, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x53, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x48, 0x5f,
-	0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x43, 0x4e, 0x54, 0x10, 0x18,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32, 0x10, 0x1e, 0x12, 0x22, 0x0a, 0x1e,
+	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x33, 0x10, 0x1f,
+	0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49,
+	0x5f, 0x34, 0x10, 0x20, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x35, 0x10, 0x21, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36, 0x10, 0x22, 0x12, 0x22, 0x0a, 0x1e,
+	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x37, 0x10, 0x23,
 	0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49,
-	0x43, 0x41, 0x53, 0x54, 0x10, 0x19, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49,
+	0x43, 0x41, 0x53, 0x54, 0x10, 0x24, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
 	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x1a, 0x12, 0x26,
+	0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x25, 0x12, 0x26,
 	0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44,
-	0x43, 0x41, 0x53, 0x54, 0x10, 0x1b, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44,
+	0x43, 0x41, 0x53, 0x54, 0x10, 0x26, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
 	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x1c, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
+	0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x27, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
 	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x31, 0x10, 0x1d, 0x12, 0x22,
+	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x31, 0x10, 0x28, 0x12, 0x22,
 	0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32,
-	0x10, 0x1e, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50,
-	0x52, 0x49, 0x5f, 0x33, 0x10, 0x1f, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32,
+	0x10, 0x29, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50,
+	0x52, 0x49, 0x5f, 0x33, 0x10, 0x2a, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
 	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x34, 0x10, 0x20, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
+	0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x34, 0x10, 0x2b, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
 	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x35, 0x10, 0x21, 0x12, 0x22,
+	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x35, 0x10, 0x2c, 0x12, 0x22,
 	0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36,
-	0x10, 0x22, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50,
-	0x52, 0x49, 0x5f, 0x37, 0x10, 0x23, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
-	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x24, 0x12, 0x26, 0x0a, 0x22,
-	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41,
-	0x53, 0x54, 0x10, 0x25, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
-	0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x26, 0x12, 0x22, 0x0a, 0x1e,
-	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x27,
-	0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49,
-	0x5f, 0x31, 0x10, 0x28, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
-	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32, 0x10, 0x29, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f,
-	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x33, 0x10, 0x2a, 0x12, 0x22, 0x0a, 0x1e,
-	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x34, 0x10, 0x2b,
-	0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49,
-	0x5f, 0x35, 0x10, 0x2c, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
-	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36, 0x10, 0x2d, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f,
-	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x37, 0x10, 0x2e, 0x12, 0x1f, 0x0a, 0x1b,
-	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f,
-	0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x2f, 0x12, 0x20, 0x0a,
-	0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
-	0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x30, 0x12,
-	0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x31,
-	0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c,
-	0x10, 0x32, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50,
-	0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x64, 0x12, 0x29, 0x0a, 0x25, 0x4e, 0x49, 0x43, 0x5f,
-	0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36,
+	0x10, 0x2d, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50,
+	0x52, 0x49, 0x5f, 0x37, 0x10, 0x2e, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f,
+	0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x2f, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53,
+	0x5f, 0x52, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x30, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43,
+	0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45,
+	0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x31, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49,
+	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54,
+	0x45, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x32, 0x12, 0x24, 0x0a, 0x20,
+	0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53,
+	0x10, 0x64, 0x12, 0x29, 0x0a, 0x25, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x65, 0x12, 0x2b, 0x0a,
+	0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52,
+	0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x66, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49,
+	0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42,
+	0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41,
+	0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x67, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x4c,
+	0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x4d, 0x41, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x68, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f,
+	0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49,
+	0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x69, 0x12, 0x29,
+	0x0a, 0x25, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
+	0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f,
+	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x6a, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43,
+	0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x55,
+	0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43,
+	0x4b, 0x45, 0x54, 0x53, 0x10, 0x6b, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49,
+	0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44,
 	0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54,
-	0x53, 0x10, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53,
-	0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x66,
-	0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44,
-	0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x67, 0x12, 0x1f, 0x0a,
-	0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52,
-	0x58, 0x5f, 0x44, 0x4d, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x68, 0x12, 0x24,
-	0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45,
-	0x54, 0x53, 0x10, 0x69, 0x12, 0x29, 0x0a, 0x25, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54,
-	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x6a, 0x12,
-	0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52,
-	0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x6b, 0x12, 0x2b, 0x0a, 0x27,
-	0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58,
-	0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x6c, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43,
-	0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x44, 0x4d,
-	0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x6d, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x44,
-	0x4d, 0x41, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x4b, 0x54, 0x53,
-	0x10, 0xc8, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x54, 0x58, 0x5f, 0x43,
-	0x4e, 0x50, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xc9, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x44,
-	0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x4b, 0x54, 0x53,
-	0x10, 0xca, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x43,
-	0x4e, 0x50, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xcb, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x44,
-	0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xcc,
-	0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58,
-	0x5f, 0x50, 0x4b, 0x54, 0x5f, 0x53, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xcd, 0x01, 0x12,
-	0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x52,
-	0x4e, 0x52, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xce, 0x01, 0x12,
-	0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x52,
-	0x4d, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xcf, 0x01, 0x12, 0x1c, 0x0a,
-	0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x52, 0x4d, 0x54,
-	0x5f, 0x52, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd0, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52,
-	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0xd1, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52,
-	0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x5f, 0x4e, 0x41, 0x4b, 0x5f, 0x53,
-	0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd2, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x44, 0x4d,
-	0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xd3, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f,
-	0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0xd4, 0x01, 0x12,
-	0x19, 0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x44,
-	0x55, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0xd5, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44,
-	0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xd6, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x44, 0x4d,
-	0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xd7, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f,
-	0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10,
-	0xd8, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54,
-	0x58, 0x5f, 0x4d, 0x45, 0x4d, 0x5f, 0x4d, 0x47, 0x4d, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd9,
-	0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58,
-	0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x43, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10,
-	0xda, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54,
-	0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x53, 0x47, 0x4c, 0x5f, 0x49, 0x4e, 0x56, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xdb, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53,
-	0x50, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x55, 0x50, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
-	0x10, 0xdc, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50,
-	0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x55, 0x54, 0x4f, 0x46, 0x5f, 0x42, 0x55, 0x46, 0x10, 0xdd, 0x01,
-	0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58,
-	0x5f, 0x4f, 0x55, 0x54, 0x4f, 0x55, 0x46, 0x5f, 0x53, 0x45, 0x51, 0x10, 0xde, 0x01, 0x12, 0x19,
-	0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x43,
-	0x51, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xdf, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x44, 0x4d,
-	0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x46, 0x4c,
-	0x55, 0x53, 0x48, 0x10, 0xe0, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52,
-	0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x4c, 0x45, 0x4e, 0x5f, 0x45,
-	0x52, 0x52, 0x10, 0xe1, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45,
-	0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xe2, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41,
-	0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x4f, 0x50, 0x45,
-	0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe3, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41,
-	0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x55, 0x54, 0x4f, 0x46, 0x5f, 0x41,
-	0x54, 0x4f, 0x4d, 0x49, 0x43, 0x10, 0xe4, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41,
-	0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x4b, 0x54, 0x5f, 0x53, 0x45, 0x51,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0xe5, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x52, 0x44, 0x4d, 0x41, 0x5f,
-	0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41,
-	0x4c, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe6, 0x01, 0x12, 0x1d, 0x0a, 0x18,
-	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4d, 0x54,
-	0x5f, 0x41, 0x43, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe7, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52,
-	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f,
-	0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe8, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x52,
-	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f,
-	0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe9, 0x01, 0x12, 0x21, 0x0a, 0x1c,
-	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43,
-	0x5f, 0x53, 0x47, 0x4c, 0x5f, 0x49, 0x4e, 0x56, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xea, 0x01, 0x12,
-	0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f,
-	0x53, 0x30, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xeb, 0x01, 0x12,
-	0x1c, 0x0a, 0x17, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f,
-	0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xac, 0x02, 0x12, 0x28, 0x0a,
-	0x23, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55,
-	0x4d, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4d, 0x53, 0x47, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x52, 0x4b, 0x45, 0x10, 0xad, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x53, 0x51,
-	0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x41,
-	0x4c, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x53, 0x10, 0xae,
-	0x02, 0x12, 0x1d, 0x0a, 0x18, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54,
-	0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xaf, 0x02,
-	0x12, 0x22, 0x0a, 0x1d, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58,
-	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x5f, 0x53, 0x51, 0x5f, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x45,
-	0x44, 0x10, 0xb0, 0x02, 0x12, 0x1e, 0x0a, 0x19, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45,
-	0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x53, 0x45, 0x4e,
-	0x54, 0x10, 0xb1, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45,
-	0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10,
-	0xb2, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f,
-	0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0xb3, 0x02, 0x12,
-	0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43, 0x55, 0x52,
-	0x52, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0xb4,
-	0x02, 0x12, 0x27, 0x0a, 0x22, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e,
-	0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f,
-	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xb5, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50,
-	0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x49, 0x4d, 0x45,
-	0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xb6, 0x02, 0x12, 0x26, 0x0a, 0x21,
-	0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x4c,
-	0x50, 0x48, 0x41, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0xb7, 0x02, 0x12, 0x1b, 0x0a, 0x16, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43,
-	0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x52, 0x43, 0x56, 0x44, 0x10, 0xb8,
-	0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e,
-	0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44,
-	0x10, 0xb9, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50,
-	0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xba,
-	0x02, 0x12, 0x1b, 0x0a, 0x16, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54,
-	0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbb, 0x02, 0x12, 0x24,
-	0x0a, 0x1f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e,
-	0x55, 0x4d, 0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0xbc, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53,
-	0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x50, 0x5f, 0x42, 0x59, 0x54, 0x45,
-	0x5f, 0x54, 0x48, 0x52, 0x45, 0x53, 0x5f, 0x48, 0x49, 0x54, 0x10, 0xbd, 0x02, 0x12, 0x25, 0x0a,
-	0x20, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55,
-	0x4d, 0x5f, 0x52, 0x50, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x49,
-	0x54, 0x10, 0xbe, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53,
-	0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10,
-	0xbf, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f,
-	0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4d, 0x53, 0x47, 0x53,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x4b, 0x45, 0x10, 0xc0, 0x02, 0x12, 0x2b, 0x0a, 0x26,
-	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d,
+	0x53, 0x10, 0x6c, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x44, 0x4d, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x53, 0x10, 0x6d, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x54, 0x58, 0x5f,
+	0x55, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xc8, 0x01, 0x12, 0x15, 0x0a,
+	0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x54, 0x58, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x4b, 0x54,
+	0x53, 0x10, 0xc9, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f,
+	0x55, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xca, 0x01, 0x12, 0x15, 0x0a,
+	0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x4b, 0x54,
+	0x53, 0x10, 0xcb, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f,
+	0x45, 0x43, 0x4e, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xcc, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52,
+	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x4b, 0x54, 0x5f, 0x53,
+	0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xcd, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d,
+	0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x52, 0x45, 0x54,
+	0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xce, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d,
+	0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x41, 0x43, 0x43,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0xcf, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f,
+	0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xd0, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45,
+	0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd1, 0x01,
+	0x12, 0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f,
+	0x49, 0x4d, 0x50, 0x4c, 0x5f, 0x4e, 0x41, 0x4b, 0x5f, 0x53, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0xd2, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f,
+	0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd3, 0x01, 0x12, 0x1a, 0x0a,
+	0x15, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x51, 0x45,
+	0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0xd4, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x44, 0x4d,
+	0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x55, 0x50, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x10, 0xd5, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51,
+	0x5f, 0x52, 0x58, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x4b, 0x54, 0x53,
+	0x10, 0xd6, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f,
+	0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd7, 0x01, 0x12, 0x1d, 0x0a,
+	0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43,
+	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd8, 0x01, 0x12, 0x1d, 0x0a, 0x18,
+	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x45, 0x4d, 0x5f,
+	0x4d, 0x47, 0x4d, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd9, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x52,
+	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59,
+	0x5f, 0x45, 0x58, 0x43, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xda, 0x01, 0x12, 0x20, 0x0a, 0x1b,
+	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f,
+	0x53, 0x47, 0x4c, 0x5f, 0x49, 0x4e, 0x56, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xdb, 0x01, 0x12, 0x1d,
+	0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x44,
+	0x55, 0x50, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xdc, 0x01, 0x12, 0x1b, 0x0a,
+	0x16, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x55,
+	0x54, 0x4f, 0x46, 0x5f, 0x42, 0x55, 0x46, 0x10, 0xdd, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44,
+	0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x55, 0x54, 0x4f, 0x55,
+	0x46, 0x5f, 0x53, 0x45, 0x51, 0x10, 0xde, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41,
+	0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0xdf, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50,
+	0x5f, 0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0xe0, 0x01,
+	0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58,
+	0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x4c, 0x45, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe1, 0x01, 0x12,
+	0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10,
+	0xe2, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f,
+	0x52, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xe3, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f,
+	0x52, 0x58, 0x5f, 0x4f, 0x55, 0x54, 0x4f, 0x46, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x10,
+	0xe4, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f,
+	0x54, 0x58, 0x5f, 0x50, 0x4b, 0x54, 0x5f, 0x53, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe5,
+	0x01, 0x12, 0x23, 0x0a, 0x1e, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54,
+	0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xe6, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xe7, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xe8, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xe9, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x53, 0x47, 0x4c, 0x5f, 0x49,
+	0x4e, 0x56, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xea, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d,
+	0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x53, 0x30, 0x5f, 0x54, 0x41, 0x42,
+	0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xeb, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x51, 0x50, 0x5f,
+	0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41,
+	0x43, 0x4b, 0x45, 0x54, 0x10, 0xac, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x53, 0x51,
+	0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45, 0x4e, 0x44,
+	0x5f, 0x4d, 0x53, 0x47, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x4b, 0x45, 0x10, 0xad,
+	0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54,
+	0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x4b, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x53, 0x10, 0xae, 0x02, 0x12, 0x1d, 0x0a, 0x18, 0x51,
+	0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xaf, 0x02, 0x12, 0x22, 0x0a, 0x1d, 0x51, 0x50,
+	0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53,
+	0x5f, 0x53, 0x51, 0x5f, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x45, 0x44, 0x10, 0xb0, 0x02, 0x12, 0x1e,
+	0x0a, 0x19, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e,
+	0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0xb1, 0x02, 0x12, 0x1c,
+	0x0a, 0x17, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4e,
+	0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xb2, 0x02, 0x12, 0x2b, 0x0a, 0x26,
+	0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d,
 	0x5f, 0x50, 0x4b, 0x54, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x4d,
-	0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0xc1, 0x02, 0x12, 0x23, 0x0a, 0x1e, 0x51, 0x50, 0x5f,
-	0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e,
-	0x50, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0xc2, 0x02, 0x12, 0x2a,
-	0x0a, 0x25, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4d,
-	0x41, 0x58, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x52, 0x43, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44,
-	0x45, 0x44, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xc3, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50,
-	0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4d,
-	0x45, 0x4d, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x10, 0xc4, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53,
-	0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x57, 0x52, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4f, 0x50, 0x43, 0x10, 0xc5,
-	0x02, 0x12, 0x29, 0x0a, 0x24, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52,
-	0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f,
-	0x42, 0x41, 0x43, 0x4b, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x10, 0xc6, 0x02, 0x12, 0x2b, 0x0a, 0x26,
-	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d,
-	0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49,
-	0x43, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xc7, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f,
-	0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x5f, 0x42, 0x59, 0x54, 0x45,
-	0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0xc8, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x51,
-	0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54,
+	0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0xb3, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f,
+	0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x5f, 0x42, 0x59, 0x54, 0x45,
+	0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0xb4, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x51,
+	0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54,
 	0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0xc9, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43,
+	0x44, 0x10, 0xb5, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43,
 	0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49,
-	0x52, 0x45, 0x44, 0x10, 0xca, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f,
+	0x52, 0x45, 0x44, 0x10, 0xb6, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f,
 	0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x5f, 0x54, 0x49,
-	0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xcb, 0x02, 0x12, 0x1b,
-	0x0a, 0x16, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
-	0x43, 0x4e, 0x50, 0x5f, 0x52, 0x43, 0x56, 0x44, 0x10, 0xcc, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51,
-	0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50,
-	0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0xcd, 0x02, 0x12, 0x13, 0x0a,
-	0x0e, 0x45, 0x54, 0x48, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10,
-	0xf4, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x45, 0x54, 0x48, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x59, 0x54,
-	0x45, 0x53, 0x10, 0xf5, 0x03, 0x12, 0x13, 0x0a, 0x0e, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0xf6, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x45, 0x54,
-	0x48, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0xf7, 0x03, 0x12, 0x1c, 0x0a,
-	0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42,
-	0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0xf8, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45,
-	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c,
-	0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xf9, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44,
-	0x43, 0x41, 0x53, 0x54, 0x10, 0xfa, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41,
-	0x53, 0x54, 0x10, 0xfb, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0xfc, 0x03, 0x12,
-	0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
-	0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0xfd, 0x03, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x36, 0x34, 0x42, 0x10, 0xfe,
-	0x03, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x36, 0x35, 0x42, 0x5f, 0x31, 0x32, 0x37, 0x42, 0x10, 0xff, 0x03, 0x12, 0x1c,
-	0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f,
-	0x31, 0x32, 0x38, 0x42, 0x5f, 0x32, 0x35, 0x35, 0x42, 0x10, 0x80, 0x04, 0x12, 0x1c, 0x0a, 0x17,
-	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x35,
-	0x36, 0x42, 0x5f, 0x35, 0x31, 0x31, 0x42, 0x10, 0x81, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x54,
-	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x35, 0x31, 0x32, 0x42,
-	0x5f, 0x31, 0x30, 0x32, 0x33, 0x42, 0x10, 0x82, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x30, 0x32, 0x34, 0x42,
-	0x5f, 0x31, 0x35, 0x31, 0x38, 0x42, 0x10, 0x83, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x35, 0x31, 0x39, 0x42,
-	0x5f, 0x32, 0x30, 0x34, 0x37, 0x42, 0x10, 0x84, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x42,
-	0x5f, 0x34, 0x30, 0x39, 0x35, 0x42, 0x10, 0x85, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x34, 0x30, 0x39, 0x36, 0x42,
-	0x5f, 0x38, 0x31, 0x39, 0x31, 0x42, 0x10, 0x86, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x46,
-	0x43, 0x53, 0x10, 0x87, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x30, 0x10, 0x88, 0x04, 0x12, 0x17,
+	0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xb7, 0x02, 0x12, 0x1b,
+	0x0a, 0x16, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
+	0x43, 0x4e, 0x50, 0x5f, 0x52, 0x43, 0x56, 0x44, 0x10, 0xb8, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51,
+	0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50,
+	0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0xb9, 0x02, 0x12, 0x1c, 0x0a,
+	0x17, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55,
+	0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xba, 0x02, 0x12, 0x1b, 0x0a, 0x16, 0x51,
+	0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbb, 0x02, 0x12, 0x24, 0x0a, 0x1f, 0x51, 0x50, 0x5f, 0x52,
+	0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45, 0x51,
+	0x55, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbc, 0x02, 0x12, 0x27,
+	0x0a, 0x22, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e,
+	0x55, 0x4d, 0x5f, 0x52, 0x50, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53,
+	0x5f, 0x48, 0x49, 0x54, 0x10, 0xbd, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x51, 0x50, 0x5f, 0x52, 0x51,
+	0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x50, 0x5f, 0x4d,
+	0x41, 0x58, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x49, 0x54, 0x10, 0xbe, 0x02, 0x12, 0x1c,
+	0x0a, 0x17, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e,
+	0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xbf, 0x02, 0x12, 0x28, 0x0a, 0x23,
+	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d,
+	0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4d, 0x53, 0x47, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x52, 0x4b, 0x45, 0x10, 0xc0, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f,
+	0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47,
+	0x10, 0xc1, 0x02, 0x12, 0x23, 0x0a, 0x1e, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50,
+	0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x53, 0x5f, 0x52, 0x45, 0x43,
+	0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0xc2, 0x02, 0x12, 0x2a, 0x0a, 0x25, 0x51, 0x50, 0x5f, 0x52,
+	0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x45, 0x43,
+	0x49, 0x52, 0x43, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x10, 0xc3, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53,
+	0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4d, 0x45, 0x4d, 0x5f, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xc4, 0x02, 0x12, 0x2b,
+	0x0a, 0x26, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e,
+	0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x57, 0x52, 0x5f,
+	0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4f, 0x50, 0x43, 0x10, 0xc5, 0x02, 0x12, 0x29, 0x0a, 0x24, 0x51,
+	0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
+	0x44, 0x55, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x54, 0x52,
+	0x41, 0x43, 0x4b, 0x10, 0xc6, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f,
+	0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x10, 0xc7, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e,
+	0x5f, 0x43, 0x55, 0x52, 0x52, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x45, 0x52, 0x10, 0xc8, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51,
+	0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xc9, 0x02, 0x12, 0x20,
+	0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xca, 0x02,
+	0x12, 0x26, 0x0a, 0x21, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55,
+	0x4d, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xcb, 0x02, 0x12, 0x1b, 0x0a, 0x16, 0x51, 0x50, 0x5f, 0x52,
+	0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x52, 0x43,
+	0x56, 0x44, 0x10, 0xcc, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51,
+	0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45,
+	0x53, 0x53, 0x45, 0x44, 0x10, 0xcd, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x45, 0x54, 0x48, 0x5f, 0x54,
+	0x58, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0xf4, 0x03, 0x12, 0x11, 0x0a, 0x0c,
+	0x45, 0x54, 0x48, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0xf5, 0x03, 0x12,
+	0x13, 0x0a, 0x0e, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54,
+	0x53, 0x10, 0xf6, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x42,
+	0x59, 0x54, 0x45, 0x53, 0x10, 0xf7, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41,
+	0x53, 0x54, 0x10, 0xf8, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54,
+	0x10, 0xf9, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0xfa,
+	0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xfb, 0x03, 0x12,
+	0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0xfc, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45,
+	0x10, 0xfd, 0x03, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x36, 0x34, 0x42, 0x10, 0xfe, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x45,
+	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x36, 0x35, 0x42,
+	0x5f, 0x31, 0x32, 0x37, 0x42, 0x10, 0xff, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f,
+	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x32, 0x38, 0x42, 0x5f, 0x32,
+	0x35, 0x35, 0x42, 0x10, 0x80, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x35, 0x36, 0x42, 0x5f, 0x35, 0x31, 0x31,
+	0x42, 0x10, 0x81, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
+	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x35, 0x31, 0x32, 0x42, 0x5f, 0x31, 0x30, 0x32, 0x33, 0x42,
+	0x10, 0x82, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x30, 0x32, 0x34, 0x42, 0x5f, 0x31, 0x35, 0x31, 0x38, 0x42,
+	0x10, 0x83, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x35, 0x31, 0x39, 0x42, 0x5f, 0x32, 0x30, 0x34, 0x37, 0x42,
+	0x10, 0x84, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x42, 0x5f, 0x34, 0x30, 0x39, 0x35, 0x42,
+	0x10, 0x85, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x34, 0x30, 0x39, 0x36, 0x42, 0x5f, 0x38, 0x31, 0x39, 0x31, 0x42,
+	0x10, 0x86, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x46, 0x43, 0x53, 0x10, 0x87, 0x04, 0x12,
+	0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x50, 0x52, 0x49, 0x30, 0x10, 0x88, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f,
+	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x31, 0x10, 0x89,
+	0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x32, 0x10, 0x8a, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54,
+	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x33,
+	0x10, 0x8b, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x34, 0x10, 0x8c, 0x04, 0x12, 0x17, 0x0a, 0x12,
+	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52,
+	0x49, 0x35, 0x10, 0x8d, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x36, 0x10, 0x8e, 0x04, 0x12, 0x17,
 	0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f,
-	0x50, 0x52, 0x49, 0x31, 0x10, 0x89, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x32, 0x10, 0x8a, 0x04,
-	0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52,
-	0x58, 0x5f, 0x50, 0x52, 0x49, 0x33, 0x10, 0x8b, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x34, 0x10,
-	0x8c, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
-	0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x35, 0x10, 0x8d, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45,
-	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49,
-	0x36, 0x10, 0x8e, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x37, 0x10, 0x8f, 0x04, 0x12, 0x17, 0x0a,
+	0x50, 0x52, 0x49, 0x37, 0x10, 0x8f, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x30, 0x10, 0x90, 0x04,
+	0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54,
+	0x58, 0x5f, 0x50, 0x52, 0x49, 0x31, 0x10, 0x91, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x32, 0x10,
+	0x92, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
+	0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x33, 0x10, 0x93, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45,
+	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49,
+	0x34, 0x10, 0x94, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
+	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x35, 0x10, 0x95, 0x04, 0x12, 0x17, 0x0a,
 	0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50,
-	0x52, 0x49, 0x30, 0x10, 0x90, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
-	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x31, 0x10, 0x91, 0x04, 0x12,
-	0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
-	0x5f, 0x50, 0x52, 0x49, 0x32, 0x10, 0x92, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f,
-	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x33, 0x10, 0x93,
-	0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x34, 0x10, 0x94, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54,
-	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x35,
-	0x10, 0x95, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x36, 0x10, 0x96, 0x04, 0x12, 0x17, 0x0a, 0x12,
-	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52,
-	0x49, 0x37, 0x10, 0x97, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x98,
-	0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x99, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x41,
-	0x4c, 0x4c, 0x10, 0x9a, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x9b, 0x04, 0x12, 0x16, 0x0a,
-	0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42,
-	0x41, 0x44, 0x10, 0x9c, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x48, 0x57, 0x5f,
-	0x54, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x9d, 0x04, 0x12, 0x16, 0x0a,
-	0x11, 0x45, 0x54, 0x48, 0x5f, 0x48, 0x57, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50,
-	0x45, 0x44, 0x10, 0x9e, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f,
-	0x30, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x9f, 0x04, 0x12, 0x15, 0x0a, 0x10,
-	0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
-	0x10, 0xa0, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x5f,
-	0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa1, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54,
-	0x48, 0x5f, 0x52, 0x58, 0x5f, 0x33, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa2,
-	0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x34, 0x5f, 0x44, 0x52,
-	0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa3, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f,
-	0x52, 0x58, 0x5f, 0x35, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa4, 0x04, 0x12,
-	0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x36, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x50, 0x45, 0x44, 0x10, 0xa5, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58,
-	0x5f, 0x37, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa6, 0x04, 0x12, 0x15, 0x0a,
-	0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x38, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45,
-	0x44, 0x10, 0xa7, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x39,
-	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa8, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45,
-	0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x30, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
-	0x10, 0xa9, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x31,
-	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xaa, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45,
-	0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x32, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
-	0x10, 0xab, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x33,
-	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xac, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45,
-	0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x34, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
-	0x10, 0xad, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x35,
-	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xae, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45,
-	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10,
-	0xaf, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
-	0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xb0, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48,
-	0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xb1, 0x04,
-	0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54,
-	0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xb2, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x4f,
-	0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xb3,
-	0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xb4, 0x04, 0x12, 0x1a, 0x0a,
-	0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55,
-	0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xb5, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x38, 0x31, 0x39, 0x32, 0x42,
-	0x5f, 0x39, 0x32, 0x31, 0x35, 0x42, 0x10, 0xb6, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x38, 0x31, 0x39, 0x32, 0x42,
-	0x5f, 0x39, 0x32, 0x31, 0x35, 0x42, 0x10, 0xb7, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x36, 0x34, 0x42, 0x10, 0xb8,
-	0x04, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x36, 0x35, 0x42, 0x5f, 0x31, 0x32, 0x37, 0x42, 0x10, 0xb9, 0x04, 0x12, 0x1c,
-	0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f,
-	0x31, 0x32, 0x38, 0x42, 0x5f, 0x32, 0x35, 0x35, 0x42, 0x10, 0xba, 0x04, 0x12, 0x1c, 0x0a, 0x17,
-	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x32, 0x35,
-	0x36, 0x42, 0x5f, 0x35, 0x31, 0x31, 0x42, 0x10, 0xbb, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x54,
-	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x35, 0x31, 0x32, 0x42,
-	0x5f, 0x31, 0x30, 0x32, 0x33, 0x42, 0x10, 0xbc, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x30, 0x32, 0x34, 0x42,
-	0x5f, 0x31, 0x35, 0x31, 0x38, 0x42, 0x10, 0xbd, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x35, 0x31, 0x39, 0x42,
-	0x5f, 0x32, 0x30, 0x34, 0x37, 0x42, 0x10, 0xbe, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x42,
-	0x5f, 0x34, 0x30, 0x39, 0x35, 0x42, 0x10, 0xbf, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x34, 0x30, 0x39, 0x36, 0x42,
-	0x5f, 0x38, 0x31, 0x39, 0x31, 0x42, 0x10, 0xc0, 0x04, 0x2a, 0x2a, 0x0a, 0x0e, 0x4e, 0x49, 0x43,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x4e,
-	0x49, 0x43, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x49, 0x43,
-	0x5f, 0x49, 0x44, 0x10, 0x01, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x49, 0x36, 0x10, 0x96, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x37, 0x10, 0x97, 0x04, 0x12,
+	0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x98, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45,
+	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x41, 0x4c, 0x4c,
+	0x10, 0x99, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x9a, 0x04, 0x12,
+	0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
+	0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x9b, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x10, 0x9c, 0x04, 0x12,
+	0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x48, 0x57, 0x5f, 0x54, 0x58, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x50, 0x45, 0x44, 0x10, 0x9d, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x48,
+	0x57, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x9e, 0x04, 0x12,
+	0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x30, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x50, 0x45, 0x44, 0x10, 0x9f, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58,
+	0x5f, 0x31, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa0, 0x04, 0x12, 0x15, 0x0a,
+	0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45,
+	0x44, 0x10, 0xa1, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x33,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa2, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45,
+	0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x34, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10,
+	0xa3, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x35, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa4, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48,
+	0x5f, 0x52, 0x58, 0x5f, 0x36, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa5, 0x04,
+	0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x37, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x50, 0x45, 0x44, 0x10, 0xa6, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52,
+	0x58, 0x5f, 0x38, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa7, 0x04, 0x12, 0x15,
+	0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x39, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50,
+	0x45, 0x44, 0x10, 0xa8, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f,
+	0x31, 0x30, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa9, 0x04, 0x12, 0x16, 0x0a,
+	0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x31, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50,
+	0x45, 0x44, 0x10, 0xaa, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f,
+	0x31, 0x32, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xab, 0x04, 0x12, 0x16, 0x0a,
+	0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x33, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50,
+	0x45, 0x44, 0x10, 0xac, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f,
+	0x31, 0x34, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xad, 0x04, 0x12, 0x16, 0x0a,
+	0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x35, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50,
+	0x45, 0x44, 0x10, 0xae, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xaf, 0x04, 0x12, 0x15, 0x0a, 0x10,
+	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b,
+	0x10, 0xb0, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xb1, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54,
+	0x48, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xb2,
+	0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f,
+	0x54, 0x58, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xb3, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45,
+	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49,
+	0x43, 0x41, 0x53, 0x54, 0x10, 0xb4, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54,
+	0x10, 0xb5, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x38, 0x31, 0x39, 0x32, 0x42, 0x5f, 0x39, 0x32, 0x31, 0x35, 0x42,
+	0x10, 0xb6, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x38, 0x31, 0x39, 0x32, 0x42, 0x5f, 0x39, 0x32, 0x31, 0x35, 0x42,
+	0x10, 0xb7, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x36, 0x34, 0x42, 0x10, 0xb8, 0x04, 0x12, 0x1b, 0x0a, 0x16, 0x45,
+	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x36, 0x35, 0x42,
+	0x5f, 0x31, 0x32, 0x37, 0x42, 0x10, 0xb9, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f,
+	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x32, 0x38, 0x42, 0x5f, 0x32,
+	0x35, 0x35, 0x42, 0x10, 0xba, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x32, 0x35, 0x36, 0x42, 0x5f, 0x35, 0x31, 0x31,
+	0x42, 0x10, 0xbb, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
+	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x35, 0x31, 0x32, 0x42, 0x5f, 0x31, 0x30, 0x32, 0x33, 0x42,
+	0x10, 0xbc, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x30, 0x32, 0x34, 0x42, 0x5f, 0x31, 0x35, 0x31, 0x38, 0x42,
+	0x10, 0xbd, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x35, 0x31, 0x39, 0x42, 0x5f, 0x32, 0x30, 0x34, 0x37, 0x42,
+	0x10, 0xbe, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x42, 0x5f, 0x34, 0x30, 0x39, 0x35, 0x42,
+	0x10, 0xbf, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x34, 0x30, 0x39, 0x36, 0x42, 0x5f, 0x38, 0x31, 0x39, 0x31, 0x42,
+	0x10, 0xc0, 0x04, 0x2a, 0x2a, 0x0a, 0x0e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x49, 0x43, 0x5f, 0x55, 0x55, 0x49,
+	0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x49, 0x43, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x42,
+	0x15, 0x5a, 0x13, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2982,43 +4576,88 @@ func file_exporterconfig_proto_rawDescGZIP() []byte {
 }
 
 var file_exporterconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_exporterconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_exporterconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_exporterconfig_proto_goTypes = []any{
-	(MetricLabel)(0),             // 0: exportermetrics.MetricLabel
-	(GPUMetricField)(0),          // 1: exportermetrics.GPUMetricField
-	(GPUMetricLabel)(0),          // 2: exportermetrics.GPUMetricLabel
-	(NICMetricField)(0),          // 3: exportermetrics.NICMetricField
-	(NICMetricLabel)(0),          // 4: exportermetrics.NICMetricLabel
-	(*GPUHealthThresholds)(nil),  // 5: exportermetrics.GPUHealthThresholds
-	(*GPUMetricConfig)(nil),      // 6: exportermetrics.GPUMetricConfig
-	(*HealthServiceConfig)(nil),  // 7: exportermetrics.HealthServiceConfig
-	(*CommonConfig)(nil),         // 8: exportermetrics.CommonConfig
-	(*NICMetricConfig)(nil),      // 9: exportermetrics.NICMetricConfig
-	(*NICHealthCheckConfig)(nil), // 10: exportermetrics.NICHealthCheckConfig
-	(*MetricConfig)(nil),         // 11: exportermetrics.MetricConfig
-	nil,                          // 12: exportermetrics.GPUMetricConfig.CustomLabelsEntry
-	nil,                          // 13: exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
-	nil,                          // 14: exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
-	nil,                          // 15: exportermetrics.NICMetricConfig.CustomLabelsEntry
-	nil,                          // 16: exportermetrics.NICMetricConfig.ExtraPodLabelsEntry
+	(MetricLabel)(0),                    // 0: exportermetrics.MetricLabel
+	(GPUMetricField)(0),                 // 1: exportermetrics.GPUMetricField
+	(GPUMetricLabel)(0),                 // 2: exportermetrics.GPUMetricLabel
+	(NICMetricField)(0),                 // 3: exportermetrics.NICMetricField
+	(NICMetricLabel)(0),                 // 4: exportermetrics.NICMetricLabel
+	(*GPUHealthThresholds)(nil),         // 5: exportermetrics.GPUHealthThresholds
+	(*GPUHealthHysteresis)(nil),         // 6: exportermetrics.GPUHealthHysteresis
+	(*GPUHealthRule)(nil),               // 7: exportermetrics.GPUHealthRule
+	(*GPUDegradedHealthConfig)(nil),     // 8: exportermetrics.GPUDegradedHealthConfig
+	(*GPUMetricConfig)(nil),             // 9: exportermetrics.GPUMetricConfig
+	(*GPUHealthHistoryConfig)(nil),      // 10: exportermetrics.GPUHealthHistoryConfig
+	(*SysfsFallbackConfig)(nil),         // 11: exportermetrics.SysfsFallbackConfig
+	(*GPUAgentPollingConfig)(nil),       // 12: exportermetrics.GPUAgentPollingConfig
+	(*HighFrequencySamplingConfig)(nil), // 13: exportermetrics.HighFrequencySamplingConfig
+	(*HistogramBuckets)(nil),            // 14: exportermetrics.HistogramBuckets
+	(*ProcessMetricsConfig)(nil),        // 15: exportermetrics.ProcessMetricsConfig
+	(*JobAccountingConfig)(nil),         // 16: exportermetrics.JobAccountingConfig
+	(*GPURemediationConfig)(nil),        // 17: exportermetrics.GPURemediationConfig
+	(*NodeHealthReportingConfig)(nil),   // 18: exportermetrics.NodeHealthReportingConfig
+	(*HealthServiceSocketConfig)(nil),   // 19: exportermetrics.HealthServiceSocketConfig
+	(*HealthServiceTCPConfig)(nil),      // 20: exportermetrics.HealthServiceTCPConfig
+	(*HealthServiceConfig)(nil),         // 21: exportermetrics.HealthServiceConfig
+	(*WorkloadRegistrationConfig)(nil),  // 22: exportermetrics.WorkloadRegistrationConfig
+	(*CommonConfig)(nil),                // 23: exportermetrics.CommonConfig
+	(*NICMetricConfig)(nil),             // 24: exportermetrics.NICMetricConfig
+	(*NICHealthCheckConfig)(nil),        // 25: exportermetrics.NICHealthCheckConfig
+	(*MetricConfig)(nil),                // 26: exportermetrics.MetricConfig
+	nil,                                 // 27: exportermetrics.GPUMetricConfig.CustomLabelsEntry
+	nil,                                 // 28: exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
+	nil,                                 // 29: exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
+	nil,                                 // 30: exportermetrics.GPUMetricConfig.NodeLabelsEntry
+	nil,                                 // 31: exportermetrics.GPUMetricConfig.ExtraPodAnnotationsEntry
+	nil,                                 // 32: exportermetrics.GPUMetricConfig.WorkloadLabelsEntry
+	nil,                                 // 33: exportermetrics.HighFrequencySamplingConfig.BucketsEntry
+	nil,                                 // 34: exportermetrics.NICMetricConfig.CustomLabelsEntry
+	nil,                                 // 35: exportermetrics.NICMetricConfig.ExtraPodLabelsEntry
+	nil,                                 // 36: exportermetrics.NICMetricConfig.NodeLabelsEntry
+	nil,                                 // 37: exportermetrics.NICMetricConfig.ExtraPodAnnotationsEntry
+	nil,                                 // 38: exportermetrics.NICMetricConfig.WorkloadLabelsEntry
 }
 var file_exporterconfig_proto_depIdxs = []int32{
 	5,  // 0: exportermetrics.GPUMetricConfig.HealthThresholds:type_name -> exportermetrics.GPUHealthThresholds
-	12, // 1: exportermetrics.GPUMetricConfig.CustomLabels:type_name -> exportermetrics.GPUMetricConfig.CustomLabelsEntry
-	13, // 2: exportermetrics.GPUMetricConfig.ExtraPodLabels:type_name -> exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
-	14, // 3: exportermetrics.GPUMetricConfig.ProfilerMetrics:type_name -> exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
-	7,  // 4: exportermetrics.CommonConfig.HealthService:type_name -> exportermetrics.HealthServiceConfig
-	15, // 5: exportermetrics.NICMetricConfig.CustomLabels:type_name -> exportermetrics.NICMetricConfig.CustomLabelsEntry
-	10, // 6: exportermetrics.NICMetricConfig.HealthCheckConfig:type_name -> exportermetrics.NICHealthCheckConfig
-	16, // 7: exportermetrics.NICMetricConfig.ExtraPodLabels:type_name -> exportermetrics.NICMetricConfig.ExtraPodLabelsEntry
-	6,  // 8: exportermetrics.MetricConfig.GPUConfig:type_name -> exportermetrics.GPUMetricConfig
-	8,  // 9: exportermetrics.MetricConfig.CommonConfig:type_name -> exportermetrics.CommonConfig
-	9,  // 10: exportermetrics.MetricConfig.NICConfig:type_name -> exportermetrics.NICMetricConfig
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	27, // 1: exportermetrics.GPUMetricConfig.CustomLabels:type_name -> exportermetrics.GPUMetricConfig.CustomLabelsEntry
+	28, // 2: exportermetrics.GPUMetricConfig.ExtraPodLabels:type_name -> exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
+	29, // 3: exportermetrics.GPUMetricConfig.ProfilerMetrics:type_name -> exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
+	6,  // 4: exportermetrics.GPUMetricConfig.HealthHysteresis:type_name -> exportermetrics.GPUHealthHysteresis
+	7,  // 5: exportermetrics.GPUMetricConfig.HealthRules:type_name -> exportermetrics.GPUHealthRule
+	8,  // 6: exportermetrics.GPUMetricConfig.DegradedHealth:type_name -> exportermetrics.GPUDegradedHealthConfig
+	18, // 7: exportermetrics.GPUMetricConfig.NodeHealthReporting:type_name -> exportermetrics.NodeHealthReportingConfig
+	17, // 8: exportermetrics.GPUMetricConfig.Remediation:type_name -> exportermetrics.GPURemediationConfig
+	30, // 9: exportermetrics.GPUMetricConfig.NodeLabels:type_name -> exportermetrics.GPUMetricConfig.NodeLabelsEntry
+	31, // 10: exportermetrics.GPUMetricConfig.ExtraPodAnnotations:type_name -> exportermetrics.GPUMetricConfig.ExtraPodAnnotationsEntry
+	16, // 11: exportermetrics.GPUMetricConfig.JobAccounting:type_name -> exportermetrics.JobAccountingConfig
+	32, // 12: exportermetrics.GPUMetricConfig.WorkloadLabels:type_name -> exportermetrics.GPUMetricConfig.WorkloadLabelsEntry
+	15, // 13: exportermetrics.GPUMetricConfig.ProcessMetrics:type_name -> exportermetrics.ProcessMetricsConfig
+	11, // 14: exportermetrics.GPUMetricConfig.SysfsFallback:type_name -> exportermetrics.SysfsFallbackConfig
+	13, // 15: exportermetrics.GPUMetricConfig.HighFrequencySampling:type_name -> exportermetrics.HighFrequencySamplingConfig
+	12, // 16: exportermetrics.GPUMetricConfig.Polling:type_name -> exportermetrics.GPUAgentPollingConfig
+	10, // 17: exportermetrics.GPUMetricConfig.HealthHistory:type_name -> exportermetrics.GPUHealthHistoryConfig
+	33, // 18: exportermetrics.HighFrequencySamplingConfig.Buckets:type_name -> exportermetrics.HighFrequencySamplingConfig.BucketsEntry
+	19, // 19: exportermetrics.HealthServiceConfig.Socket:type_name -> exportermetrics.HealthServiceSocketConfig
+	20, // 20: exportermetrics.HealthServiceConfig.TCP:type_name -> exportermetrics.HealthServiceTCPConfig
+	21, // 21: exportermetrics.CommonConfig.HealthService:type_name -> exportermetrics.HealthServiceConfig
+	22, // 22: exportermetrics.CommonConfig.WorkloadRegistration:type_name -> exportermetrics.WorkloadRegistrationConfig
+	34, // 23: exportermetrics.NICMetricConfig.CustomLabels:type_name -> exportermetrics.NICMetricConfig.CustomLabelsEntry
+	25, // 24: exportermetrics.NICMetricConfig.HealthCheckConfig:type_name -> exportermetrics.NICHealthCheckConfig
+	35, // 25: exportermetrics.NICMetricConfig.ExtraPodLabels:type_name -> exportermetrics.NICMetricConfig.ExtraPodLabelsEntry
+	18, // 26: exportermetrics.NICMetricConfig.NodeHealthReporting:type_name -> exportermetrics.NodeHealthReportingConfig
+	36, // 27: exportermetrics.NICMetricConfig.NodeLabels:type_name -> exportermetrics.NICMetricConfig.NodeLabelsEntry
+	37, // 28: exportermetrics.NICMetricConfig.ExtraPodAnnotations:type_name -> exportermetrics.NICMetricConfig.ExtraPodAnnotationsEntry
+	38, // 29: exportermetrics.NICMetricConfig.WorkloadLabels:type_name -> exportermetrics.NICMetricConfig.WorkloadLabelsEntry
+	9,  // 30: exportermetrics.MetricConfig.GPUConfig:type_name -> exportermetrics.GPUMetricConfig
+	23, // 31: exportermetrics.MetricConfig.CommonConfig:type_name -> exportermetrics.CommonConfig
+	24, // 32: exportermetrics.MetricConfig.NICConfig:type_name -> exportermetrics.NICMetricConfig
+	14, // 33: exportermetrics.HighFrequencySamplingConfig.BucketsEntry.value:type_name -> exportermetrics.HistogramBuckets
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_exporterconfig_proto_init() }
@@ -3040,7 +4679,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GPUMetricConfig); i {
+			switch v := v.(*GPUHealthHysteresis); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3052,7 +4691,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*HealthServiceConfig); i {
+			switch v := v.(*GPUHealthRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3064,7 +4703,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*CommonConfig); i {
+			switch v := v.(*GPUDegradedHealthConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3076,7 +4715,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*NICMetricConfig); i {
+			switch v := v.(*GPUMetricConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3088,7 +4727,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*NICHealthCheckConfig); i {
+			switch v := v.(*GPUHealthHistoryConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3100,6 +4739,186 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*SysfsFallbackConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUAgentPollingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*HighFrequencySamplingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*HistogramBuckets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ProcessMetricsConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*JobAccountingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GPURemediationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*NodeHealthReportingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*HealthServiceSocketConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*HealthServiceTCPConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*HealthServiceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*WorkloadRegistrationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CommonConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*NICMetricConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*NICHealthCheckConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[21].Exporter = func(v any, i int) any {
 			switch v := v.(*MetricConfig); i {
 			case 0:
 				return &v.state
@@ -3118,7 +4937,7 @@ func file_exporterconfig_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_exporterconfig_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   12,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -30,13 +30,55 @@ const (
 
 	SlurmDir = "/var/run/exporter/"
 
+	// PBSDir - directory of the job files written by the pbs hook
+	PBSDir = "/var/run/exporter/pbs/"
+
+	// SlurmCgroupRoot - cgroup hierarchy scanned for the slurm jobs
+	SlurmCgroupRoot = "/sys/fs/cgroup"
+
+	// SlurmSpoolDir - slurmd spool directory with the job scripts
+	SlurmSpoolDir = "/var/spool/slurmd"
+
+	// ProcRoot - default procfs root of the GPU and slurm job processes
+	ProcRoot = "/proc"
+
+	// SysRoot - default sysfs root of the GPU devices and KFD process
+	// accounting
+	SysRoot = "/sys"
+
+	// KFDProcessTopN - default number of processes exported per GPU
+	KFDProcessTopN = 10
+
+	// SamplingIntervalMs - default interval of the high frequency sampling
+	SamplingIntervalMs = 100
+
+	// SamplingMaxSamples - default number of the latest samples kept per
+	// GPU and field by the high frequency sampling
+	SamplingMaxSamples = 1000
+
 	MetricsSocketPath = "/var/lib/amd-metrics-exporter/amdgpu_device_metrics_exporter_grpc.socket"
 
 	NICMetricsSocketPath = "/var/lib/amd-metrics-exporter/amdnic_device_metrics_exporter_grpc.socket"
 
+	// WorkloadRegistrationSocketPath - unix socket of the workload
+	// registration of the external schedulers
+	WorkloadRegistrationSocketPath = "/var/lib/amd-metrics-exporter/workload_registration.socket"
+
+	// DockerSocketPath - docker engine API socket of the container
+	// attribution
+	DockerSocketPath = "/var/run/docker.sock"
+
+	// ContainerdSocketPath - containerd API socket of the container
+	// attribution
+	ContainerdSocketPath = "/run/containerd/containerd.sock"
+
 	//PodResourceSocket - k8s pod grpc socket
 	PodResourceSocket = "/var/lib/kubelet/pod-resources/kubelet.sock"
 
+	// ExporterEventSourceComponentName - source component of the k8s events
+	// generated by the exporter
+	ExporterEventSourceComponentName = "amd-metrics-exporter"
+
 	// AMDGPUResourcePrefix - k8s AMD gpu resource prefix
 	AMDGPUResourcePrefix = "amd.com/"
 
@@ -46,6 +88,15 @@ const (
 	// max number of extra pod labels that will be exported in the logs
 	MaxSupportedPodLabels = 20
 
+	// max number of node labels that will be exported in the metrics
+	MaxSupportedNodeLabels = 10
+
+	// max number of pod annotations that will be exported in the metrics
+	MaxSupportedPodAnnotations = 10
+
+	// max number of registered workload labels that will be exported in the metrics
+	MaxSupportedWorkloadLabels = 10
+
 	// amdgpuhealth tool log file name
 	GPUHealthCheckerLogFile = "amdgpuhealth.log"
 
@@ -64,6 +115,24 @@ const (
 	// Metrics endpoint - returns all static metrics in JSON format
 	AMDGPUHandlerPrefix = "/gpumetrics"
 
+	// Health history endpoint - returns the GPU health transitions in JSON format
+	AMDGPUHealthHistoryHandlerPrefix = "/gpuhealth/history"
+
+	// GPUHealthHistoryFile - file backing the GPU health transition history
+	GPUHealthHistoryFile = "/var/lib/amd-metrics-exporter/gpu_health_history.log"
+
+	// GPUHealthHistoryMaxEntries - max number of GPU health transitions retained
+	GPUHealthHistoryMaxEntries = 10000
+
+	// Job summary endpoint - returns the GPU accounting of the ended jobs in JSON format
+	AMDGPUJobSummaryHandlerPrefix = "/gpujobs/summary"
+
+	// GPUJobSummaryDir - default directory of the json job summaries
+	GPUJobSummaryDir = "/var/lib/amd-metrics-exporter/jobs"
+
+	// GPUJobSummaryMaxEntries - default number of job summaries retained
+	GPUJobSummaryMaxEntries = 100
+
 	// Host directory where amdgpuhealth utility is copied to
 	AMDGPUHealthHostDirPath = "/var/lib/amd-metrics-exporter"
 
@@ -46,6 +46,7 @@ const (
 	GPUStateWatchFreq        = 30 * time.Second // frequency to watch GPU health state from exporter
 	GPUStateReqTimeout       = 10 * time.Second // timeout for gRPC request sending to exporter socket
 	GPUStateConnRetryFreq    = 5 * time.Second
+	GPUStateWatchRetryFreq   = 10 * time.Minute // retry of the GPU state watch on exporters without watch support
 	GPUStateConnREtryTimeout = 60 * time.Second
 
 	// DefaultResultLogDir directory to save test runner result logs
@@ -154,6 +154,11 @@ func (mh *MetricsHandler) GetHealthServiceState() bool {
 	return mh.runConf.GetHealthServiceState()
 }
 
+// GetHealthServiceConfig : returns the health service socket and TCP settings
+func (mh *MetricsHandler) GetHealthServiceConfig() *exportermetrics.HealthServiceConfig {
+	return mh.runConf.GetHealthServiceConfig()
+}
+
 func (mh *MetricsHandler) GetAgentAddr() string {
 	return mh.runConf.GetAgentAddr()
 }
@@ -0,0 +1,499 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	namespacesapi "github.com/containerd/containerd/api/services/namespaces/v1"
+	tasksapi "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types/task"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/fsysdevice"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// container runtimes of the container attribution outside kubernetes
+const (
+	ContainerRuntimeDocker     = "docker"
+	ContainerRuntimeContainerd = "containerd"
+)
+
+const (
+	// defaultContainerScanInterval is the default container scan interval
+	defaultContainerScanInterval = 10 * time.Second
+	// containerRequestTimeout limits a docker or containerd API request
+	containerRequestTimeout = 5 * time.Second
+	// containerdNamespaceKey is the grpc metadata key of the containerd
+	// namespace of a request
+	containerdNamespaceKey = "containerd-namespace"
+	// composeProjectLabel is the docker compose project container label
+	composeProjectLabel = "com.docker.compose.project"
+	// cdiAMDGPUPrefix prefixes the AMD GPU CDI device names, amd.com/gpu=0
+	cdiAMDGPUPrefix = "amd.com/gpu="
+	// nerdctlNameLabel is the container name set by nerdctl
+	nerdctlNameLabel = "nerdctl/name"
+)
+
+// ContainerLabels are the labels of the containers
+var ContainerLabels = map[string]bool{
+	exportermetrics.MetricLabel_CONTAINER.String():          true,
+	exportermetrics.GPUMetricLabel_CONTAINER_IMAGE.String(): true,
+	exportermetrics.GPUMetricLabel_COMPOSE_PROJECT.String(): true,
+}
+
+// containerdSkipNamespaces are the containerd namespaces of the containers
+// attributed by the kubernetes and docker clients
+var containerdSkipNamespaces = map[string]bool{
+	"k8s.io": true,
+	"moby":   true,
+}
+
+// renderNodeRe matches the render nodes, by name or by pcie path
+var renderNodeRe = regexp.MustCompile(`^(renderD\d+|pci-.*-render)$`)
+
+// ContainerInfo is a container using GPUs
+type ContainerInfo struct {
+	Id             string
+	Name           string
+	Image          string
+	ComposeProject string
+}
+
+// ContainerClientOption set desired options
+type ContainerClientOption func(cl *containerClient)
+
+// WithContainerSocket sets the docker engine API or containerd socket
+func WithContainerSocket(socketPath string) ContainerClientOption {
+	return func(cl *containerClient) {
+		cl.socketPath = socketPath
+	}
+}
+
+// WithContainerSysRoot sets the sysfs root used to list the render nodes
+func WithContainerSysRoot(root string) ContainerClientOption {
+	return func(cl *containerClient) {
+		cl.fsys = fsysdevice.NewFsysDevice(root)
+	}
+}
+
+// WithContainerScanInterval sets the container scan interval
+func WithContainerScanInterval(interval time.Duration) ContainerClientOption {
+	return func(cl *containerClient) {
+		if interval > 0 {
+			cl.interval = interval
+		}
+	}
+}
+
+// containerClient attributes the GPUs to the docker or containerd
+// containers from their device mappings and GPU requests. The render nodes
+// mapped to a container are only attributed along with /dev/kfd, the GPU
+// render ids are matched to the GPUs by the gpu agent.
+type containerClient struct {
+	sync.Mutex
+	runtime       string
+	socketPath    string
+	fsys          *fsysdevice.FsysDevice
+	interval      time.Duration
+	hostname      string
+	httpClient    *http.Client
+	clientConn    *grpc.ClientConn
+	gpuContainers map[string][]ContainerInfo
+	ctx           context.Context
+	cancel        context.CancelFunc
+}
+
+// NewContainerClient - creates a container scheduler client of the docker
+// or containerd runtime
+func NewContainerClient(ctx context.Context, runtime string, opts ...ContainerClientOption) (SchedulerClient, error) {
+	if runtime != ContainerRuntimeDocker && runtime != ContainerRuntimeContainerd {
+		return nil, fmt.Errorf("unsupported container runtime %v", runtime)
+	}
+	cl := &containerClient{
+		runtime:       runtime,
+		socketPath:    globals.DockerSocketPath,
+		interval:      defaultContainerScanInterval,
+		gpuContainers: make(map[string][]ContainerInfo),
+	}
+	if runtime == ContainerRuntimeContainerd {
+		cl.socketPath = globals.ContainerdSocketPath
+	}
+	for _, o := range opts {
+		o(cl)
+	}
+	if cl.fsys == nil {
+		cl.fsys = fsysdevice.GetFsysDeviceHandler()
+	}
+	// the exporter container itself maps the GPUs, it is skipped by its
+	// hostname which is the container short id by default
+	cl.hostname, _ = os.Hostname()
+	if runtime == ContainerRuntimeDocker {
+		cl.httpClient = &http.Client{
+			Timeout: containerRequestTimeout,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, "unix", cl.socketPath)
+				},
+			},
+		}
+	} else {
+		conn, err := grpc.NewClient("unix://"+cl.socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("containerd client creation failed: %v", err)
+		}
+		cl.clientConn = conn
+	}
+	cl.ctx, cl.cancel = context.WithCancel(ctx)
+
+	cl.scan()
+	go func() {
+		ticker := time.NewTicker(cl.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-cl.ctx.Done():
+				logger.Log.Printf("%v container scanner stopped", cl.runtime)
+				return
+			case <-ticker.C:
+				cl.scan()
+			}
+		}
+	}()
+
+	logger.Log.Printf("created %v container scheduler client", cl.runtime)
+	return cl, nil
+}
+
+// scan replaces the gpu containers with the running containers
+func (cl *containerClient) scan() {
+	var containers map[string][]ContainerInfo
+	var err error
+	if cl.runtime == ContainerRuntimeDocker {
+		containers, err = cl.dockerContainers()
+	} else {
+		containers, err = cl.containerdContainers()
+	}
+	if err != nil {
+		// the last known containers are kept
+		logger.Log.Printf("%v container scan failed: %v", cl.runtime, err)
+		return
+	}
+	cl.Lock()
+	changed := fmt.Sprint(cl.gpuContainers) != fmt.Sprint(containers)
+	cl.gpuContainers = containers
+	cl.Unlock()
+	if changed {
+		logger.Log.Printf("updated %v", containers)
+	}
+}
+
+// isSelf returns true for the exporter container
+func (cl *containerClient) isSelf(id string) bool {
+	return len(cl.hostname) >= 12 && strings.HasPrefix(id, cl.hostname)
+}
+
+// renderNodes returns the keys of the render nodes of all the GPUs and
+// their partitions
+func (cl *containerClient) renderNodes() []string {
+	ids, err := cl.fsys.GetRenderIds()
+	if err != nil {
+		logger.Log.Printf("render nodes listing failed: %v", err)
+	}
+	keys := []string{}
+	for _, id := range ids {
+		keys = append(keys, fmt.Sprintf("%v%v", SlurmRenderKeyPrefix, id))
+	}
+	return keys
+}
+
+// mappedGPUs returns the keys of the GPUs of the device nodes mapped to
+// the container, render nodes without /dev/kfd can't run GPU compute
+func (cl *containerClient) mappedGPUs(paths []string) []string {
+	kfd := false
+	gpus := []string{}
+	for _, p := range paths {
+		p = filepath.Clean(p)
+		switch {
+		case p == "/dev/kfd":
+			kfd = true
+		case p == "/dev/dri":
+			gpus = append(gpus, cl.renderNodes()...)
+		case renderNodeRe.MatchString(filepath.Base(p)):
+			gpus = append(gpus, SlurmGPUKey(filepath.Base(p)))
+		}
+	}
+	if !kfd {
+		return nil
+	}
+	return gpus
+}
+
+// addContainer associates the container with its GPUs
+func addContainer(containers map[string][]ContainerInfo, info ContainerInfo, gpus []string) {
+	for _, gpu := range gpus {
+		if gpu != "" && !slices.Contains(containers[gpu], info) {
+			containers[gpu] = append(containers[gpu], info)
+		}
+	}
+}
+
+// dockerDeviceRequest is a --gpus or CDI device request
+type dockerDeviceRequest struct {
+	Driver       string
+	Count        int
+	DeviceIDs    []string
+	Capabilities [][]string
+}
+
+// dockerContainer is the subset of the docker container inspect response
+// used by the attribution
+type dockerContainer struct {
+	Id     string
+	Name   string
+	Config struct {
+		Image  string
+		Labels map[string]string
+	}
+	HostConfig struct {
+		Devices []struct {
+			PathOnHost      string
+			PathInContainer string
+		}
+		DeviceRequests []dockerDeviceRequest
+	}
+}
+
+func (cl *containerClient) dockerGet(path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(cl.ctx, http.MethodGet, "http://docker"+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := cl.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%v returned %v", path, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// dockerContainers returns the running docker containers of every gpu key
+func (cl *containerClient) dockerContainers() (map[string][]ContainerInfo, error) {
+	var list []struct {
+		Id string
+	}
+	if err := cl.dockerGet("/containers/json", &list); err != nil {
+		return nil, err
+	}
+	containers := make(map[string][]ContainerInfo)
+	for _, c := range list {
+		if cl.isSelf(c.Id) {
+			continue
+		}
+		var inspect dockerContainer
+		if err := cl.dockerGet("/containers/"+c.Id+"/json", &inspect); err != nil {
+			// the container may have exited
+			logger.Log.Printf("docker container %v inspect failed: %v", c.Id, err)
+			continue
+		}
+		info := ContainerInfo{
+			Id:             inspect.Id,
+			Name:           strings.TrimPrefix(inspect.Name, "/"),
+			Image:          inspect.Config.Image,
+			ComposeProject: inspect.Config.Labels[composeProjectLabel],
+		}
+		paths := []string{}
+		for _, dev := range inspect.HostConfig.Devices {
+			paths = append(paths, dev.PathOnHost)
+		}
+		addContainer(containers, info, cl.mappedGPUs(paths))
+		addContainer(containers, info, cl.requestedGPUs(inspect.HostConfig.DeviceRequests))
+	}
+	return containers, nil
+}
+
+// requestedGPUs returns the keys of the GPUs requested with --gpus or
+// with the AMD CDI devices
+func (cl *containerClient) requestedGPUs(requests []dockerDeviceRequest) []string {
+	gpus := []string{}
+	for _, req := range requests {
+		if req.Driver == "cdi" {
+			for _, id := range req.DeviceIDs {
+				gpu, ok := strings.CutPrefix(id, cdiAMDGPUPrefix)
+				if !ok {
+					continue
+				}
+				if gpu == "all" {
+					gpus = append(gpus, cl.renderNodes()...)
+				} else {
+					gpus = append(gpus, SlurmGPUKey(gpu))
+				}
+			}
+			continue
+		}
+		isGPU := false
+		for _, caps := range req.Capabilities {
+			isGPU = isGPU || slices.Contains(caps, "gpu")
+		}
+		if !isGPU {
+			continue
+		}
+		if req.Count == -1 {
+			gpus = append(gpus, cl.renderNodes()...)
+		}
+		for _, id := range req.DeviceIDs {
+			gpus = append(gpus, SlurmGPUKey(id))
+		}
+	}
+	return gpus
+}
+
+// ociSpec is the subset of the OCI runtime spec of a containerd container
+// used by the attribution
+type ociSpec struct {
+	Linux struct {
+		Devices []struct {
+			Path  string `json:"path"`
+			Major int64  `json:"major"`
+			Minor int64  `json:"minor"`
+		} `json:"devices"`
+	} `json:"linux"`
+}
+
+// containerdContainers returns the running containerd containers of every
+// gpu key from the containerd API, the namespaces of the kubernetes and
+// docker containers are skipped
+func (cl *containerClient) containerdContainers() (map[string][]ContainerInfo, error) {
+	ctx, cancel := context.WithTimeout(cl.ctx, containerRequestTimeout)
+	defer cancel()
+	nsResp, err := namespacesapi.NewNamespacesClient(cl.clientConn).List(ctx, &namespacesapi.ListNamespacesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	containers := make(map[string][]ContainerInfo)
+	for _, ns := range nsResp.GetNamespaces() {
+		if containerdSkipNamespaces[ns.GetName()] {
+			continue
+		}
+		nsCtx := metadata.AppendToOutgoingContext(ctx, containerdNamespaceKey, ns.GetName())
+		taskResp, err := tasksapi.NewTasksClient(cl.clientConn).List(nsCtx, &tasksapi.ListTasksRequest{})
+		if err != nil {
+			return nil, err
+		}
+		running := map[string]bool{}
+		for _, t := range taskResp.GetTasks() {
+			if t.GetStatus() == task.Status_RUNNING {
+				running[t.GetID()] = true
+			}
+		}
+		if len(running) == 0 {
+			continue
+		}
+		listResp, err := containersapi.NewContainersClient(cl.clientConn).List(nsCtx, &containersapi.ListContainersRequest{})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range listResp.GetContainers() {
+			if !running[c.GetID()] || cl.isSelf(c.GetID()) {
+				continue
+			}
+			var spec ociSpec
+			if err := json.Unmarshal(c.GetSpec().GetValue(), &spec); err != nil {
+				logger.Log.Printf("containerd container %v spec parse failed: %v", c.GetID(), err)
+				continue
+			}
+			info := ContainerInfo{
+				Id:             c.GetID(),
+				Name:           c.GetLabels()[nerdctlNameLabel],
+				Image:          c.GetImage(),
+				ComposeProject: c.GetLabels()[composeProjectLabel],
+			}
+			if info.Name == "" {
+				info.Name = info.Id
+			}
+			paths := []string{}
+			for _, dev := range spec.Linux.Devices {
+				if fmt.Sprint(dev.Major) == drmMajor && dev.Minor >= renderMinorStart {
+					paths = append(paths, fmt.Sprintf("%v%v", SlurmRenderKeyPrefix, dev.Minor))
+					continue
+				}
+				paths = append(paths, dev.Path)
+			}
+			addContainer(containers, info, cl.mappedGPUs(paths))
+		}
+	}
+	return containers, nil
+}
+
+// ListWorkloads - returns the containers of every gpu key
+func (cl *containerClient) ListWorkloads() (map[string][]Workload, error) {
+	wls := make(map[string][]Workload)
+	cl.Lock()
+	defer cl.Unlock()
+	for gpu, list := range cl.gpuContainers {
+		for _, info := range list {
+			AddWorkload(wls, gpu, Workload{
+				Type: Container,
+				Info: info,
+			})
+		}
+	}
+	return wls, nil
+}
+
+func (cl *containerClient) CheckExportLabels(labels map[string]bool) bool {
+	for k := range ContainerLabels {
+		if ok := labels[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (cl *containerClient) Close() error {
+	if cl.ctx != nil {
+		cl.cancel()
+	}
+	if cl.clientConn != nil {
+		return cl.clientConn.Close()
+	}
+	return nil
+}
+
+func (cl *containerClient) Type() SchedulerType {
+	return Container
+}
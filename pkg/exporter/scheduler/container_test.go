@@ -0,0 +1,226 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	namespacesapi "github.com/containerd/containerd/api/services/namespaces/v1"
+	tasksapi "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types/task"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// newContainerSysRoot returns a sysfs root of two GPUs, renderD128 and
+// renderD129
+func newContainerSysRoot(t *testing.T) string {
+	sysRoot := t.TempDir()
+	for card, render := range map[string]string{"card0": "renderD128", "card1": "renderD129"} {
+		devDir := filepath.Join(sysRoot, "class/drm", card, "device")
+		assert.NilError(t, os.MkdirAll(filepath.Join(devDir, "drm", render), 0755))
+		assert.NilError(t, os.WriteFile(filepath.Join(devDir, "vendor"), []byte("0x1002\n"), 0644))
+	}
+	return sysRoot
+}
+
+func listContainers(t *testing.T, cl SchedulerClient) map[string][]ContainerInfo {
+	wls, err := cl.ListWorkloads()
+	assert.NilError(t, err)
+	containers := map[string][]ContainerInfo{}
+	for gpu, list := range wls {
+		for _, wl := range list {
+			assert.Equal(t, wl.Type, Container)
+			containers[gpu] = append(containers[gpu], wl.Info.(ContainerInfo))
+		}
+	}
+	return containers
+}
+
+func TestDockerContainers(t *testing.T) {
+	logger.Init(true)
+	inspect := map[string]string{
+		// device mapped render node with kfd
+		"c1": `{"Id": "c1", "Name": "/train", "Config": {"Image": "rocm/pytorch:latest",
+			"Labels": {"com.docker.compose.project": "llm"}},
+			"HostConfig": {"Devices": [{"PathOnHost": "/dev/kfd"}, {"PathOnHost": "/dev/dri/renderD129"}]}}`,
+		// all the gpus with the dri directory
+		"c2": `{"Id": "c2", "Name": "/bench", "Config": {"Image": "bench:1"},
+			"HostConfig": {"Devices": [{"PathOnHost": "/dev/kfd"}, {"PathOnHost": "/dev/dri"}]}}`,
+		// render node without kfd is not attributed
+		"c3": `{"Id": "c3", "Name": "/video", "Config": {"Image": "ffmpeg"},
+			"HostConfig": {"Devices": [{"PathOnHost": "/dev/dri/renderD128"}]}}`,
+		// cdi and --gpus requests
+		"c4": `{"Id": "c4", "Name": "/infer", "Config": {"Image": "vllm"},
+			"HostConfig": {"DeviceRequests": [{"Driver": "cdi", "DeviceIDs": ["amd.com/gpu=1"]},
+			{"Count": 0, "DeviceIDs": ["0"], "Capabilities": [["gpu"]]}]}}`,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/containers/json", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]string{{"Id": "c1"}, {"Id": "c2"}, {"Id": "c3"}, {"Id": "c4"}, {"Id": "gone"}})
+	})
+	mux.HandleFunc("/containers/{id}/json", func(w http.ResponseWriter, r *http.Request) {
+		body, ok := inspect[r.PathValue("id")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	})
+	socketPath := filepath.Join(t.TempDir(), "docker.sock")
+	lis, err := net.Listen("unix", socketPath)
+	assert.NilError(t, err)
+	srv := &http.Server{Handler: mux}
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Close()
+
+	cl, err := NewContainerClient(context.Background(), ContainerRuntimeDocker,
+		WithContainerSocket(socketPath), WithContainerSysRoot(newContainerSysRoot(t)))
+	assert.NilError(t, err)
+	defer cl.Close()
+
+	train := ContainerInfo{Id: "c1", Name: "train", Image: "rocm/pytorch:latest", ComposeProject: "llm"}
+	bench := ContainerInfo{Id: "c2", Name: "bench", Image: "bench:1"}
+	infer := ContainerInfo{Id: "c4", Name: "infer", Image: "vllm"}
+	assert.DeepEqual(t, listContainers(t, cl), map[string][]ContainerInfo{
+		"renderD128": {bench},
+		"renderD129": {train, bench},
+		"1":          {infer},
+		"0":          {infer},
+	})
+	wls, err := cl.ListWorkloads()
+	assert.NilError(t, err)
+	assert.Equal(t, wls["1"][0].String(), "Container: infer, Image: vllm")
+
+	_, err = NewContainerClient(context.Background(), "podman")
+	assert.Assert(t, err != nil)
+}
+
+// fakeContainerd serves the containers and tasks of the namespaces
+type fakeContainerd struct {
+	containers map[string][]*containersapi.Container
+	tasks      map[string][]*task.Process
+}
+
+type fakeNamespaces struct {
+	namespacesapi.UnimplementedNamespacesServer
+	*fakeContainerd
+}
+
+func (f fakeNamespaces) List(ctx context.Context, req *namespacesapi.ListNamespacesRequest) (*namespacesapi.ListNamespacesResponse, error) {
+	resp := &namespacesapi.ListNamespacesResponse{}
+	for ns := range f.containers {
+		resp.Namespaces = append(resp.Namespaces, &namespacesapi.Namespace{Name: ns})
+	}
+	return resp, nil
+}
+
+type fakeTasks struct {
+	tasksapi.UnimplementedTasksServer
+	*fakeContainerd
+}
+
+func (f fakeTasks) List(ctx context.Context, req *tasksapi.ListTasksRequest) (*tasksapi.ListTasksResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &tasksapi.ListTasksResponse{Tasks: f.tasks[md.Get(containerdNamespaceKey)[0]]}, nil
+}
+
+type fakeContainers struct {
+	containersapi.UnimplementedContainersServer
+	*fakeContainerd
+}
+
+func (f fakeContainers) List(ctx context.Context, req *containersapi.ListContainersRequest) (*containersapi.ListContainersResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &containersapi.ListContainersResponse{Containers: f.containers[md.Get(containerdNamespaceKey)[0]]}, nil
+}
+
+func TestContainerdContainers(t *testing.T) {
+	logger.Init(true)
+	newContainer := func(id, image string, labels map[string]string, spec string) *containersapi.Container {
+		return &containersapi.Container{
+			ID:     id,
+			Image:  image,
+			Labels: labels,
+			Spec:   &anypb.Any{TypeUrl: "types.containerd.io/opencontainers/runtime-spec/1/Spec", Value: []byte(spec)},
+		}
+	}
+	running := func(ids ...string) []*task.Process {
+		procs := []*task.Process{}
+		for _, id := range ids {
+			procs = append(procs, &task.Process{ID: id, Status: task.Status_RUNNING})
+		}
+		return procs
+	}
+	fake := &fakeContainerd{
+		containers: map[string][]*containersapi.Container{
+			"default": {
+				newContainer("n1", "docker.io/rocm/pytorch:latest",
+					map[string]string{"nerdctl/name": "train", "com.docker.compose.project": "llm"},
+					`{"linux": {"devices": [
+					{"path": "/dev/kfd", "major": 235, "minor": 0},
+					{"path": "/dev/dri/renderD129", "major": 226, "minor": 129}]}}`),
+				newContainer("n2", "docker.io/library/bench:1", nil, `{"linux": {"devices": [
+					{"path": "/dev/kfd", "major": 235, "minor": 0},
+					{"path": "/dev/dri/gpu0", "major": 226, "minor": 128}]}}`),
+				// stopped container
+				newContainer("n3", "docker.io/library/bench:1", nil, `{"linux": {"devices": [
+					{"path": "/dev/kfd", "major": 235, "minor": 0},
+					{"path": "/dev/dri/renderD129", "major": 226, "minor": 129}]}}`),
+			},
+			// kubernetes and docker containers are attributed by their clients
+			"k8s.io": {
+				newContainer("p1", "vllm", nil, `{"linux": {"devices": [
+					{"path": "/dev/kfd", "major": 235, "minor": 0},
+					{"path": "/dev/dri/renderD128", "major": 226, "minor": 128}]}}`),
+			},
+		},
+		tasks: map[string][]*task.Process{
+			"default": append(running("n1", "n2"), &task.Process{ID: "n3", Status: task.Status_STOPPED}),
+			"k8s.io":  running("p1"),
+		},
+	}
+	socketPath := filepath.Join(t.TempDir(), "containerd.sock")
+	lis, err := net.Listen("unix", socketPath)
+	assert.NilError(t, err)
+	srv := grpc.NewServer()
+	namespacesapi.RegisterNamespacesServer(srv, fakeNamespaces{fakeContainerd: fake})
+	tasksapi.RegisterTasksServer(srv, fakeTasks{fakeContainerd: fake})
+	containersapi.RegisterContainersServer(srv, fakeContainers{fakeContainerd: fake})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	cl, err := NewContainerClient(context.Background(), ContainerRuntimeContainerd,
+		WithContainerSocket(socketPath), WithContainerSysRoot(newContainerSysRoot(t)))
+	assert.NilError(t, err)
+	defer cl.Close()
+	assert.DeepEqual(t, listContainers(t, cl), map[string][]ContainerInfo{
+		"renderD129": {{Id: "n1", Name: "train", Image: "docker.io/rocm/pytorch:latest", ComposeProject: "llm"}},
+		"renderD128": {{Id: "n2", Name: "n2", Image: "docker.io/library/bench:1"}},
+	})
+}
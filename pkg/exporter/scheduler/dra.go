@@ -0,0 +1,101 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"regexp"
+	"strings"
+
+	kube "k8s.io/kubelet/pkg/apis/podresources/v1"
+)
+
+const (
+	// AMDDRADriverSuffix - suffix of the AMD DRA driver names (e.g. gpu.amd.com)
+	AMDDRADriverSuffix = "amd.com"
+)
+
+var (
+	// pcie address in domain:bus:device.function format
+	pcieAddrRe = regexp.MustCompile(`[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]`)
+	// partition device name as reported by the amdgpu_xcp platform devices
+	partitionRe = regexp.MustCompile(`amdgpu_xcp_[0-9]+`)
+)
+
+// DRADeviceResolver resolves a DRA allocated device to the device ids
+// (pcie address or partition name) used to look up workloads
+type DRADeviceResolver interface {
+	ResolveDRADevice(driver, pool, device string) []string
+}
+
+// IsAMDDRADriver returns true if the DRA driver publishes AMD devices
+func IsAMDDRADriver(driver string) bool {
+	driver = strings.ToLower(driver)
+	return driver == AMDDRADriverSuffix || strings.HasSuffix(driver, "."+AMDDRADriverSuffix)
+}
+
+// ParseDRADeviceIDs extracts the pcie addresses and partition names
+// embedded in a DRA device or CDI device name
+func ParseDRADeviceIDs(name string) []string {
+	name = strings.ToLower(name)
+	ids := pcieAddrRe.FindAllString(name, -1)
+	return append(ids, partitionRe.FindAllString(name, -1)...)
+}
+
+// draDeviceIDs returns the device ids of an AMD DRA claim result, the
+// resolver is preferred and the device and CDI names are the fallback
+func draDeviceIDs(claim *kube.ClaimResource, resolver DRADeviceResolver) []string {
+	if claim == nil || !IsAMDDRADriver(claim.DriverName) {
+		return nil
+	}
+	if resolver != nil && claim.DeviceName != "" {
+		if ids := resolver.ResolveDRADevice(claim.DriverName, claim.PoolName, claim.DeviceName); len(ids) != 0 {
+			return normalizeDeviceIDs(ids)
+		}
+	}
+	ids := ParseDRADeviceIDs(claim.DeviceName)
+	for _, cdi := range claim.CDIDevices {
+		ids = append(ids, ParseDRADeviceIDs(cdi.GetName())...)
+	}
+	return normalizeDeviceIDs(ids)
+}
+
+// normalizeDeviceIDs lowercases and removes duplicate device ids
+func normalizeDeviceIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	result := []string{}
+	for _, id := range ids {
+		id = strings.ToLower(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
+
+// containerDRADeviceIDs returns the device ids of all AMD devices
+// allocated to the container through DRA resource claims
+func containerDRADeviceIDs(container *kube.ContainerResources, resolver DRADeviceResolver) []string {
+	ids := []string{}
+	for _, dr := range container.GetDynamicResources() {
+		for _, claim := range dr.GetClaimResources() {
+			ids = append(ids, draDeviceIDs(claim, resolver)...)
+		}
+	}
+	return normalizeDeviceIDs(ids)
+}
@@ -0,0 +1,135 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	kube "k8s.io/kubelet/pkg/apis/podresources/v1"
+)
+
+type fakeDRAResolver map[string][]string
+
+func (f fakeDRAResolver) ResolveDRADevice(driver, pool, device string) []string {
+	return f[driver+"/"+pool+"/"+device]
+}
+
+func TestParseDRADeviceIDs(t *testing.T) {
+	assert.DeepEqual(t, ParseDRADeviceIDs("gpu-0000:1B:00.0"), []string{"0000:1b:00.0"})
+	assert.DeepEqual(t, ParseDRADeviceIDs("amd.com/gpu=amdgpu_xcp_3"), []string{"amdgpu_xcp_3"})
+	assert.Equal(t, len(ParseDRADeviceIDs("gpu-1")), 0)
+
+	assert.Assert(t, IsAMDDRADriver("gpu.amd.com"))
+	assert.Assert(t, IsAMDDRADriver("AMD.COM"))
+	assert.Assert(t, !IsAMDDRADriver("gpu.nvidia.com"))
+	assert.Assert(t, !IsAMDDRADriver("notamd.com"))
+}
+
+func TestBuildWorkloads(t *testing.T) {
+	pods := []*kube.PodResources{
+		{
+			Name:      "plugin-pod",
+			Namespace: "ns1",
+			Containers: []*kube.ContainerResources{
+				{
+					Name: "c1",
+					Devices: []*kube.ContainerDevices{
+						{ResourceName: "amd.com/gpu", DeviceIds: []string{"0000:05:00.0"}},
+						{ResourceName: "example.com/nic", DeviceIds: []string{"0000:09:00.0"}},
+					},
+				},
+			},
+		},
+		{
+			Name:      "dra-pod",
+			Namespace: "ns2",
+			Containers: []*kube.ContainerResources{
+				{
+					Name: "c2",
+					DynamicResources: []*kube.DynamicResource{
+						{
+							ClaimName:      "gpus",
+							ClaimNamespace: "ns2",
+							ClaimResources: []*kube.ClaimResource{
+								// resolved from the resource slice
+								{DriverName: "gpu.amd.com", PoolName: "node1", DeviceName: "gpu-1"},
+								// not resolved, partition taken from the CDI device
+								{
+									DriverName: "gpu.amd.com", PoolName: "node1", DeviceName: "gpu-2-xcp",
+									CDIDevices: []*kube.CDIDevice{{Name: "amd.com/gpu=amdgpu_xcp_5"}},
+								},
+								// other vendor devices are ignored
+								{DriverName: "gpu.example.com", PoolName: "node1", DeviceName: "0000:07:00.0"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cl := &podResourcesClient{
+		draResolver: fakeDRAResolver{"gpu.amd.com/node1/gpu-1": {"0000:1B:00.0"}},
+	}
+	wls := cl.buildWorkloads(pods)
+	assert.Equal(t, len(wls), 3)
+	assert.DeepEqual(t, wls["0000:05:00.0"][0].Info, PodResourceInfo{Pod: "plugin-pod", Namespace: "ns1", Container: "c1"})
+	draPod := PodResourceInfo{Pod: "dra-pod", Namespace: "ns2", Container: "c2"}
+	assert.DeepEqual(t, wls["0000:1b:00.0"][0].Info, draPod)
+	assert.DeepEqual(t, wls["amdgpu_xcp_5"][0].Info, draPod)
+	assert.Equal(t, wls["amdgpu_xcp_5"][0].Type, Kubernetes)
+
+	// without a resolver only the ids embedded in the names are used
+	cl.draResolver = nil
+	wls = cl.buildWorkloads(pods)
+	assert.Equal(t, len(wls), 2)
+	_, ok := wls["amdgpu_xcp_5"]
+	assert.Assert(t, ok)
+}
+
+func TestBuildWorkloadsSharedGPU(t *testing.T) {
+	sharedDevice := []*kube.ContainerDevices{
+		{ResourceName: "amd.com/gpu", DeviceIds: []string{"0000:05:00.0"}},
+	}
+	pods := []*kube.PodResources{
+		{
+			Name:      "pod0",
+			Namespace: "ns",
+			Containers: []*kube.ContainerResources{
+				{Name: "c0", Devices: sharedDevice},
+				{Name: "c1", Devices: sharedDevice},
+			},
+		},
+		{
+			Name:      "pod1",
+			Namespace: "ns",
+			Containers: []*kube.ContainerResources{
+				// same device reported twice for the container
+				{Name: "c0", Devices: append(sharedDevice, sharedDevice...)},
+			},
+		},
+	}
+	cl := &podResourcesClient{}
+	wls := cl.buildWorkloads(pods)
+	assert.Equal(t, len(wls), 1)
+	assert.DeepEqual(t, wls["0000:05:00.0"], []Workload{
+		{Type: Kubernetes, Info: PodResourceInfo{Pod: "pod0", Namespace: "ns", Container: "c0"}},
+		{Type: Kubernetes, Info: PodResourceInfo{Pod: "pod0", Namespace: "ns", Container: "c1"}},
+		{Type: Kubernetes, Info: PodResourceInfo{Pod: "pod1", Namespace: "ns", Container: "c0"}},
+	})
+}
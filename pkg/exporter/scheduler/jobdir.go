@@ -0,0 +1,97 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchJobDir reports the job files of the directory written and removed
+// by the scheduler hooks, the existing files are reported first. It
+// returns once the context is done.
+func watchJobDir(ctx context.Context, dir string, isJobFile func(name string) bool,
+	process func(op fsnotify.Op, name string, data []byte)) {
+	if err := os.MkdirAll(path.Clean(dir), 0755); err != nil {
+		logger.Log.Printf("error creating job dir %v err: %v", dir, err)
+	}
+
+	// Create new watcher.
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.Log.Fatal(err)
+	}
+	defer watcher.Close()
+
+	// Start listening for events.
+	go func() {
+		for ctx.Err() == nil {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				if !isJobFile(path.Base(event.Name)) {
+					logger.Log.Printf("skip event: %+v", event)
+					continue
+				}
+				logger.Log.Printf("event: %+v", event)
+
+				if event.Has(fsnotify.Create | fsnotify.Write) {
+					logger.Log.Printf("modified file: %v", event.Name)
+					data, err := os.ReadFile(event.Name)
+					if err != nil {
+						logger.Log.Printf("failed to read %v, %v", event.Name, err)
+						continue
+					}
+					process(fsnotify.Write, path.Base(event.Name), data)
+
+				} else if event.Has(fsnotify.Remove) {
+					logger.Log.Printf("deleted file: %v", event.Name)
+					process(fsnotify.Remove, path.Base(event.Name), nil)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Log.Printf("error: %v", err)
+			}
+		}
+	}()
+
+	// Add a path.
+	err = watcher.Add(dir)
+	if err != nil {
+		logger.Log.Fatalf("fsnotify watch err: %v", err)
+	}
+
+	// read existing
+	if fds, err := os.ReadDir(dir); err == nil {
+		for _, f := range fds {
+			watcher.Events <- fsnotify.Event{Name: path.Join(dir, f.Name()), Op: fsnotify.Write}
+		}
+	}
+
+	// Block main goroutine forever.
+	<-ctx.Done()
+	logger.Log.Printf("job watcher of %v stopped", dir)
+}
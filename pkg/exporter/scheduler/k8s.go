@@ -27,7 +27,7 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	kube "k8s.io/kubelet/pkg/apis/podresources/v1alpha1"
+	kube "k8s.io/kubelet/pkg/apis/podresources/v1"
 
 	"os"
 )
@@ -39,12 +39,24 @@ var KubernetesLabels = map[string]bool{
 }
 
 type podResourcesClient struct {
-	clientConn *grpc.ClientConn
-	ctx        context.Context // parent context
+	clientConn  *grpc.ClientConn
+	ctx         context.Context // parent context
+	draResolver DRADeviceResolver
+}
+
+// KubernetesClientOption set desired option
+type KubernetesClientOption func(cl *podResourcesClient)
+
+// WithDRADeviceResolver sets the resolver used to map DRA allocated
+// devices to pcie addresses and partitions
+func WithDRADeviceResolver(resolver DRADeviceResolver) KubernetesClientOption {
+	return func(cl *podResourcesClient) {
+		cl.draResolver = resolver
+	}
 }
 
 // NewKubernetesClient - creates a kubernetes schedler client
-func NewKubernetesClient(ctx context.Context) (SchedulerClient, error) {
+func NewKubernetesClient(ctx context.Context, opts ...KubernetesClientOption) (SchedulerClient, error) {
 	if _, err := os.Stat(globals.PodResourceSocket); err != nil {
 		logger.Log.Printf("no kubelet found")
 		return nil, fmt.Errorf("no kubelet, %v", err)
@@ -55,13 +67,18 @@ func NewKubernetesClient(ctx context.Context) (SchedulerClient, error) {
 		return nil, fmt.Errorf("kubelet socket error, %v", err)
 	}
 	logger.Log.Printf("created k8s scheduler client")
-	return &podResourcesClient{clientConn: client, ctx: ctx}, nil
+	cl := &podResourcesClient{clientConn: client, ctx: ctx}
+	for _, o := range opts {
+		o(cl)
+	}
+	return cl, nil
 }
 
 // ListWorkloads - list all the workloads
-// This function will return a map of device id to workload
+// This function will return a map of device id to the workloads using it
 // The device id is the device id (pcie id or partition xcd string) of the GPU
-func (cl *podResourcesClient) ListWorkloads() (map[string]Workload, error) {
+// allocated by the device plugin or through DRA resource claims
+func (cl *podResourcesClient) ListWorkloads() (map[string][]Workload, error) {
 	prCl := kube.NewPodResourcesListerClient(cl.clientConn)
 	ctx, cancel := context.WithTimeout(cl.ctx, time.Second*10)
 	defer cancel()
@@ -89,26 +106,36 @@ func (cl *podResourcesClient) ListWorkloads() (map[string]Workload, error) {
 		}
 	}
 
-	podInfo := make(map[string]Workload)
-	for _, pod := range resp.PodResources {
-		for _, container := range pod.Containers {
+	return cl.buildWorkloads(resp.GetPodResources()), nil
+}
+
+// buildWorkloads maps the AMD devices of every container to its workload,
+// a device shared by several containers lists all of them
+func (cl *podResourcesClient) buildWorkloads(pods []*kube.PodResources) map[string][]Workload {
+	podInfo := make(map[string][]Workload)
+	for _, pod := range pods {
+		for _, container := range pod.GetContainers() {
+			workload := Workload{
+				Type: Kubernetes,
+				Info: PodResourceInfo{
+					Pod:       pod.Name,
+					Namespace: pod.Namespace,
+					Container: container.Name,
+				},
+			}
 			for _, devs := range container.GetDevices() {
 				if strings.HasPrefix(devs.ResourceName, globals.AMDGPUResourcePrefix) {
 					for _, devId := range devs.DeviceIds {
-						podInfo[strings.ToLower(devId)] = Workload{
-							Type: Kubernetes,
-							Info: PodResourceInfo{
-								Pod:       pod.Name,
-								Namespace: pod.Namespace,
-								Container: container.Name,
-							},
-						}
+						AddWorkload(podInfo, strings.ToLower(devId), workload)
 					}
 				}
 			}
+			for _, devId := range containerDRADeviceIDs(container, cl.draResolver) {
+				AddWorkload(podInfo, devId, workload)
+			}
 		}
 	}
-	return podInfo, nil
+	return podInfo
 }
 
 func (cl *podResourcesClient) CheckExportLabels(labels map[string]bool) bool {
@@ -0,0 +1,197 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/fsnotify/fsnotify"
+)
+
+// batch schedulers of the exporter outside kubernetes
+const (
+	BatchSchedulerSlurm = "slurm"
+	BatchSchedulerPBS   = "pbs"
+)
+
+// PBSLabels are the labels of the pbs jobs, the job queue is exported as
+// the job partition
+var PBSLabels = map[string]bool{
+	exportermetrics.MetricLabel_JOB_ID.String():               true,
+	exportermetrics.MetricLabel_JOB_USER.String():             true,
+	exportermetrics.MetricLabel_JOB_PARTITION.String():        true,
+	exportermetrics.GPUMetricLabel_JOB_ARRAY_JOB_ID.String():  true,
+	exportermetrics.GPUMetricLabel_JOB_ARRAY_TASK_ID.String(): true,
+}
+
+// pbsJobFilePrefix prefixes the job env files written by the pbs hook
+const pbsJobFilePrefix = "job-"
+
+// pbsGPUEnvs are the job env variables listing the assigned gpus, by
+// order of precedence. PBS_GPUS is set by the site hook assigning the
+// gpus, the visibility variables are used otherwise.
+var pbsGPUEnvs = []string{
+	"PBS_GPUS",
+	"ROCR_VISIBLE_DEVICES",
+	"HIP_VISIBLE_DEVICES",
+	"CUDA_VISIBLE_DEVICES",
+	"GPU_DEVICE_ORDINAL",
+}
+
+// PBSClientOption set desired options
+type PBSClientOption func(cl *pbsClient)
+
+// WithPBSJobDir sets the directory of the job files written by the hook
+func WithPBSJobDir(dir string) PBSClientOption {
+	return func(cl *pbsClient) {
+		cl.jobDir = dir
+	}
+}
+
+// pbsFileJob is the job read from a job env file
+type pbsFileJob struct {
+	job  JobInfo
+	gpus []string
+}
+
+// pbsClient discovers the PBS Pro/OpenPBS jobs from the job env files
+// written by the execjob_launch hook and removed by the execjob_end hook
+type pbsClient struct {
+	sync.Mutex
+	jobDir   string
+	jobFiles map[string]pbsFileJob
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+// NewPBSClient - creates a pbs scheduler client
+func NewPBSClient(ctx context.Context, opts ...PBSClientOption) (SchedulerClient, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	cl := &pbsClient{
+		jobDir:   globals.PBSDir,
+		jobFiles: make(map[string]pbsFileJob),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	for _, o := range opts {
+		o(cl)
+	}
+
+	go watchJobDir(cl.ctx, cl.jobDir, isPBSJobFile, cl.processPBS)
+
+	logger.Log.Printf("created pbs scheduler client")
+	return cl, nil
+}
+
+// isPBSJobFile returns true for the job env files, job-<job id>
+func isPBSJobFile(name string) bool {
+	return strings.HasPrefix(name, pbsJobFilePrefix) && !strings.HasSuffix(name, ".tmp")
+}
+
+func (cl *pbsClient) processPBS(op fsnotify.Op, name string, buff []byte) {
+	if !op.Has(fsnotify.Write) {
+		cl.Lock()
+		delete(cl.jobFiles, name)
+		cl.Unlock()
+		logger.Log.Printf("removed pbs job file %v", name)
+		return
+	}
+	var jobEnv map[string]string
+	if err := json.Unmarshal(buff, &jobEnv); err != nil {
+		logger.Log.Printf("could not parse job env %v", err)
+		logger.Log.Printf("job env %v ", string(buff))
+		return
+	}
+	logger.Log.Printf("received job env %+v", jobEnv)
+	job := pbsJobFromEnv(jobEnv)
+	gpus, env := resolveGPUs(jobEnv, pbsGPUEnvs)
+	cl.Lock()
+	defer cl.Unlock()
+	// a rewritten file replaces the job it reported
+	delete(cl.jobFiles, name)
+	if len(gpus) == 0 || job.Id == "" {
+		logger.Log.Printf("no job gpus in job env %v", name)
+		return
+	}
+	cl.jobFiles[name] = pbsFileJob{job: job, gpus: gpus}
+	logger.Log.Printf("pbs job %v gpus %v from %v", job.Id, gpus, env)
+}
+
+// pbsJobFromEnv returns the job info of a job env, the job runs as USER
+// and the submitting user is used when it is not set
+func pbsJobFromEnv(jobEnv map[string]string) JobInfo {
+	user := jobEnv["USER"]
+	if user == "" {
+		user = jobEnv["PBS_O_LOGNAME"]
+	}
+	return JobInfo{
+		Id:          jobEnv["PBS_JOBID"],
+		User:        user,
+		Partition:   jobEnv["PBS_QUEUE"],
+		ArrayJobId:  jobEnv["PBS_ARRAY_ID"],
+		ArrayTaskId: jobEnv["PBS_ARRAY_INDEX"],
+	}
+}
+
+// ListWorkloads - returns the jobs of every gpu key
+func (cl *pbsClient) ListWorkloads() (map[string][]Workload, error) {
+	jobs := make(map[string][]Workload)
+	cl.Lock()
+	defer cl.Unlock()
+	names := make([]string, 0, len(cl.jobFiles))
+	for name := range cl.jobFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := cl.jobFiles[name]
+		for _, gpu := range f.gpus {
+			AddWorkload(jobs, gpu, Workload{
+				Type: PBS,
+				Info: f.job,
+			})
+		}
+	}
+	return jobs, nil
+}
+
+func (cl *pbsClient) CheckExportLabels(labels map[string]bool) bool {
+	for k := range PBSLabels {
+		if ok := labels[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (cl *pbsClient) Close() error {
+	if cl.ctx != nil {
+		cl.cancel()
+	}
+	return nil
+}
+
+func (cl *pbsClient) Type() SchedulerType {
+	return PBS
+}
@@ -0,0 +1,124 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func writePBSJobEnv(t *testing.T, cl *pbsClient, name string, jobEnv map[string]string) {
+	buff, err := json.Marshal(jobEnv)
+	assert.NilError(t, err)
+	cl.processPBS(fsnotify.Write, name, buff)
+}
+
+func listPBSJobs(t *testing.T, cl SchedulerClient) map[string][]JobInfo {
+	wls, err := cl.ListWorkloads()
+	assert.NilError(t, err)
+	jobs := map[string][]JobInfo{}
+	for gpu, list := range wls {
+		for _, wl := range list {
+			assert.Equal(t, wl.Type, PBS)
+			jobs[gpu] = append(jobs[gpu], wl.Info.(JobInfo))
+		}
+	}
+	return jobs
+}
+
+func TestPBSJobFiles(t *testing.T) {
+	logger.Init(true)
+	cl := &pbsClient{jobFiles: map[string]pbsFileJob{}}
+
+	writePBSJobEnv(t, cl, "job-12.pbs01", map[string]string{
+		"PBS_JOBID":            "12.pbs01",
+		"PBS_QUEUE":            "workq",
+		"PBS_O_LOGNAME":        "alice",
+		"PBS_GPUS":             "renderD128,renderD129",
+		"ROCR_VISIBLE_DEVICES": "0,1",
+	})
+	writePBSJobEnv(t, cl, "job-13[1].pbs01", map[string]string{
+		"PBS_JOBID":            "13[1].pbs01",
+		"PBS_QUEUE":            "gpuq",
+		"USER":                 "bob",
+		"PBS_O_LOGNAME":        "carol",
+		"PBS_ARRAY_ID":         "13[].pbs01",
+		"PBS_ARRAY_INDEX":      "1",
+		"CUDA_VISIBLE_DEVICES": "2",
+	})
+	// job without gpus is not reported
+	writePBSJobEnv(t, cl, "job-14.pbs01", map[string]string{
+		"PBS_JOBID": "14.pbs01",
+	})
+	assert.DeepEqual(t, listPBSJobs(t, cl), map[string][]JobInfo{
+		"renderD128": {{Id: "12.pbs01", User: "alice", Partition: "workq"}},
+		"renderD129": {{Id: "12.pbs01", User: "alice", Partition: "workq"}},
+		"2":          {{Id: "13[1].pbs01", User: "bob", Partition: "gpuq", ArrayJobId: "13[].pbs01", ArrayTaskId: "1"}},
+	})
+	wl := Workload{Type: PBS, Info: JobInfo{Id: "12.pbs01", User: "alice", Partition: "workq"}}
+	assert.Equal(t, wl.String(), "Job: 12.pbs01, User: alice, Queue: workq")
+
+	cl.processPBS(fsnotify.Remove, "job-12.pbs01", nil)
+	assert.Equal(t, len(listPBSJobs(t, cl)), 1)
+
+	assert.Assert(t, isPBSJobFile("job-12.pbs01"))
+	assert.Assert(t, !isPBSJobFile("job-12.pbs01.tmp"))
+	assert.Assert(t, !isPBSJobFile("12"))
+	assert.Assert(t, cl.CheckExportLabels(map[string]bool{"JOB_PARTITION": true}))
+	assert.Assert(t, !cl.CheckExportLabels(map[string]bool{"POD": true}))
+}
+
+func TestPBSClient(t *testing.T) {
+	logger.Init(true)
+	dir := t.TempDir()
+	data, err := json.Marshal(map[string]string{
+		"PBS_JOBID":            "20.pbs01",
+		"USER":                 "alice",
+		"PBS_QUEUE":            "workq",
+		"ROCR_VISIBLE_DEVICES": "1",
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "job-20.pbs01"), data, 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cl, err := NewPBSClient(ctx, WithPBSJobDir(dir))
+	assert.NilError(t, err)
+	defer cl.Close()
+	assert.Equal(t, cl.Type(), PBS)
+
+	// existing job files are read on start, removed ones are dropped
+	waitJobs := func(count int) {
+		for i := 0; i < 100 && len(listPBSJobs(t, cl)) != count; i++ {
+			time.Sleep(20 * time.Millisecond)
+		}
+		assert.Equal(t, len(listPBSJobs(t, cl)), count)
+	}
+	waitJobs(1)
+	assert.Equal(t, listPBSJobs(t, cl)["1"][0].Id, "20.pbs01")
+	assert.NilError(t, os.Remove(filepath.Join(dir, "job-20.pbs01")))
+	waitJobs(0)
+}
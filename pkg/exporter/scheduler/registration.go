@@ -0,0 +1,287 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const (
+	// RegistrationHandlerPrefix is the endpoint of the registered workloads
+	RegistrationHandlerPrefix = "/v1/workloads"
+	// registrationSocketMode limits the registration to the socket owner
+	// and group, the mode can be relaxed on the host
+	registrationSocketMode os.FileMode = 0660
+	// maxRegistrationSize limits the size of a registration request
+	maxRegistrationSize = 64 * 1024
+	// maxRegistrationLabels limits the labels of a registered workload
+	maxRegistrationLabels = 64
+	// maxRegistrationDevices limits the devices of a registered workload
+	maxRegistrationDevices = 256
+)
+
+// ExternalLabels are the labels of the registered workloads, the mapped
+// registration labels are exported in addition
+var ExternalLabels = map[string]bool{
+	exportermetrics.MetricLabel_JOB_ID.String(): true,
+}
+
+// RegisteredWorkload is a workload registered by an external scheduler,
+// the devices are gpu indexes, render nodes, partitions or pcie addresses
+type RegisteredWorkload struct {
+	Id        string            `json:"id"`
+	Scheduler string            `json:"scheduler,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Devices   []string          `json:"devices"`
+}
+
+// RegistrationClientOption set desired options
+type RegistrationClientOption func(cl *registrationClient)
+
+// WithRegistrationSocket sets the unix socket of the registration service
+func WithRegistrationSocket(socketPath string) RegistrationClientOption {
+	return func(cl *registrationClient) {
+		cl.socketPath = socketPath
+	}
+}
+
+// registrationClient serves the registration of the workloads of the
+// external schedulers, ex: in-house batch systems or ray clusters, as
+// json over http on a unix socket
+type registrationClient struct {
+	sync.Mutex
+	socketPath string
+	workloads  map[string]*RegisteredWorkload
+	server     *http.Server
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+// NewRegistrationClient - creates a workload registration scheduler client
+// serving the registration socket
+func NewRegistrationClient(ctx context.Context, opts ...RegistrationClientOption) (SchedulerClient, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	cl := &registrationClient{
+		socketPath: globals.WorkloadRegistrationSocketPath,
+		workloads:  make(map[string]*RegisteredWorkload),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+	for _, o := range opts {
+		o(cl)
+	}
+
+	if err := os.Remove(cl.socketPath); err != nil && !os.IsNotExist(err) {
+		cancel()
+		return nil, fmt.Errorf("failed to remove socket file: %v", err)
+	}
+	if err := os.MkdirAll(path.Dir(cl.socketPath), 0755); err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to create socket dir: %v", err)
+	}
+	lis, err := net.Listen("unix", cl.socketPath)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to listen on %v: %v", cl.socketPath, err)
+	}
+	if err := os.Chmod(cl.socketPath, registrationSocketMode); err != nil {
+		logger.Log.Printf("socket %v chmod to %o failed, set it on host", cl.socketPath, registrationSocketMode)
+	}
+
+	cl.server = &http.Server{
+		Handler:           cl.router(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		if err := cl.server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Log.Printf("workload registration server on %v failed: %v", cl.socketPath, err)
+		}
+	}()
+	go func() {
+		<-cl.ctx.Done()
+		cl.server.Close()
+	}()
+
+	logger.Log.Printf("created workload registration client on %v", cl.socketPath)
+	return cl, nil
+}
+
+func (cl *registrationClient) router() http.Handler {
+	router := mux.NewRouter()
+	router.HandleFunc(RegistrationHandlerPrefix, cl.handleList).Methods("GET")
+	router.HandleFunc(RegistrationHandlerPrefix, cl.handleRegister).Methods("POST")
+	router.HandleFunc(RegistrationHandlerPrefix+"/{id}", cl.handleDeregister).Methods("DELETE")
+	return router
+}
+
+func writeRegistrationResponse(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Log.Printf("workload registration response failed: %v", err)
+	}
+}
+
+func writeRegistrationError(w http.ResponseWriter, status int, err error) {
+	writeRegistrationResponse(w, status, map[string]string{"error": err.Error()})
+}
+
+func (cl *registrationClient) handleList(w http.ResponseWriter, r *http.Request) {
+	writeRegistrationResponse(w, http.StatusOK, cl.Registrations())
+}
+
+func (cl *registrationClient) handleRegister(w http.ResponseWriter, r *http.Request) {
+	var reg RegisteredWorkload
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRegistrationSize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&reg); err != nil {
+		writeRegistrationError(w, http.StatusBadRequest, fmt.Errorf("invalid registration: %v", err))
+		return
+	}
+	if err := cl.Register(reg); err != nil {
+		writeRegistrationError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeRegistrationResponse(w, http.StatusOK, reg)
+}
+
+func (cl *registrationClient) handleDeregister(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !cl.Deregister(id) {
+		writeRegistrationError(w, http.StatusNotFound, fmt.Errorf("workload %v is not registered", id))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Register adds the workload, a workload registered with the same id is
+// replaced
+func (cl *registrationClient) Register(reg RegisteredWorkload) error {
+	if reg.Id == "" || strings.Contains(reg.Id, "/") {
+		return fmt.Errorf("workload id %q is invalid", reg.Id)
+	}
+	if len(reg.Devices) == 0 || len(reg.Devices) > maxRegistrationDevices {
+		return fmt.Errorf("workload %v must have 1 to %v devices", reg.Id, maxRegistrationDevices)
+	}
+	if len(reg.Labels) > maxRegistrationLabels {
+		return fmt.Errorf("workload %v has more than %v labels", reg.Id, maxRegistrationLabels)
+	}
+	devices := []string{}
+	seen := map[string]bool{}
+	for _, dev := range reg.Devices {
+		key := SlurmGPUKey(dev)
+		if key == "" {
+			return fmt.Errorf("workload %v has an empty device", reg.Id)
+		}
+		if !seen[key] {
+			seen[key] = true
+			devices = append(devices, key)
+		}
+	}
+	labels := make(map[string]string, len(reg.Labels))
+	for k, v := range reg.Labels {
+		labels[k] = v
+	}
+	cl.Lock()
+	defer cl.Unlock()
+	cl.workloads[reg.Id] = &RegisteredWorkload{
+		Id:        reg.Id,
+		Scheduler: reg.Scheduler,
+		Labels:    labels,
+		Devices:   devices,
+	}
+	logger.Log.Printf("registered workload %v scheduler %v devices %v", reg.Id, reg.Scheduler, devices)
+	return nil
+}
+
+// Deregister removes the workload, false if it is not registered
+func (cl *registrationClient) Deregister(id string) bool {
+	cl.Lock()
+	defer cl.Unlock()
+	if _, ok := cl.workloads[id]; !ok {
+		return false
+	}
+	delete(cl.workloads, id)
+	logger.Log.Printf("deregistered workload %v", id)
+	return true
+}
+
+// Registrations returns the registered workloads sorted by id
+func (cl *registrationClient) Registrations() []*RegisteredWorkload {
+	cl.Lock()
+	defer cl.Unlock()
+	list := make([]*RegisteredWorkload, 0, len(cl.workloads))
+	for _, reg := range cl.workloads {
+		list = append(list, reg)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Id < list[j].Id
+	})
+	return list
+}
+
+// ListWorkloads - returns the registered workloads of every device key,
+// the workload info is the *RegisteredWorkload which is never modified
+func (cl *registrationClient) ListWorkloads() (map[string][]Workload, error) {
+	wls := make(map[string][]Workload)
+	for _, reg := range cl.Registrations() {
+		for _, dev := range reg.Devices {
+			AddWorkload(wls, dev, Workload{
+				Type: External,
+				Info: reg,
+			})
+		}
+	}
+	return wls, nil
+}
+
+func (cl *registrationClient) CheckExportLabels(labels map[string]bool) bool {
+	for k := range ExternalLabels {
+		if ok := labels[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (cl *registrationClient) Close() error {
+	if cl.ctx != nil {
+		cl.cancel()
+	}
+	return nil
+}
+
+func (cl *registrationClient) Type() SchedulerType {
+	return External
+}
@@ -0,0 +1,105 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func registrationRequest(t *testing.T, hc *http.Client, method, url string, body interface{}) int {
+	var buff bytes.Buffer
+	if body != nil {
+		assert.NilError(t, json.NewEncoder(&buff).Encode(body))
+	}
+	req, err := http.NewRequest(method, "http://unix"+url, &buff)
+	assert.NilError(t, err)
+	resp, err := hc.Do(req)
+	assert.NilError(t, err)
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestWorkloadRegistration(t *testing.T) {
+	logger.Init(true)
+	socketPath := filepath.Join(t.TempDir(), "registration.socket")
+	cl, err := NewRegistrationClient(context.Background(), WithRegistrationSocket(socketPath))
+	assert.NilError(t, err)
+	defer cl.Close()
+	assert.Equal(t, cl.Type(), External)
+
+	hc := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+		},
+	}}
+
+	status := registrationRequest(t, hc, http.MethodPost, RegistrationHandlerPrefix, RegisteredWorkload{
+		Id:        "ray-train-1",
+		Scheduler: "ray",
+		Labels:    map[string]string{"team": "llm"},
+		Devices:   []string{"0", "/dev/dri/renderD129", "0000:0C:00.0", "0"},
+	})
+	assert.Equal(t, status, http.StatusOK)
+	status = registrationRequest(t, hc, http.MethodPost, RegistrationHandlerPrefix, RegisteredWorkload{
+		Id:      "batch-7",
+		Devices: []string{"0"},
+	})
+	assert.Equal(t, status, http.StatusOK)
+
+	// invalid registrations
+	status = registrationRequest(t, hc, http.MethodPost, RegistrationHandlerPrefix, RegisteredWorkload{Id: "no-devices"})
+	assert.Equal(t, status, http.StatusBadRequest)
+	status = registrationRequest(t, hc, http.MethodPost, RegistrationHandlerPrefix, map[string]string{"name": "x"})
+	assert.Equal(t, status, http.StatusBadRequest)
+
+	wls, err := cl.ListWorkloads()
+	assert.NilError(t, err)
+	assert.Equal(t, len(wls), 3)
+	assert.Equal(t, len(wls["0"]), 2)
+	reg := wls["renderD129"][0].Info.(*RegisteredWorkload)
+	assert.Equal(t, reg.Id, "ray-train-1")
+	assert.Equal(t, reg.Labels["team"], "llm")
+	assert.Equal(t, wls["0000:0c:00.0"][0].String(), "Workload: ray-train-1, Scheduler: ray")
+
+	// a registration with the same id replaces the workload
+	status = registrationRequest(t, hc, http.MethodPost, RegistrationHandlerPrefix, RegisteredWorkload{
+		Id:      "ray-train-1",
+		Devices: []string{"1"},
+	})
+	assert.Equal(t, status, http.StatusOK)
+	wls, err = cl.ListWorkloads()
+	assert.NilError(t, err)
+	assert.Equal(t, len(wls), 2)
+	assert.Equal(t, len(wls["1"]), 1)
+
+	assert.Equal(t, registrationRequest(t, hc, http.MethodDelete, RegistrationHandlerPrefix+"/batch-7", nil), http.StatusNoContent)
+	assert.Equal(t, registrationRequest(t, hc, http.MethodDelete, RegistrationHandlerPrefix+"/batch-7", nil), http.StatusNotFound)
+	assert.Equal(t, registrationRequest(t, hc, http.MethodGet, RegistrationHandlerPrefix, nil), http.StatusOK)
+	wls, err = cl.ListWorkloads()
+	assert.NilError(t, err)
+	assert.Equal(t, len(wls), 1)
+}
@@ -23,6 +23,9 @@ type SchedulerType int
 const (
 	Kubernetes SchedulerType = iota + 1
 	Slurm
+	PBS
+	External
+	Container
 )
 
 type Workload struct {
@@ -31,13 +34,21 @@ type Workload struct {
 }
 
 type SchedulerClient interface {
-	// List of JobInfo/PodResourceInfo map
-	ListWorkloads() (map[string]Workload, error)
+	// List of JobInfo/PodResourceInfo of every device, a device shared
+	// by several workloads (time slicing) lists all of them
+	ListWorkloads() (map[string][]Workload, error)
 	CheckExportLabels(labels map[string]bool) bool
 	Close() error
 	Type() SchedulerType
 }
 
+// JobEndReporter is implemented by the scheduler clients notified by the
+// scheduler of the end of the jobs
+type JobEndReporter interface {
+	// EndedJobs returns the ids of the jobs ended since the previous call
+	EndedJobs() []string
+}
+
 type PodResourceInfo struct {
 	Pod       string
 	Namespace string
@@ -45,19 +56,26 @@ type PodResourceInfo struct {
 }
 
 type JobInfo struct {
-	Id        string
-	User      string
-	Partition string
-	Cluster   string
+	Id          string
+	User        string
+	Partition   string
+	Cluster     string
+	StepId      string
+	NodeId      string
+	ArrayJobId  string
+	ArrayTaskId string
 }
 
 func (s SchedulerType) String() string {
-	return [...]string{"Kubernetes", "Slurm"}[s-1]
+	return [...]string{"Kubernetes", "Slurm", "PBS", "External", "Container"}[s-1]
 }
 
 // returns String representation of Workload
 // k8s: Pod: <pod-name>, Namespace: <namespace>, Container: <container-name>
-// slurm: Job: <job-id>, User: <user>, Partition: <partition>, Cluster: <cluster>
+// slurm: Job: <job-id>, User: <user>, Partition: <partition>, Cluster: <cluster>[, Step: <step-id>]
+// pbs: Job: <job-id>, User: <user>, Queue: <queue>
+// external: Workload: <id>, Scheduler: <scheduler>
+// container: Container: <name>, Image: <image>
 func (w Workload) String() string {
 	switch w.Type {
 	case Kubernetes:
@@ -67,17 +85,51 @@ func (w Workload) String() string {
 		}
 	case Slurm:
 		if jobInfo, ok := w.Info.(JobInfo); ok {
-			return fmt.Sprintf("Job: %s, User: %s, Partition: %s, Cluster: %s",
+			str := fmt.Sprintf("Job: %s, User: %s, Partition: %s, Cluster: %s",
 				jobInfo.Id, jobInfo.User, jobInfo.Partition, jobInfo.Cluster)
+			if jobInfo.StepId != "" {
+				str += fmt.Sprintf(", Step: %s", jobInfo.StepId)
+			}
+			return str
+		}
+	case PBS:
+		if jobInfo, ok := w.Info.(JobInfo); ok {
+			return fmt.Sprintf("Job: %s, User: %s, Queue: %s",
+				jobInfo.Id, jobInfo.User, jobInfo.Partition)
+		}
+	case External:
+		if reg, ok := w.Info.(*RegisteredWorkload); ok {
+			return fmt.Sprintf("Workload: %s, Scheduler: %s", reg.Id, reg.Scheduler)
+		}
+	case Container:
+		if info, ok := w.Info.(ContainerInfo); ok {
+			return fmt.Sprintf("Container: %s, Image: %s", info.Name, info.Image)
 		}
 	}
 	return fmt.Sprintf("Workload Type: %s", w.Type.String())
 }
 
+// AddWorkload associates the workload with the device, a workload
+// already associated with the device is not added again
+func AddWorkload(wls map[string][]Workload, id string, wl Workload) {
+	for _, w := range wls[id] {
+		if w == wl {
+			return
+		}
+	}
+	wls[id] = append(wls[id], wl)
+}
+
 func GetExportLabels(t SchedulerType) map[string]bool {
 	switch t {
 	case Kubernetes:
 		return KubernetesLabels
+	case PBS:
+		return PBSLabels
+	case External:
+		return ExternalLabels
+	case Container:
+		return ContainerLabels
 	default:
 		return SlurmLabels
 	}
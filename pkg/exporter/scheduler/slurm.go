@@ -20,8 +20,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
-	"path"
 	"strconv"
 	"strings"
 	"sync"
@@ -34,17 +32,34 @@ import (
 )
 
 var SlurmLabels = map[string]bool{
-	exportermetrics.MetricLabel_JOB_ID.String():        true,
-	exportermetrics.MetricLabel_JOB_USER.String():      true,
-	exportermetrics.MetricLabel_JOB_PARTITION.String(): true,
+	exportermetrics.MetricLabel_JOB_ID.String():               true,
+	exportermetrics.MetricLabel_JOB_USER.String():             true,
+	exportermetrics.MetricLabel_JOB_PARTITION.String():        true,
+	exportermetrics.GPUMetricLabel_JOB_STEP_ID.String():       true,
+	exportermetrics.GPUMetricLabel_JOB_ARRAY_JOB_ID.String():  true,
+	exportermetrics.GPUMetricLabel_JOB_ARRAY_TASK_ID.String(): true,
+}
+
+// slurmJobFilePrefix prefixes the job env files written per job or step,
+// the files named after a gpu index are also accepted
+const slurmJobFilePrefix = "job-"
+
+// slurmFileJob is the job read from a job env file
+type slurmFileJob struct {
+	job  JobInfo
+	gpus []string
 }
 
 type client struct {
 	sync.Mutex
 	zmqSock zmq.Socket
-	GpuJobs map[string]JobInfo
-	ctx     context.Context
-	cancel  context.CancelFunc
+	// jobs and steps of every gpu key, a job level entry has no step id
+	GpuJobs  map[string][]JobInfo
+	jobFiles map[string]slurmFileJob
+	// jobs completed since the last EndedJobs call
+	endedJobs []string
+	ctx       context.Context
+	cancel    context.CancelFunc
 }
 
 // NewSlurmClient - creates a slurm schedler client
@@ -61,83 +76,33 @@ func NewSlurmClient(ctx context.Context, enableZmq bool) (SchedulerClient, error
 	}
 
 	cl := &client{
-		zmqSock: sock,
-		GpuJobs: make(map[string]JobInfo),
-		ctx:     ctx,
-		cancel:  cancel,
+		zmqSock:  sock,
+		GpuJobs:  make(map[string][]JobInfo),
+		jobFiles: make(map[string]slurmFileJob),
+		ctx:      ctx,
+		cancel:   cancel,
 	}
 
-	go func() {
-		if err := os.MkdirAll(path.Dir(globals.SlurmDir), 0644); err != nil {
-			logger.Log.Printf("error creating slurm dir %v err: %v", globals.SlurmDir, err)
-		}
-
-		// Create new watcher.
-		watcher, err := fsnotify.NewWatcher()
-		if err != nil {
-			logger.Log.Fatal(err)
-		}
-		defer watcher.Close()
-
-		// Start listening for events.
-		go func() {
-			for cl.ctx.Err() == nil {
-				select {
-				case event, ok := <-watcher.Events:
-					if !ok {
-						return
-					}
-
-					if _, err := strconv.Atoi(path.Base(event.Name)); err != nil {
-						logger.Log.Printf("skip event: %+v", event)
-						continue
-					}
-					logger.Log.Printf("event: %+v", event)
-
-					if event.Has(fsnotify.Create | fsnotify.Write) {
-						logger.Log.Printf("modified file: %v", event.Name)
-						data, err := os.ReadFile(event.Name)
-						if err != nil {
-							logger.Log.Printf("failed to read %v, %v", event.Name, err)
-							continue
-						}
-						cl.processSlurm(fsnotify.Write, path.Base(event.Name), data)
-
-					} else if event.Has(fsnotify.Remove) {
-						logger.Log.Printf("deleted file: %v", event.Name)
-						cl.processSlurm(fsnotify.Remove, path.Base(event.Name), nil)
-					}
-				case err, ok := <-watcher.Errors:
-					if !ok {
-						return
-					}
-					logger.Log.Printf("error: %v", err)
-				}
-			}
-		}()
-
-		// Add a path.
-		err = watcher.Add(globals.SlurmDir)
-		if err != nil {
-			logger.Log.Fatalf("fsnotify watch err: %v", err)
-		}
-
-		// read existing
-		if fds, err := os.ReadDir(globals.SlurmDir); err == nil {
-			for _, f := range fds {
-				watcher.Events <- fsnotify.Event{Name: globals.SlurmDir + "/" + f.Name(), Op: fsnotify.Write}
-			}
-		}
+	if enableZmq {
+		go cl.receiveNotifications()
+	}
 
-		// Block main goroutine forever.
-		<-cl.ctx.Done()
-		logger.Log.Printf("slurm job watcher stopped")
-	}()
+	go watchJobDir(cl.ctx, globals.SlurmDir, isSlurmJobFile, cl.processSlurm)
 
 	logger.Log.Printf("created slurm scheduler client")
 	return cl, nil
 }
 
+// isSlurmJobFile returns true for the job env files, named after the job
+// (job-<job id>[.<step id>]) or after a gpu index
+func isSlurmJobFile(name string) bool {
+	if strings.HasPrefix(name, slurmJobFilePrefix) {
+		return true
+	}
+	_, err := strconv.Atoi(name)
+	return err == nil
+}
+
 func (cl *client) processSlurm(op fsnotify.Op, name string, buff []byte) {
 	if op.Has(fsnotify.Write) {
 		var jobEnv map[string]string
@@ -148,43 +113,120 @@ func (cl *client) processSlurm(op fsnotify.Op, name string, buff []byte) {
 		}
 
 		logger.Log.Printf("received job env %+v", jobEnv)
-		if gpus, ok := jobEnv["CUDA_VISIBLE_DEVICES"]; ok {
-			cl.Lock()
-			for _, allocGPU := range strings.Split(gpus, ",") {
-				cl.GpuJobs[allocGPU] = JobInfo{
-					Id:        jobEnv["SLURM_JOB_ID"],
-					User:      jobEnv["SLURM_JOB_USER"],
-					Partition: jobEnv["SLURM_JOB_PARTITION"],
-					Cluster:   jobEnv["SLURM_CLUSTER_NAME"],
-				}
-			}
-			cl.Unlock()
-			logger.Log.Printf("updated %v", cl.GpuJobs)
+		gpus, env := ResolveSlurmGPUs(jobEnv)
+		if len(gpus) == 0 {
+			logger.Log.Printf("no gpus allocated in job env %v", name)
+			return
+		}
+		job := slurmJobFromEnv(jobEnv)
+		cl.Lock()
+		// a rewritten file replaces the job it reported
+		cl.removeFileJobs(name)
+		for _, gpu := range gpus {
+			cl.addGPUJob(gpu, job)
 		}
+		cl.jobFiles[name] = slurmFileJob{job: job, gpus: gpus}
+		cl.Unlock()
+		logger.Log.Printf("job %v step %v gpus %v from %v", job.Id, job.StepId, gpus, env)
+		logger.Log.Printf("updated %v", cl.GpuJobs)
 	} else {
 		cl.Lock()
-		delete(cl.GpuJobs, fmt.Sprintf("%v", name))
-
+		cl.removeFileJobs(name)
 		cl.Unlock()
 		logger.Log.Printf("updated gpu %v jobs %v", name, cl.GpuJobs)
 	}
 }
 
+// addGPUJob associates the job or step with the gpu, an entry of the same
+// job step is updated. Must be called with the lock held.
+func (cl *client) addGPUJob(gpu string, job JobInfo) {
+	for i, cur := range cl.GpuJobs[gpu] {
+		if cur.Id == job.Id && cur.StepId == job.StepId {
+			cl.GpuJobs[gpu][i] = mergeJobInfo(cur, job)
+			return
+		}
+	}
+	cl.GpuJobs[gpu] = append(cl.GpuJobs[gpu], job)
+}
+
+// removeGPUJobs removes the matching jobs of the gpu. Must be called with
+// the lock held.
+func (cl *client) removeGPUJobs(gpu string, match func(JobInfo) bool) {
+	jobs := []JobInfo{}
+	for _, job := range cl.GpuJobs[gpu] {
+		if !match(job) {
+			jobs = append(jobs, job)
+		}
+	}
+	if len(jobs) == 0 {
+		delete(cl.GpuJobs, gpu)
+		return
+	}
+	cl.GpuJobs[gpu] = jobs
+}
+
+// removeFileJobs removes the job reported by a job env file. Must be
+// called with the lock held.
+func (cl *client) removeFileJobs(name string) {
+	f, ok := cl.jobFiles[name]
+	if !ok {
+		return
+	}
+	delete(cl.jobFiles, name)
+	for _, gpu := range f.gpus {
+		cl.removeGPUJobs(gpu, func(job JobInfo) bool {
+			return job.Id == f.job.Id && job.StepId == f.job.StepId
+		})
+	}
+}
+
 // ListWorkloads - returns the list of workloads
 // for slurm it returns the list of jobs running on the gpus
 // the key is the gpu id/render id (integer) and the value is the job info
-func (cl *client) ListWorkloads() (map[string]Workload, error) {
-	jobs := make(map[string]Workload)
+// of every step using the gpu, completed with the job level details
+func (cl *client) ListWorkloads() (map[string][]Workload, error) {
+	jobs := make(map[string][]Workload)
 	cl.Lock()
 	defer cl.Unlock()
-	for k, v := range cl.GpuJobs {
-		jobs[k] = Workload{
-			Type: Slurm,
-			Info: v,
+	for k, list := range cl.GpuJobs {
+		jobLevel := map[string]JobInfo{}
+		withSteps := map[string]bool{}
+		for _, job := range list {
+			if job.StepId == "" {
+				jobLevel[job.Id] = job
+			} else {
+				withSteps[job.Id] = true
+			}
+		}
+		for _, job := range list {
+			if job.StepId == "" && withSteps[job.Id] {
+				// reported by its steps
+				continue
+			}
+			if parent, ok := jobLevel[job.Id]; ok && job.StepId != "" {
+				// the job level details are preferred, the step only
+				// knows the job uid
+				job = mergeJobInfo(job, parent)
+			}
+			AddWorkload(jobs, k, Workload{
+				Type: Slurm,
+				Info: job,
+			})
 		}
 	}
 	return jobs, nil
 }
+
+// EndedJobs returns the jobs completed since the previous call, learnt from
+// the epilog notifications of the slurm plugin
+func (cl *client) EndedJobs() []string {
+	cl.Lock()
+	defer cl.Unlock()
+	ended := cl.endedJobs
+	cl.endedJobs = nil
+	return ended
+}
+
 func (cl *client) CheckExportLabels(labels map[string]bool) bool {
 	for k := range SlurmLabels {
 		if ok := labels[k]; ok {
@@ -0,0 +1,424 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const (
+	// SlurmDiscoveryFiles discovers the jobs from the prolog job files
+	SlurmDiscoveryFiles = "files"
+	// SlurmDiscoveryCgroup discovers the jobs from the cgroup hierarchy
+	SlurmDiscoveryCgroup = "cgroup"
+
+	// defaultCgroupScanInterval is the default job scan interval
+	defaultCgroupScanInterval = 10 * time.Second
+	// drmMajor is the device major number of the drm devices
+	drmMajor = "226"
+)
+
+// slurmJobCgroupPatterns are the job cgroups of the cgroup v1 controllers
+// and of the cgroup v2 unified hierarchy
+var slurmJobCgroupPatterns = []string{
+	"*/slurm*/uid_*/job_*",
+	"slurm*/uid_*/job_*",
+	"system.slice/slurmstepd.scope/job_*",
+	"system.slice/*_slurmstepd.scope/job_*",
+}
+
+// SlurmCgroupClientOption set desired option
+type SlurmCgroupClientOption func(cl *cgroupClient)
+
+// WithCgroupRoot sets the root of the scanned cgroup hierarchy
+func WithCgroupRoot(root string) SlurmCgroupClientOption {
+	return func(cl *cgroupClient) {
+		cl.cgroupRoot = root
+	}
+}
+
+// WithProcRoot sets the procfs root used to read the job processes
+func WithProcRoot(root string) SlurmCgroupClientOption {
+	return func(cl *cgroupClient) {
+		cl.procRoot = root
+	}
+}
+
+// WithSlurmSpoolDir sets the slurmd spool directory
+func WithSlurmSpoolDir(dir string) SlurmCgroupClientOption {
+	return func(cl *cgroupClient) {
+		cl.spoolDir = dir
+	}
+}
+
+// WithCgroupScanInterval sets the job scan interval
+func WithCgroupScanInterval(interval time.Duration) SlurmCgroupClientOption {
+	return func(cl *cgroupClient) {
+		if interval > 0 {
+			cl.interval = interval
+		}
+	}
+}
+
+// cgroupClient discovers the slurm jobs from their cgroups, it does not
+// depend on the prolog and epilog scripts
+type cgroupClient struct {
+	sync.Mutex
+	cgroupRoot string
+	procRoot   string
+	spoolDir   string
+	interval   time.Duration
+	GpuJobs    map[string][]JobInfo
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+// cgroupJob is a job found in the cgroup hierarchy, a job is found once
+// per cgroup v1 controller
+type cgroupJob struct {
+	id   string
+	uid  string
+	dirs []string
+}
+
+// NewSlurmCgroupClient - creates a slurm scheduler client discovering the
+// jobs from the cgroup hierarchy
+func NewSlurmCgroupClient(ctx context.Context, opts ...SlurmCgroupClientOption) (SchedulerClient, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	cl := &cgroupClient{
+		cgroupRoot: globals.SlurmCgroupRoot,
+		procRoot:   globals.ProcRoot,
+		spoolDir:   globals.SlurmSpoolDir,
+		interval:   defaultCgroupScanInterval,
+		GpuJobs:    make(map[string][]JobInfo),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+	for _, o := range opts {
+		o(cl)
+	}
+	if _, err := os.Stat(cl.cgroupRoot); err != nil {
+		cancel()
+		return nil, fmt.Errorf("cgroup root %v, %v", cl.cgroupRoot, err)
+	}
+
+	cl.scan()
+	go func() {
+		ticker := time.NewTicker(cl.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-cl.ctx.Done():
+				logger.Log.Printf("slurm cgroup scanner stopped")
+				return
+			case <-ticker.C:
+				cl.scan()
+			}
+		}
+	}()
+
+	logger.Log.Printf("created slurm cgroup scheduler client, cgroup root %v", cl.cgroupRoot)
+	return cl, nil
+}
+
+// scan replaces the gpu jobs with the jobs running in the cgroups, the
+// jobs that ended are expired
+func (cl *cgroupClient) scan() {
+	gpuJobs := make(map[string][]JobInfo)
+	for _, job := range cl.findJobs() {
+		cl.addJobSteps(gpuJobs, job)
+	}
+	cl.Lock()
+	changed := fmt.Sprint(cl.GpuJobs) != fmt.Sprint(gpuJobs)
+	cl.GpuJobs = gpuJobs
+	cl.Unlock()
+	if changed {
+		logger.Log.Printf("updated %v", gpuJobs)
+	}
+}
+
+// findJobs returns the job cgroups by job id
+func (cl *cgroupClient) findJobs() []*cgroupJob {
+	jobs := map[string]*cgroupJob{}
+	for _, pattern := range slurmJobCgroupPatterns {
+		matches, err := filepath.Glob(filepath.Join(cl.cgroupRoot, pattern))
+		if err != nil {
+			continue
+		}
+		for _, dir := range matches {
+			id := strings.TrimPrefix(filepath.Base(dir), "job_")
+			if id == "" {
+				continue
+			}
+			job, ok := jobs[id]
+			if !ok {
+				job = &cgroupJob{id: id}
+				jobs[id] = job
+			}
+			if uid, ok := strings.CutPrefix(filepath.Base(filepath.Dir(dir)), "uid_"); ok {
+				job.uid = uid
+			}
+			if !slices.Contains(job.dirs, dir) {
+				job.dirs = append(job.dirs, dir)
+			}
+		}
+	}
+	list := make([]*cgroupJob, 0, len(jobs))
+	for _, job := range jobs {
+		list = append(list, job)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].id < list[j].id })
+	return list
+}
+
+// addJobSteps adds the steps of a running job using gpus, the job itself
+// without step cgroups
+func (cl *cgroupClient) addJobSteps(gpuJobs map[string][]JobInfo, job *cgroupJob) {
+	steps := map[string][]string{}
+	for _, dir := range job.dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			if step, ok := strings.CutPrefix(e.Name(), "step_"); ok && e.IsDir() {
+				steps[step] = append(steps[step], filepath.Join(dir, e.Name()))
+			}
+		}
+	}
+	if len(steps) == 0 {
+		steps[""] = job.dirs
+	}
+	// the job allow list applies to the steps without their own
+	jobDevices, jobConstrained := cl.allowedGPUs(job.dirs)
+	for step, dirs := range steps {
+		if step == "extern" {
+			// the extern step only holds the processes adopted by
+			// pam_slurm_adopt, it is not a user step
+			continue
+		}
+		pids := cl.cgroupPids(dirs)
+		if len(pids) == 0 {
+			// ended step, the cgroup is not removed yet
+			continue
+		}
+		env := cl.processEnv(pids)
+		gpus, constrained := cl.allowedGPUs(dirs)
+		if !constrained {
+			gpus, constrained = jobDevices, jobConstrained
+		}
+		if !constrained {
+			gpus, _ = ResolveSlurmGPUs(env)
+		}
+		if len(gpus) == 0 {
+			continue
+		}
+		info := cl.jobInfo(job, step, env)
+		for _, gpu := range gpus {
+			gpuJobs[gpu] = append(gpuJobs[gpu], info)
+		}
+	}
+}
+
+// jobInfo returns the job details from the job environment, completed
+// with the job cgroup and the slurmd spool
+func (cl *cgroupClient) jobInfo(job *cgroupJob, step string, env map[string]string) JobInfo {
+	info := slurmJobFromEnv(env)
+	info.Id = job.id
+	info.StepId = step
+	if info.User == "" {
+		uid := job.uid
+		if uid == "" {
+			uid = env["SLURM_JOB_UID"]
+		}
+		if uid != "" {
+			info.User = lookupJobUser(uid)
+		}
+	}
+	if info.Partition == "" {
+		info.Partition = cl.spoolPartition(job.id)
+	}
+	return info
+}
+
+// allowedGPUs returns the render nodes of the cgroup v1 device allow
+// lists, constrained is false without a device allow list restricting
+// the devices
+func (cl *cgroupClient) allowedGPUs(dirs []string) (gpus []string, constrained bool) {
+	seen := map[string]bool{}
+	for _, dir := range dirs {
+		data, err := os.ReadFile(filepath.Join(dir, "devices.list"))
+		if err != nil {
+			continue
+		}
+		devices, ok := parseDeviceAllowList(data)
+		if !ok {
+			continue
+		}
+		constrained = true
+		for _, gpu := range devices {
+			if !seen[gpu] {
+				seen[gpu] = true
+				gpus = append(gpus, gpu)
+			}
+		}
+	}
+	return gpus, constrained
+}
+
+// parseDeviceAllowList returns the render node keys of a devices.list,
+// ok is false if all devices are allowed
+func parseDeviceAllowList(data []byte) (gpus []string, ok bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		// <type> <major>:<minor> <access>
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		if fields[0] == "a" {
+			return nil, false
+		}
+		major, minor, found := strings.Cut(fields[1], ":")
+		if fields[0] != "c" || !found || major != drmMajor {
+			continue
+		}
+		if key := SlurmGPUKey(minor); strings.HasPrefix(key, SlurmRenderKeyPrefix) {
+			gpus = append(gpus, key)
+		}
+	}
+	return gpus, true
+}
+
+// cgroupPids returns the processes of the cgroups and their children
+func (cl *cgroupClient) cgroupPids(dirs []string) []string {
+	pids := []string{}
+	for _, dir := range dirs {
+		_ = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || d.Name() != "cgroup.procs" {
+				return nil
+			}
+			data, err := os.ReadFile(p)
+			if err != nil {
+				return nil
+			}
+			pids = append(pids, strings.Fields(string(data))...)
+			return nil
+		})
+	}
+	return pids
+}
+
+// processEnv returns the slurm environment of the first readable process
+// setting the job id
+func (cl *cgroupClient) processEnv(pids []string) map[string]string {
+	for _, pid := range pids {
+		data, err := os.ReadFile(filepath.Join(cl.procRoot, pid, "environ"))
+		if err != nil {
+			continue
+		}
+		env := map[string]string{}
+		for _, kv := range bytes.Split(data, []byte{0}) {
+			if k, v, ok := strings.Cut(string(kv), "="); ok {
+				env[k] = v
+			}
+		}
+		if env["SLURM_JOB_ID"] != "" {
+			return env
+		}
+	}
+	return map[string]string{}
+}
+
+// spoolPartition returns the partition requested by the batch script of
+// the job in the slurmd spool
+func (cl *cgroupClient) spoolPartition(id string) string {
+	f, err := os.Open(filepath.Join(cl.spoolDir, fmt.Sprintf("job%05s", id), "slurm_script"))
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		args, ok := strings.CutPrefix(line, "#SBATCH")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(args)
+		for i, arg := range fields {
+			if v, ok := strings.CutPrefix(arg, "--partition="); ok {
+				return v
+			}
+			if (arg == "-p" || arg == "--partition") && i+1 < len(fields) {
+				return fields[i+1]
+			}
+			if v, ok := strings.CutPrefix(arg, "-p"); ok && v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+// ListWorkloads - returns the jobs of the gpus found in the last scan
+func (cl *cgroupClient) ListWorkloads() (map[string][]Workload, error) {
+	jobs := make(map[string][]Workload)
+	cl.Lock()
+	defer cl.Unlock()
+	for k, list := range cl.GpuJobs {
+		for _, job := range list {
+			AddWorkload(jobs, k, Workload{
+				Type: Slurm,
+				Info: job,
+			})
+		}
+	}
+	return jobs, nil
+}
+
+func (cl *cgroupClient) CheckExportLabels(labels map[string]bool) bool {
+	for k := range SlurmLabels {
+		if ok := labels[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (cl *cgroupClient) Close() error {
+	cl.cancel()
+	return nil
+}
+
+func (cl *cgroupClient) Type() SchedulerType {
+	return Slurm
+}
@@ -0,0 +1,119 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func writeTestFile(t *testing.T, name, data string) {
+	assert.NilError(t, os.MkdirAll(filepath.Dir(name), 0755))
+	assert.NilError(t, os.WriteFile(name, []byte(data), 0644))
+}
+
+func writeTestEnviron(t *testing.T, procRoot, pid string, env ...string) {
+	writeTestFile(t, filepath.Join(procRoot, pid, "environ"), strings.Join(env, "\x00")+"\x00")
+}
+
+// newFakeSlurmNode creates a cgroup v1 job, a cgroup v2 job and a job
+// without processes
+func newFakeSlurmNode(t *testing.T) (cgroupRoot, procRoot, spoolDir string) {
+	dir := t.TempDir()
+	cgroupRoot = filepath.Join(dir, "cgroup")
+	procRoot = filepath.Join(dir, "proc")
+	spoolDir = filepath.Join(dir, "spool")
+
+	// cgroup v1 job 42 with a batch, extern and gpu step
+	v1Job := filepath.Join(cgroupRoot, "devices/slurm/uid_4294967000/job_42")
+	writeTestFile(t, filepath.Join(v1Job, "devices.list"), "c 226:128 rw\nc 226:129 rw\nc 238:0 rwm\n")
+	writeTestFile(t, filepath.Join(v1Job, "step_0/devices.list"), "c 226:129 rw\nc 226:1 rw\n")
+	writeTestFile(t, filepath.Join(v1Job, "step_0/cgroup.procs"), "100\n")
+	writeTestFile(t, filepath.Join(v1Job, "step_batch/cgroup.procs"), "101\n")
+	writeTestFile(t, filepath.Join(v1Job, "step_extern/cgroup.procs"), "102\n")
+	writeTestFile(t, filepath.Join(cgroupRoot, "freezer/slurm/uid_4294967000/job_42/step_0/cgroup.procs"), "100\n")
+	writeTestEnviron(t, procRoot, "100", "SLURM_JOB_ID=42", "SLURM_CLUSTER_NAME=c1", "PATH=/bin")
+	writeTestFile(t, filepath.Join(spoolDir, "job00042/slurm_script"),
+		"#!/bin/bash\n#SBATCH -N 1\n#SBATCH --partition=mi300\nsrun hostname\n")
+
+	// ended job, the cgroup is not removed
+	writeTestFile(t, filepath.Join(cgroupRoot, "devices/slurm/uid_4294967000/job_43/devices.list"), "c 226:130 rw\n")
+
+	// cgroup v2 job 50, the gpus are read from the job environment
+	v2Job := filepath.Join(cgroupRoot, "system.slice/slurmstepd.scope/job_50")
+	writeTestFile(t, filepath.Join(v2Job, "step_1/user/task_0/cgroup.procs"), "200\n201\n")
+	writeTestEnviron(t, procRoot, "201", "SLURM_JOB_ID=50", "SLURM_JOB_USER=bob",
+		"SLURM_JOB_PARTITION=gpu", "ROCR_VISIBLE_DEVICES=3", "SLURM_ARRAY_JOB_ID=49", "SLURM_ARRAY_TASK_ID=1")
+	return cgroupRoot, procRoot, spoolDir
+}
+
+func TestSlurmCgroupDiscovery(t *testing.T) {
+	logger.Init(true)
+	cgroupRoot, procRoot, spoolDir := newFakeSlurmNode(t)
+
+	scl, err := NewSlurmCgroupClient(context.Background(),
+		WithCgroupRoot(cgroupRoot), WithProcRoot(procRoot), WithSlurmSpoolDir(spoolDir))
+	assert.NilError(t, err)
+	defer scl.Close()
+	assert.Equal(t, scl.Type(), Slurm)
+	cl := scl.(*cgroupClient)
+
+	batch := JobInfo{Id: "42", User: "4294967000", Partition: "mi300", StepId: "batch"}
+	step0 := JobInfo{Id: "42", User: "4294967000", Partition: "mi300", Cluster: "c1", StepId: "0"}
+	job50 := JobInfo{Id: "50", User: "bob", Partition: "gpu", StepId: "1", ArrayJobId: "49", ArrayTaskId: "1"}
+	assert.DeepEqual(t, cl.GpuJobs["renderD128"], []JobInfo{batch})
+	assert.Equal(t, len(cl.GpuJobs["renderD129"]), 2)
+	assert.Assert(t, cl.GpuJobs["renderD129"][0] == step0 || cl.GpuJobs["renderD129"][1] == step0)
+	assert.DeepEqual(t, cl.GpuJobs["3"], []JobInfo{job50})
+	_, ok := cl.GpuJobs["renderD130"]
+	assert.Assert(t, !ok)
+	assert.Equal(t, len(cl.GpuJobs), 3)
+
+	wls, err := scl.ListWorkloads()
+	assert.NilError(t, err)
+	assert.Equal(t, wls["3"][0].Type, Slurm)
+	assert.DeepEqual(t, wls["3"][0].Info, job50)
+
+	// ended step and removed job are expired on the next scan
+	writeTestFile(t, filepath.Join(cgroupRoot, "devices/slurm/uid_4294967000/job_42/step_0/cgroup.procs"), "")
+	writeTestFile(t, filepath.Join(cgroupRoot, "freezer/slurm/uid_4294967000/job_42/step_0/cgroup.procs"), "")
+	assert.NilError(t, os.RemoveAll(filepath.Join(cgroupRoot, "system.slice/slurmstepd.scope/job_50")))
+	cl.scan()
+	assert.DeepEqual(t, cl.GpuJobs, map[string][]JobInfo{
+		"renderD128": {batch},
+		"renderD129": {batch},
+	})
+
+	_, err = NewSlurmCgroupClient(context.Background(), WithCgroupRoot(filepath.Join(cgroupRoot, "none")))
+	assert.Assert(t, err != nil)
+}
+
+func TestParseDeviceAllowList(t *testing.T) {
+	gpus, ok := parseDeviceAllowList([]byte("c 226:128 rw\nb 8:0 r\nc 226:0 rw\nc 226:136 rwm\n"))
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, gpus, []string{"renderD128", "renderD136"})
+
+	_, ok = parseDeviceAllowList([]byte("a *:* rwm\n"))
+	assert.Assert(t, !ok)
+}
@@ -0,0 +1,179 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SlurmRenderKeyPrefix prefixes the key of a gpu allocated by its
+	// render node minor number
+	SlurmRenderKeyPrefix = "renderD"
+	// renderMinorStart is the minor number of the first render node,
+	// smaller numbers are gpu indexes
+	renderMinorStart = 128
+	// maxSlurmRange limits the expansion of a gpu range
+	maxSlurmRange = 1024
+)
+
+// job step ids of the slurm special steps
+const (
+	slurmInteractiveStep = 0xfffffffa
+	slurmBatchStep       = 0xfffffffb
+	slurmExternStep      = 0xfffffffc
+)
+
+// slurmGPUEnvs are the job env variables listing the allocated gpus, by
+// order of precedence. The gres variables hold the node gpus while the
+// visibility variables are only used when slurm does not set them.
+var slurmGPUEnvs = []string{
+	"SLURM_STEP_GPUS",
+	"SLURM_JOB_GPUS",
+	"ROCR_VISIBLE_DEVICES",
+	"HIP_VISIBLE_DEVICES",
+	"CUDA_VISIBLE_DEVICES",
+	"GPU_DEVICE_ORDINAL",
+}
+
+// gresIdxRe matches the gpu indexes of a gres string gpu:mi300x:2(IDX:0-1)
+var gresIdxRe = regexp.MustCompile(`\(IDX:([^)]*)\)`)
+
+// ResolveSlurmGPUs returns the keys of the gpus allocated to the job or
+// step from the first gpu variable set in the job env, with the variable
+func ResolveSlurmGPUs(jobEnv map[string]string) ([]string, string) {
+	return resolveGPUs(jobEnv, slurmGPUEnvs)
+}
+
+// resolveGPUs returns the keys of the gpus listed by the first of the env
+// variables set in the job env, with the variable
+func resolveGPUs(jobEnv map[string]string, envs []string) ([]string, string) {
+	for _, env := range envs {
+		value := strings.TrimSpace(jobEnv[env])
+		if value == "" || strings.EqualFold(value, "NoDevFiles") {
+			continue
+		}
+		if keys := ParseSlurmGPUList(value); len(keys) != 0 {
+			return keys, env
+		}
+	}
+	return nil, ""
+}
+
+// ParseSlurmGPUList returns the gpu keys of a gpu list, the list is
+// comma separated with ranges (0,2-3) or a gres string
+func ParseSlurmGPUList(value string) []string {
+	if matches := gresIdxRe.FindAllStringSubmatch(value, -1); len(matches) != 0 {
+		idx := []string{}
+		for _, m := range matches {
+			idx = append(idx, m[1])
+		}
+		value = strings.Join(idx, ",")
+	}
+	keys := []string{}
+	seen := map[string]bool{}
+	for _, item := range strings.Split(value, ",") {
+		for _, gpu := range expandSlurmRange(strings.TrimSpace(item)) {
+			key := SlurmGPUKey(gpu)
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// expandSlurmRange expands an index range, other items are returned as is
+func expandSlurmRange(item string) []string {
+	lo, hi, ok := strings.Cut(item, "-")
+	if !ok {
+		return []string{item}
+	}
+	start, err := strconv.Atoi(lo)
+	if err != nil {
+		return []string{item}
+	}
+	end, err := strconv.Atoi(hi)
+	if err != nil || start > end || end-start >= maxSlurmRange {
+		return []string{item}
+	}
+	list := make([]string, 0, end-start+1)
+	for i := start; i <= end; i++ {
+		list = append(list, strconv.Itoa(i))
+	}
+	return list
+}
+
+// SlurmGPUKey returns the key of an allocated gpu matching the device ids
+// of the gpu: index, render node (renderD<minor>), partition
+// (amdgpu_xcp_<n>) or pcie address
+func SlurmGPUKey(gpu string) string {
+	gpu = strings.TrimPrefix(strings.TrimSpace(gpu), "/dev/dri/")
+	if gpu == "" {
+		return ""
+	}
+	if minor, ok := strings.CutPrefix(gpu, SlurmRenderKeyPrefix); ok {
+		if n, err := strconv.Atoi(minor); err == nil && n >= 0 {
+			return fmt.Sprintf("%v%v", SlurmRenderKeyPrefix, n)
+		}
+	}
+	if n, err := strconv.Atoi(gpu); err == nil && n >= 0 {
+		if n >= renderMinorStart {
+			return fmt.Sprintf("%v%v", SlurmRenderKeyPrefix, n)
+		}
+		return strconv.Itoa(n)
+	}
+	if addr := pcieAddrRe.FindString(gpu); addr != "" {
+		return strings.ToLower(addr)
+	}
+	if partition := partitionRe.FindString(gpu); partition != "" {
+		return partition
+	}
+	return strings.ToLower(gpu)
+}
+
+// slurmStepName returns the step id label of a job step id
+func slurmStepName(id uint32) string {
+	switch id {
+	case slurmInteractiveStep:
+		return "interactive"
+	case slurmBatchStep:
+		return "batch"
+	case slurmExternStep:
+		return "extern"
+	}
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// slurmJobFromEnv returns the job info of a job env
+func slurmJobFromEnv(jobEnv map[string]string) JobInfo {
+	return JobInfo{
+		Id:          jobEnv["SLURM_JOB_ID"],
+		User:        jobEnv["SLURM_JOB_USER"],
+		Partition:   jobEnv["SLURM_JOB_PARTITION"],
+		Cluster:     jobEnv["SLURM_CLUSTER_NAME"],
+		StepId:      jobEnv["SLURM_STEP_ID"],
+		NodeId:      jobEnv["SLURM_NODEID"],
+		ArrayJobId:  jobEnv["SLURM_ARRAY_JOB_ID"],
+		ArrayTaskId: jobEnv["SLURM_ARRAY_TASK_ID"],
+	}
+}
@@ -0,0 +1,210 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/luaplugin"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func newTestSlurmClient() *client {
+	return &client{
+		GpuJobs:  map[string][]JobInfo{},
+		jobFiles: map[string]slurmFileJob{},
+	}
+}
+
+func newNotification(stage luaplugin.Stages, job, step uint32, gpus ...string) *luaplugin.Notification {
+	return &luaplugin.Notification{
+		Type: stage,
+		SData: &luaplugin.SpankData{
+			JobID:     job,
+			JobUID:    4294967000,
+			JobStepID: step,
+			NodeID:    1,
+			AllocGPUs: gpus,
+		},
+	}
+}
+
+func writeJobEnv(t *testing.T, cl *client, name string, jobEnv map[string]string) {
+	buff, err := json.Marshal(jobEnv)
+	assert.NilError(t, err)
+	cl.processSlurm(fsnotify.Write, name, buff)
+}
+
+func listJobs(t *testing.T, cl *client) map[string][]JobInfo {
+	wls, err := cl.ListWorkloads()
+	assert.NilError(t, err)
+	jobs := map[string][]JobInfo{}
+	for gpu, list := range wls {
+		for _, wl := range list {
+			assert.Equal(t, wl.Type, Slurm)
+			jobs[gpu] = append(jobs[gpu], wl.Info.(JobInfo))
+		}
+	}
+	return jobs
+}
+
+func TestResolveSlurmGPUs(t *testing.T) {
+	gpus, env := ResolveSlurmGPUs(map[string]string{
+		"CUDA_VISIBLE_DEVICES": "0,1",
+		"ROCR_VISIBLE_DEVICES": "2,3",
+	})
+	assert.Equal(t, env, "ROCR_VISIBLE_DEVICES")
+	assert.DeepEqual(t, gpus, []string{"2", "3"})
+
+	gpus, env = ResolveSlurmGPUs(map[string]string{
+		"SLURM_JOB_GPUS":       "0-3",
+		"SLURM_STEP_GPUS":      "NoDevFiles",
+		"HIP_VISIBLE_DEVICES":  "0",
+		"CUDA_VISIBLE_DEVICES": "0",
+	})
+	assert.Equal(t, env, "SLURM_JOB_GPUS")
+	assert.DeepEqual(t, gpus, []string{"0", "1", "2", "3"})
+
+	gpus, env = ResolveSlurmGPUs(map[string]string{"SLURM_JOB_ID": "1"})
+	assert.Equal(t, env, "")
+	assert.Equal(t, len(gpus), 0)
+
+	assert.DeepEqual(t, ParseSlurmGPUList("128,129-130"),
+		[]string{"renderD128", "renderD129", "renderD130"})
+	assert.DeepEqual(t, ParseSlurmGPUList("gpu:mi300x:2(IDX:4-5)"), []string{"4", "5"})
+	assert.DeepEqual(t, ParseSlurmGPUList("/dev/dri/renderD136, amdgpu_xcp_3,0000:1B:00.0,1,1"),
+		[]string{"renderD136", "amdgpu_xcp_3", "0000:1b:00.0", "1"})
+	assert.DeepEqual(t, ParseSlurmGPUList("GPU-4B6F2A1C"), []string{"gpu-4b6f2a1c"})
+}
+
+func TestSlurmJobFiles(t *testing.T) {
+	logger.Init(true)
+	cl := newTestSlurmClient()
+
+	// legacy file named after a gpu index
+	writeJobEnv(t, cl, "3", map[string]string{
+		"SLURM_JOB_ID":         "10",
+		"SLURM_JOB_USER":       "alice",
+		"SLURM_JOB_PARTITION":  "gpu",
+		"CUDA_VISIBLE_DEVICES": "0,1",
+	})
+	writeJobEnv(t, cl, "job-11", map[string]string{
+		"SLURM_JOB_ID":        "11",
+		"SLURM_JOB_USER":      "bob",
+		"SLURM_JOB_GPUS":      "130",
+		"SLURM_ARRAY_JOB_ID":  "9",
+		"SLURM_ARRAY_TASK_ID": "2",
+	})
+	// concurrent steps of a job sharing the node
+	writeJobEnv(t, cl, "job-10.0", map[string]string{
+		"SLURM_JOB_ID":    "10",
+		"SLURM_STEP_ID":   "0",
+		"SLURM_STEP_GPUS": "0",
+	})
+	writeJobEnv(t, cl, "job-10.1", map[string]string{
+		"SLURM_JOB_ID":    "10",
+		"SLURM_STEP_ID":   "1",
+		"SLURM_STEP_GPUS": "0",
+	})
+	assert.DeepEqual(t, listJobs(t, cl), map[string][]JobInfo{
+		"0": {
+			{Id: "10", User: "alice", Partition: "gpu", StepId: "0"},
+			{Id: "10", User: "alice", Partition: "gpu", StepId: "1"},
+		},
+		"1":          {{Id: "10", User: "alice", Partition: "gpu"}},
+		"renderD130": {{Id: "11", User: "bob", ArrayJobId: "9", ArrayTaskId: "2"}},
+	})
+
+	// step completion reports the job again
+	cl.processSlurm(fsnotify.Remove, "job-10.0", nil)
+	cl.processSlurm(fsnotify.Remove, "job-10.1", nil)
+	assert.DeepEqual(t, listJobs(t, cl)["0"], []JobInfo{{Id: "10", User: "alice", Partition: "gpu"}})
+
+	// a rewritten file replaces its job
+	writeJobEnv(t, cl, "job-11", map[string]string{
+		"SLURM_JOB_ID":   "12",
+		"SLURM_JOB_GPUS": "2",
+	})
+	jobs := listJobs(t, cl)
+	_, ok := jobs["renderD130"]
+	assert.Assert(t, !ok)
+	assert.Equal(t, jobs["2"][0].Id, "12")
+
+	cl.processSlurm(fsnotify.Remove, "3", nil)
+	cl.processSlurm(fsnotify.Remove, "job-11", nil)
+	assert.Equal(t, len(cl.GpuJobs), 0)
+	assert.Equal(t, len(cl.jobFiles), 0)
+
+	assert.Assert(t, isSlurmJobFile("12"))
+	assert.Assert(t, isSlurmJobFile("job-12.batch"))
+	assert.Assert(t, !isSlurmJobFile("config.json"))
+}
+
+func TestSlurmNotifications(t *testing.T) {
+	logger.Init(true)
+	cl := newTestSlurmClient()
+
+	// job env file written by the prolog before the tasks start
+	writeJobEnv(t, cl, "job-10", map[string]string{
+		"SLURM_JOB_ID":        "10",
+		"SLURM_JOB_USER":      "alice",
+		"SLURM_JOB_PARTITION": "gpu",
+		"SLURM_CLUSTER_NAME":  "c1",
+		"SLURM_JOB_GPUS":      "0,1",
+	})
+
+	cl.processNotification(newNotification(luaplugin.Stages_TaskInit, 10, 0, "0", "129"))
+	cl.processNotification(newNotification(luaplugin.Stages_TaskInit, 10, 1, "0"))
+	cl.processNotification(newNotification(luaplugin.Stages_TaskInit, 11, 0xfffffffb, "2"))
+	assert.DeepEqual(t, listJobs(t, cl), map[string][]JobInfo{
+		"0": {
+			{Id: "10", User: "alice", Partition: "gpu", Cluster: "c1", StepId: "0", NodeId: "1"},
+			{Id: "10", User: "alice", Partition: "gpu", Cluster: "c1", StepId: "1", NodeId: "1"},
+		},
+		"1":          {{Id: "10", User: "alice", Partition: "gpu", Cluster: "c1"}},
+		"renderD129": {{Id: "10", User: "4294967000", StepId: "0", NodeId: "1"}},
+		"2":          {{Id: "11", User: "4294967000", StepId: "batch", NodeId: "1"}},
+	})
+
+	// exit of a step keeps the other steps
+	cl.processNotification(newNotification(luaplugin.Stages_TaskExit, 10, 0))
+	jobs := listJobs(t, cl)
+	assert.Equal(t, len(jobs["0"]), 1)
+	assert.Equal(t, jobs["0"][0].StepId, "1")
+	_, ok := jobs["renderD129"]
+	assert.Assert(t, !ok)
+
+	cl.processNotification(newNotification(luaplugin.Stages_TaskEpilog, 10, 0))
+	assert.DeepEqual(t, listJobs(t, cl), map[string][]JobInfo{
+		"2": {{Id: "11", User: "4294967000", StepId: "batch", NodeId: "1"}},
+	})
+	// the ended job is reported once
+	assert.DeepEqual(t, cl.EndedJobs(), []string{"10"})
+	assert.Equal(t, len(cl.EndedJobs()), 0)
+
+	// notifications without job data are ignored
+	cl.processNotification(&luaplugin.Notification{Type: luaplugin.Stages_TaskEpilog})
+	assert.Equal(t, len(cl.GpuJobs), 1)
+
+	// the epilog file removal after the notification
+	cl.processSlurm(fsnotify.Remove, "job-10", nil)
+	assert.Equal(t, len(cl.jobFiles), 0)
+}
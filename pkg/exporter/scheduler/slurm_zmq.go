@@ -0,0 +1,144 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"fmt"
+	"os/user"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/luaplugin"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// number of ended jobs kept until collected
+const maxEndedJobs = 256
+
+// receiveNotifications decodes the job notifications sent by the slurm
+// plugin over the zmq socket until the client is closed
+func (cl *client) receiveNotifications() {
+	for cl.ctx.Err() == nil {
+		msg, err := cl.zmqSock.Recv()
+		if err != nil {
+			if cl.ctx.Err() != nil {
+				break
+			}
+			logger.Log.Printf("slurm notification receive failed, %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		var notification luaplugin.Notification
+		if err := proto.Unmarshal(msg.Bytes(), &notification); err != nil {
+			logger.Log.Printf("could not decode slurm notification, %v", err)
+			continue
+		}
+		cl.processNotification(&notification)
+	}
+	logger.Log.Printf("slurm notification receiver stopped")
+}
+
+// processNotification updates the gpu jobs from a task notification
+func (cl *client) processNotification(n *luaplugin.Notification) {
+	sdata := n.GetSData()
+	if sdata == nil {
+		logger.Log.Printf("skip slurm notification without job data: %+v", n)
+		return
+	}
+	logger.Log.Printf("received slurm notification %v job %v step %v gpus %v",
+		n.GetType(), sdata.GetJobID(), sdata.GetJobStepID(), sdata.GetAllocGPUs())
+
+	jobId := fmt.Sprintf("%v", sdata.GetJobID())
+	stepId := slurmStepName(sdata.GetJobStepID())
+	cl.Lock()
+	defer cl.Unlock()
+	switch n.GetType() {
+	case luaplugin.Stages_TaskInit:
+		job := JobInfo{
+			Id:     jobId,
+			User:   lookupJobUser(fmt.Sprintf("%v", sdata.GetJobUID())),
+			StepId: stepId,
+			NodeId: fmt.Sprintf("%v", sdata.GetNodeID()),
+		}
+		for _, gpu := range ParseSlurmGPUList(strings.Join(sdata.GetAllocGPUs(), ",")) {
+			cl.addGPUJob(gpu, job)
+		}
+	case luaplugin.Stages_TaskExit:
+		// only the exited step is released, the other steps of the job
+		// sharing the node are kept
+		for gpu := range cl.GpuJobs {
+			cl.removeGPUJobs(gpu, func(job JobInfo) bool {
+				return job.Id == jobId && job.StepId == stepId
+			})
+		}
+	case luaplugin.Stages_TaskEpilog:
+		// job completed, release all its gpus
+		for gpu := range cl.GpuJobs {
+			cl.removeGPUJobs(gpu, func(job JobInfo) bool {
+				return job.Id == jobId
+			})
+		}
+		// the ended jobs are only collected by the job accounting, the
+		// oldest are dropped if nobody collects them
+		cl.endedJobs = append(cl.endedJobs, jobId)
+		if len(cl.endedJobs) > maxEndedJobs {
+			cl.endedJobs = cl.endedJobs[len(cl.endedJobs)-maxEndedJobs:]
+		}
+	}
+	logger.Log.Printf("updated %v", cl.GpuJobs)
+}
+
+// mergeJobInfo returns the new job info completed with the fields only
+// known from the other source (job env file or notification) of the same job
+func mergeJobInfo(old, job JobInfo) JobInfo {
+	if old.Id != job.Id {
+		return job
+	}
+	if job.User == "" {
+		job.User = old.User
+	}
+	if job.Partition == "" {
+		job.Partition = old.Partition
+	}
+	if job.Cluster == "" {
+		job.Cluster = old.Cluster
+	}
+	if job.StepId == "" {
+		job.StepId = old.StepId
+	}
+	if job.NodeId == "" {
+		job.NodeId = old.NodeId
+	}
+	if job.ArrayJobId == "" {
+		job.ArrayJobId = old.ArrayJobId
+	}
+	if job.ArrayTaskId == "" {
+		job.ArrayTaskId = old.ArrayTaskId
+	}
+	return job
+}
+
+// lookupJobUser returns the user name of the job uid, the uid if the user
+// is not known in the exporter environment
+func lookupJobUser(uid string) string {
+	if u, err := user.LookupId(uid); err == nil {
+		return u.Username
+	}
+	return uid
+}
@@ -0,0 +1,191 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+)
+
+// the socket is only reachable by the owner and the socket group by default
+const defaultSocketMode os.FileMode = 0660
+
+// readOnlyMethods are the health service methods that do not change the
+// device health, every other method requires a read-write identity
+var readOnlyMethods = map[string]bool{
+	metricssvc.MetricsService_GetGPUState_FullMethodName:      true,
+	metricssvc.MetricsService_List_FullMethodName:             true,
+	metricssvc.MetricsService_GetHealthHistory_FullMethodName: true,
+	metricssvc.MetricsService_Watch_FullMethodName:            true,
+	nicmetricssvc.MetricsService_List_FullMethodName:          true,
+	nicmetricssvc.MetricsService_Watch_FullMethodName:         true,
+}
+
+// methodAuthorizer authorizes remote health service calls on the identity
+// presented in the verified client certificate
+type methodAuthorizer struct {
+	readOnly  map[string]bool
+	readWrite map[string]bool
+}
+
+func newMethodAuthorizer(cfg *exportermetrics.HealthServiceTCPConfig) *methodAuthorizer {
+	a := &methodAuthorizer{
+		readOnly:  make(map[string]bool),
+		readWrite: make(map[string]bool),
+	}
+	for _, id := range cfg.GetReadOnlyClients() {
+		a.readOnly[id] = true
+	}
+	for _, id := range cfg.GetReadWriteClients() {
+		a.readWrite[id] = true
+	}
+	return a
+}
+
+// clientIdentities returns the CN and DNS SANs of the verified client certificate
+func clientIdentities(ctx context.Context) ([]string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no peer info")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, fmt.Errorf("connection is not using TLS")
+	}
+	chains := tlsInfo.State.VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return nil, fmt.Errorf("no verified client certificate")
+	}
+	cert := chains[0][0]
+	ids := []string{}
+	if cert.Subject.CommonName != "" {
+		ids = append(ids, cert.Subject.CommonName)
+	}
+	ids = append(ids, cert.DNSNames...)
+	return ids, nil
+}
+
+// authorize checks if the calling client is allowed to invoke the method,
+// the methods not known to be read-only require an identity of the
+// read-write list and the read-only methods an identity of either list, or
+// any verified client when the read-only list is empty
+func (a *methodAuthorizer) authorize(ctx context.Context, method string) error {
+	ids, err := clientIdentities(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	if !readOnlyMethods[method] {
+		if allowedIdentity(a.readWrite, ids) {
+			return nil
+		}
+		return status.Errorf(codes.PermissionDenied, "client %v not allowed to call %v", ids, method)
+	}
+	if len(a.readOnly) == 0 || allowedIdentity(a.readOnly, ids) || allowedIdentity(a.readWrite, ids) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "client %v not allowed to call %v", ids, method)
+}
+
+// allowedIdentity returns true when one of the identities is in the list
+func allowedIdentity(allowed map[string]bool, ids []string) bool {
+	for _, id := range ids {
+		if allowed[id] {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *methodAuthorizer) unaryInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *methodAuthorizer) streamInterceptor(srv interface{}, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// newServerTLSConfig builds the mTLS server config requiring verified client certificates
+func newServerTLSConfig(cfg *exportermetrics.HealthServiceTCPConfig) (*tls.Config, error) {
+	if cfg.GetCertFile() == "" || cfg.GetKeyFile() == "" || cfg.GetClientCAFile() == "" {
+		return nil, fmt.Errorf("CertFile, KeyFile and ClientCAFile are required")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.GetCertFile(), cfg.GetKeyFile())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %v", err)
+	}
+	caPEM, err := os.ReadFile(cfg.GetClientCAFile())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no valid certificates in %v", cfg.GetClientCAFile())
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// parseSocketMode parses the octal socket mode, empty returns the default
+func parseSocketMode(mode string) (os.FileMode, error) {
+	if mode == "" {
+		return defaultSocketMode, nil
+	}
+	m, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || m > 0777 {
+		return 0, fmt.Errorf("invalid socket mode %q", mode)
+	}
+	return os.FileMode(m), nil
+}
+
+// lookupSocketGroup resolves a group name or numeric gid
+func lookupSocketGroup(group string) (int, error) {
+	if gid, err := strconv.Atoi(group); err == nil {
+		return gid, nil
+	}
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return -1, err
+	}
+	return strconv.Atoi(g.Gid)
+}
@@ -0,0 +1,145 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"os"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+)
+
+func clientContext(cn string, dnsNames ...string) context.Context {
+	cert := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: cn},
+		DNSNames: dnsNames,
+	}
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{cert}},
+			},
+		},
+	})
+}
+
+func TestMethodAuthorizer(t *testing.T) {
+	authz := newMethodAuthorizer(&exportermetrics.HealthServiceTCPConfig{
+		ReadOnlyClients:  []string{"monitor"},
+		ReadWriteClients: []string{"remediation"},
+	})
+
+	readMethods := []string{
+		metricssvc.MetricsService_List_FullMethodName,
+		metricssvc.MetricsService_GetGPUState_FullMethodName,
+		metricssvc.MetricsService_GetHealthHistory_FullMethodName,
+		metricssvc.MetricsService_Watch_FullMethodName,
+		nicmetricssvc.MetricsService_List_FullMethodName,
+		nicmetricssvc.MetricsService_Watch_FullMethodName,
+	}
+	writeMethods := []string{
+		metricssvc.MetricsService_SetError_FullMethodName,
+		metricssvc.MetricsService_ClearGPUHealth_FullMethodName,
+		// methods not known to be read-only are denied to read-only clients
+		"/metricssvc.MetricsService/Unknown",
+	}
+
+	for _, m := range readMethods {
+		assert.NilError(t, authz.authorize(clientContext("monitor"), m))
+		assert.NilError(t, authz.authorize(clientContext("remediation"), m))
+		err := authz.authorize(clientContext("other"), m)
+		assert.Equal(t, status.Code(err), codes.PermissionDenied)
+	}
+	for _, m := range writeMethods {
+		err := authz.authorize(clientContext("monitor"), m)
+		assert.Equal(t, status.Code(err), codes.PermissionDenied)
+		assert.NilError(t, authz.authorize(clientContext("remediation"), m))
+		// identity from DNS SAN
+		assert.NilError(t, authz.authorize(clientContext("node", "remediation"), m))
+	}
+
+	// no peer certificate
+	err := authz.authorize(context.Background(), readMethods[0])
+	assert.Equal(t, status.Code(err), codes.Unauthenticated)
+
+	// empty lists allow any verified client to read but not write
+	authz = newMethodAuthorizer(&exportermetrics.HealthServiceTCPConfig{})
+	for _, m := range readMethods {
+		assert.NilError(t, authz.authorize(clientContext("any"), m))
+	}
+	for _, m := range writeMethods {
+		err = authz.authorize(clientContext("any"), m)
+		assert.Equal(t, status.Code(err), codes.PermissionDenied)
+	}
+
+	// read-only clients are not allowed to write without read-write clients
+	authz = newMethodAuthorizer(&exportermetrics.HealthServiceTCPConfig{
+		ReadOnlyClients: []string{"monitor"},
+	})
+	for _, m := range writeMethods {
+		err = authz.authorize(clientContext("monitor"), m)
+		assert.Equal(t, status.Code(err), codes.PermissionDenied)
+	}
+}
+
+func TestServerTLSConfigErrors(t *testing.T) {
+	_, err := newServerTLSConfig(&exportermetrics.HealthServiceTCPConfig{
+		ListenAddress: ":0",
+	})
+	assert.Assert(t, err != nil)
+
+	_, err = newServerTLSConfig(&exportermetrics.HealthServiceTCPConfig{
+		ListenAddress: ":0",
+		CertFile:      "/nonexistent/cert.pem",
+		KeyFile:       "/nonexistent/key.pem",
+		ClientCAFile:  "/nonexistent/ca.pem",
+	})
+	assert.Assert(t, err != nil)
+}
+
+func TestSocketPermissions(t *testing.T) {
+	mode, err := parseSocketMode("")
+	assert.NilError(t, err)
+	assert.Equal(t, mode, os.FileMode(0660))
+
+	mode, err = parseSocketMode("0660")
+	assert.NilError(t, err)
+	assert.Equal(t, mode, os.FileMode(0660))
+
+	_, err = parseSocketMode("0999")
+	assert.Assert(t, err != nil)
+	_, err = parseSocketMode("17777")
+	assert.Assert(t, err != nil)
+
+	gid, err := lookupSocketGroup("0")
+	assert.NilError(t, err)
+	assert.Equal(t, gid, 0)
+	_, err = lookupSocketGroup("no-such-group-for-test")
+	assert.Assert(t, err != nil)
+}
@@ -26,10 +26,12 @@ import (
 	"syscall"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	gpumetricsserver "github.com/ROCm/device-metrics-exporter/pkg/amdgpu/metricsserver"
 	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
 	nicmetricsserver "github.com/ROCm/device-metrics-exporter/pkg/amdnic/metricsserver"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
@@ -39,6 +41,7 @@ import (
 // SvcHandler is a struct that manages the gRPC server and metrics services.
 type SvcHandler struct {
 	grpc                *grpc.Server
+	tcpGrpc             *grpc.Server
 	mh                  *metricsutil.MetricsHandler
 	gpuHealthSvc        *gpumetricsserver.MetricsSvcImpl
 	nicHealthSvc        *nicmetricsserver.MetricsSvcImpl
@@ -78,11 +81,13 @@ func InitSvcs(mh *metricsutil.MetricsHandler, opts ...SvcHandlerOption) *SvcHand
 	svcHandler := &SvcHandler{
 		mh:      mh,
 		grpc:    grpc.NewServer(),
-		errChan: make(chan error, 2), // Buffered channel for 2 potential error from 2 listeners
+		errChan: make(chan error, 3), // Buffered channel for potential errors from the 2 sockets and tcp listener
 	}
 	for _, o := range opts {
 		o(svcHandler)
 	}
+	svcHandler.gpuHealthSvc = gpumetricsserver.NewMetricsServer(svcHandler.enableDebugAPI)
+	svcHandler.nicHealthSvc = nicmetricsserver.NewMetricsServer(svcHandler.enableDebugAPI)
 	return svcHandler
 }
 
@@ -103,6 +108,61 @@ func (s *SvcHandler) Stop() {
 		s.grpc.GracefulStop()
 		s.grpc = nil
 	}
+	if s.tcpGrpc != nil {
+		logger.Log.Printf("stopping Health gRPC TCP server")
+		s.tcpGrpc.GracefulStop()
+		s.tcpGrpc = nil
+	}
+}
+
+// registerServices registers the enabled health services on the gRPC server
+func (s *SvcHandler) registerServices(srv *grpc.Server) {
+	if s.enableGPUMonitoring {
+		metricssvc.RegisterMetricsServiceServer(srv, s.gpuHealthSvc)
+	}
+	if s.enableNICMonitoring {
+		nicmetricssvc.RegisterMetricsServiceServer(srv, s.nicHealthSvc)
+	}
+}
+
+// startTCPServer starts the mTLS protected TCP listener if configured
+func (s *SvcHandler) startTCPServer() error {
+	var tcpCfg *exportermetrics.HealthServiceTCPConfig
+	if s.mh != nil {
+		tcpCfg = s.mh.GetHealthServiceConfig().GetTCP()
+	}
+	if tcpCfg.GetListenAddress() == "" {
+		return nil
+	}
+	tlsConfig, err := newServerTLSConfig(tcpCfg)
+	if err != nil {
+		return fmt.Errorf("health service TLS config: %v", err)
+	}
+	authz := newMethodAuthorizer(tcpCfg)
+	s.tcpGrpc = grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(authz.unaryInterceptor),
+		grpc.StreamInterceptor(authz.streamInterceptor),
+	)
+	s.registerServices(s.tcpGrpc)
+
+	lis, err := net.Listen("tcp", tcpCfg.GetListenAddress())
+	if err != nil {
+		return fmt.Errorf("failed to listen on %v: %v", tcpCfg.GetListenAddress(), err)
+	}
+	logger.Log.Printf("listening on tcp %v with mTLS", lis.Addr().String())
+	s.serverWg.Add(1)
+	go s.startAndServeGRPC(s.tcpGrpc, lis)
+	return nil
+}
+
+// gracefulStop stops all running gRPC servers and waits for them to finish
+func (s *SvcHandler) gracefulStop() {
+	s.grpc.GracefulStop()
+	if s.tcpGrpc != nil {
+		s.tcpGrpc.GracefulStop()
+	}
+	s.serverWg.Wait()
 }
 
 // Run starts the gRPC server and listens for incoming connections on the specified sockets.
@@ -121,12 +181,7 @@ func (s *SvcHandler) Run() error {
 
 	// register all the services with the gRPC server
 	// all the services should be registered before starting the server
-	if s.enableGPUMonitoring {
-		metricssvc.RegisterMetricsServiceServer(s.grpc, s.gpuHealthSvc)
-	}
-	if s.enableNICMonitoring {
-		nicmetricssvc.RegisterMetricsServiceServer(s.grpc, s.nicHealthSvc)
-	}
+	s.registerServices(s.grpc)
 
 	if s.enableGPUMonitoring {
 		// start listening on the socket for GPU metrics
@@ -135,17 +190,24 @@ func (s *SvcHandler) Run() error {
 			return fmt.Errorf("failed to listen on socket %s: %v", globals.MetricsSocketPath, err)
 		}
 		s.serverWg.Add(1)
-		go s.startAndServeGRPC(gpuLis)
+		go s.startAndServeGRPC(s.grpc, gpuLis)
 	}
 
 	// start listening on the socket for NIC metrics if enabled
 	if s.enableNICMonitoring {
 		nicLis, err := s.listenOnSocket(globals.NICMetricsSocketPath)
 		if err != nil {
+			s.gracefulStop()
 			return fmt.Errorf("failed to listen on socket %s: %v", globals.NICMetricsSocketPath, err)
 		}
 		s.serverWg.Add(1)
-		go s.startAndServeGRPC(nicLis)
+		go s.startAndServeGRPC(s.grpc, nicLis)
+	}
+
+	if err := s.startTCPServer(); err != nil {
+		// stop the servers already serving on the sockets
+		s.gracefulStop()
+		return err
 	}
 
 	// Wait for any server to report an error, or for a shutdown signal
@@ -153,14 +215,12 @@ func (s *SvcHandler) Run() error {
 	case err := <-s.errChan:
 		// An error occurred in one of the serving goroutines
 		logger.Log.Printf("gRPC server encountered an error: %v. Initiating graceful shutdown...", err)
-		s.grpc.GracefulStop() // Gracefully stop all serving goroutines
-		s.serverWg.Wait()     // Wait for all goroutines to finish
+		s.gracefulStop() // Gracefully stop all serving goroutines and wait for them
 		return err
 	case <-s.setupSignalHandler():
 		// Received a termination signal (e.g., Ctrl+C, SIGTERM)
 		logger.Log.Println("received termination signal. Initiating graceful shutdown...")
-		s.grpc.GracefulStop() // Gracefully stop all serving goroutines
-		s.serverWg.Wait()     // Wait for all goroutines to finish
+		s.gracefulStop() // Gracefully stop all serving goroutines and wait for them
 		logger.Log.Println("all gRPC servers stopped gracefully.")
 		return nil
 	}
@@ -182,15 +242,39 @@ func (s *SvcHandler) listenOnSocket(socketPath string) (net.Listener, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to listen on port: %v", err)
 	}
-	// world readable socket
-	if err = os.Chmod(socketPath, 0777); err != nil {
-		logger.Log.Printf("socket %v chmod to 777 failed, set it on host", socketPath)
-	}
+	s.setSocketPermissions(socketPath)
 	logger.Log.Printf("listening on socket %v", socketPath)
 
 	return lis, nil
 }
 
+// setSocketPermissions applies the configured mode and group to the socket,
+// failures are logged as the permissions can also be set on the host
+func (s *SvcHandler) setSocketPermissions(socketPath string) {
+	var sockCfg *exportermetrics.HealthServiceSocketConfig
+	if s.mh != nil {
+		sockCfg = s.mh.GetHealthServiceConfig().GetSocket()
+	}
+	mode, err := parseSocketMode(sockCfg.GetMode())
+	if err != nil {
+		logger.Log.Printf("%v, using %o", err, defaultSocketMode)
+		mode = defaultSocketMode
+	}
+	if err = os.Chmod(socketPath, mode); err != nil {
+		logger.Log.Printf("socket %v chmod to %o failed, set it on host", socketPath, mode)
+	}
+	if group := sockCfg.GetGroup(); group != "" {
+		gid, err := lookupSocketGroup(group)
+		if err != nil {
+			logger.Log.Printf("socket %v group %v lookup failed: %v", socketPath, group, err)
+			return
+		}
+		if err = os.Chown(socketPath, -1, gid); err != nil {
+			logger.Log.Printf("socket %v chown to group %v failed: %v", socketPath, group, err)
+		}
+	}
+}
+
 // setupSignalHandler sets up a listener for OS signals to trigger graceful shutdown.
 func (s *SvcHandler) setupSignalHandler() chan os.Signal {
 	sigChan := make(chan os.Signal, 1)
@@ -200,9 +284,9 @@ func (s *SvcHandler) setupSignalHandler() chan os.Signal {
 }
 
 // startAndServeGRPC starts a gRPC server on a given listener.
-func (s *SvcHandler) startAndServeGRPC(lis net.Listener) {
+func (s *SvcHandler) startAndServeGRPC(srv *grpc.Server, lis net.Listener) {
 	defer s.serverWg.Done()
-	if err := s.grpc.Serve(lis); err != nil {
+	if err := srv.Serve(lis); err != nil {
 		// Send error to the channel, but only if the channel is not full
 		select {
 		case s.errChan <- fmt.Errorf("failed to serve on: %v, err: %v", lis.Addr().String(), err):
@@ -76,13 +76,53 @@ func (cfg *NodeHealthLabellerConfig) AddNodeHealthLabel(nodeLabels map[string]st
 	}
 }
 
+// ParseNodeHealthAnnotation converts k8s node annotations to a device health reason map.
+func (cfg *NodeHealthLabellerConfig) ParseNodeHealthAnnotation(nodeAnnotations map[string]string) map[string]string {
+	reasonMap := make(map[string]string)
+	for key, value := range nodeAnnotations {
+		if strings.HasPrefix(key, cfg.LabelPrefix) && strings.HasSuffix(key, ".reason") {
+			deviceID := cfg.extractDeviceIDWithSuffix(key, "reason")
+			if deviceID == "" {
+				logger.Log.Printf("Failed to extract device ID from annotation: %s", key)
+				continue
+			}
+			reasonMap[deviceID] = value
+		}
+	}
+	return reasonMap
+}
+
+// RemoveNodeHealthAnnotation deletes all node health reason annotations.
+func (cfg *NodeHealthLabellerConfig) RemoveNodeHealthAnnotation(nodeAnnotations map[string]string) {
+	for key := range nodeAnnotations {
+		if strings.HasPrefix(key, cfg.LabelPrefix) && strings.HasSuffix(key, ".reason") {
+			delete(nodeAnnotations, key)
+		}
+	}
+}
+
+// AddNodeHealthAnnotation adds the health reason annotations from a map.
+func (cfg *NodeHealthLabellerConfig) AddNodeHealthAnnotation(nodeAnnotations map[string]string, reasonMap map[string]string) {
+	for deviceID, reason := range reasonMap {
+		if deviceID == "" || reason == "" {
+			continue
+		}
+		annotationKey := fmt.Sprintf("%s.%s.reason", cfg.LabelPrefix, deviceID)
+		nodeAnnotations[annotationKey] = reason
+	}
+}
+
 func (cfg *NodeHealthLabellerConfig) extractDeviceID(label string) string {
+	return cfg.extractDeviceIDWithSuffix(label, "state")
+}
+
+func (cfg *NodeHealthLabellerConfig) extractDeviceIDWithSuffix(label, suffix string) string {
 	escapedPrefix := regexp.QuoteMeta(cfg.LabelPrefix)
 	// Pattern explanation:
 	// ^escapedPrefix\.      => prefix + dot at start
 	// (.+)                  => capture anything (deviceID)
-	// \.state$              => literal ".state" at end of string
-	pattern := fmt.Sprintf(`^%s\.(.+)\.state$`, escapedPrefix)
+	// \.suffix$             => literal ".<suffix>" at end of string
+	pattern := fmt.Sprintf(`^%s\.(.+)\.%s$`, escapedPrefix, regexp.QuoteMeta(suffix))
 	re := regexp.MustCompile(pattern)
 
 	matches := re.FindStringSubmatch(label)
@@ -356,3 +356,27 @@ func TestIntegration_AddRemoveParse(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeHealthAnnotation(t *testing.T) {
+	logger.Init(true)
+	cfg := NewNodeHealthLabellerConfig("test.prefix")
+
+	annotations := map[string]string{
+		"other.annotation":        "value",
+		"test.prefix.dev1.reason": "REASON_CRITICAL_EVENT:1",
+	}
+	assert.DeepEqual(t, cfg.ParseNodeHealthAnnotation(annotations),
+		map[string]string{"dev1": "REASON_CRITICAL_EVENT:1"})
+
+	cfg.RemoveNodeHealthAnnotation(annotations)
+	assert.DeepEqual(t, annotations, map[string]string{"other.annotation": "value"})
+
+	cfg.AddNodeHealthAnnotation(annotations, map[string]string{
+		"dev2": "REASON_AGENT_UNAVAILABLE",
+		"dev3": "",
+	})
+	assert.DeepEqual(t, annotations, map[string]string{
+		"other.annotation":        "value",
+		"test.prefix.dev2.reason": "REASON_AGENT_UNAVAILABLE",
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"math"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 	"github.com/ROCm/device-metrics-exporter/pkg/types"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
 )
 
 const (
@@ -240,24 +242,67 @@ func ValidateAndExport(metric prometheus.GaugeVec, fieldName string,
 }
 
 func NormalizeExtraPodLabels(extraPodLabels map[string]string) map[string]string {
-	extraPodLabelsMap := make(map[string]string)
-	if extraPodLabels != nil {
-		labelCount := 0
+	return NormalizeLabelMapping(extraPodLabels, globals.MaxSupportedPodLabels, "pod labels")
+}
 
-		for prometheusLabel, k8PodLabelName := range extraPodLabels {
-			if labelCount >= globals.MaxSupportedPodLabels {
-				logger.Log.Printf("Max pod labels supported: %v, ignoring extra pod labels.", globals.MaxSupportedPodLabels)
-				break
-			}
-			label := strings.ToLower(prometheusLabel)
-			extraPodLabelsMap[label] = k8PodLabelName
-			labelCount++
+// NormalizeLabelMapping lower cases the prometheus label names of a mapping
+// to kubernetes labels or annotations, the mapping is capped to max entries
+// in the prometheus label name order
+func NormalizeLabelMapping(mapping map[string]string, max int, kind string) map[string]string {
+	normalized := make(map[string]string)
+	keys := make([]string, 0, len(mapping))
+	for prometheusLabel := range mapping {
+		keys = append(keys, prometheusLabel)
+	}
+	sort.Strings(keys)
+	for _, prometheusLabel := range keys {
+		if len(normalized) >= max {
+			logger.Log.Printf("Max %v supported: %v, ignoring extra %v.", kind, max, kind)
+			break
+		}
+		normalized[strings.ToLower(prometheusLabel)] = mapping[prometheusLabel]
+	}
+	return normalized
+}
+
+// FilterLabelMapping drops the entries of a normalized mapping whose
+// prometheus label name is not valid or is reserved, reserved are the
+// exporter labels and the names already taken by the other mappings
+func FilterLabelMapping(mapping map[string]string, reserved map[string]bool, kind string) map[string]string {
+	filtered := make(map[string]string)
+	for prometheusLabel, k8sName := range mapping {
+		if !model.LabelName(prometheusLabel).IsValidLegacy() || strings.HasPrefix(prometheusLabel, "__") {
+			logger.Log.Printf("Invalid label name %s in %v, ignoring...", prometheusLabel, kind)
+			continue
+		}
+		if reserved[prometheusLabel] {
+			logger.Log.Printf("Label %s in %v conflicts with an exported label, ignoring...", prometheusLabel, kind)
+			continue
 		}
+		filtered[prometheusLabel] = k8sName
+	}
+	return filtered
+}
+
+// MapLabels sets the prometheus labels of the mapping from the kubernetes
+// labels or annotations values, missing values are set empty
+func MapLabels(labels map[string]string, mapping map[string]string, values map[string]string) {
+	for prometheusLabel, k8sName := range mapping {
+		labels[strings.ToLower(prometheusLabel)] = values[k8sName]
 	}
-	return extraPodLabelsMap
 }
 
 func GetPodLabels(podInfo *scheduler.PodResourceInfo, k8sPodLabelsMap map[string]map[string]string) map[string]string {
+	return getPodMetadata(podInfo, k8sPodLabelsMap)
+}
+
+// GetPodAnnotations returns the annotations of the pod from the map of
+// annotations of the pods on the node
+func GetPodAnnotations(podInfo *scheduler.PodResourceInfo, k8sPodAnnotationsMap map[string]map[string]string) map[string]string {
+	return getPodMetadata(podInfo, k8sPodAnnotationsMap)
+}
+
+func getPodMetadata(podInfo *scheduler.PodResourceInfo, k8sPodMetadataMap map[string]map[string]string) map[string]string {
 	if podInfo != nil {
 		podName, podNs := podInfo.Pod, podInfo.Namespace
 		if podName != "" && podNs != "" {
@@ -265,8 +310,8 @@ func GetPodLabels(podInfo *scheduler.PodResourceInfo, k8sPodLabelsMap map[string
 				PodName:   podName,
 				Namespace: podNs,
 			}
-			if labels, exists := k8sPodLabelsMap[pKey.String()]; exists {
-				return labels
+			if metadata, exists := k8sPodMetadataMap[pKey.String()]; exists {
+				return metadata
 			}
 		}
 	}
@@ -17,11 +17,16 @@ limitations under the License.
 package utils
 
 import (
+	"fmt"
 	"math"
+	"reflect"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 )
 
 func TestGetPCIeBaseAddress(t *testing.T) {
@@ -285,3 +290,55 @@ func TestValidateAndExport(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelMapping(t *testing.T) {
+	logger.Init(true)
+
+	mapping := map[string]string{}
+	for i := 0; i < 12; i++ {
+		mapping[fmt.Sprintf("LABEL_%02d", i)] = fmt.Sprintf("example.com/key-%d", i)
+	}
+	normalized := NormalizeLabelMapping(mapping, 10, "node labels")
+	if len(normalized) != 10 {
+		t.Fatalf("NormalizeLabelMapping() kept %v entries; want 10", len(normalized))
+	}
+	// the first names in order are kept
+	if normalized["label_09"] != "example.com/key-9" {
+		t.Errorf("NormalizeLabelMapping() label_09 = %q; want example.com/key-9", normalized["label_09"])
+	}
+	if _, ok := normalized["label_10"]; ok {
+		t.Errorf("NormalizeLabelMapping() kept label_10 over the cap")
+	}
+
+	filtered := FilterLabelMapping(map[string]string{
+		"zone":      "topology.kubernetes.io/zone",
+		"hostname":  "kubernetes.io/hostname",
+		"team":      "example.com/team",
+		"rack-id":   "example.com/rack",
+		"__name__":  "example.com/name",
+		"0_invalid": "example.com/invalid",
+	}, map[string]bool{"hostname": true, "team": true}, "node labels")
+	want := map[string]string{"zone": "topology.kubernetes.io/zone"}
+	if !reflect.DeepEqual(filtered, want) {
+		t.Errorf("FilterLabelMapping() = %v; want %v", filtered, want)
+	}
+
+	labels := map[string]string{"gpu_id": "0"}
+	MapLabels(labels, map[string]string{"zone": "topology.kubernetes.io/zone", "rack": "example.com/rack"},
+		map[string]string{"topology.kubernetes.io/zone": "us-east-1a"})
+	want = map[string]string{"gpu_id": "0", "zone": "us-east-1a", "rack": ""}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("MapLabels() = %v; want %v", labels, want)
+	}
+
+	annotations := map[string]map[string]string{
+		"ns1-pod1": {"example.com/team": "ml"},
+	}
+	podInfo := &scheduler.PodResourceInfo{Pod: "pod1", Namespace: "ns1"}
+	if got := GetPodAnnotations(podInfo, annotations)["example.com/team"]; got != "ml" {
+		t.Errorf("GetPodAnnotations() team = %q; want ml", got)
+	}
+	if got := GetPodAnnotations(nil, annotations); len(got) != 0 {
+		t.Errorf("GetPodAnnotations(nil) = %v; want empty", got)
+	}
+}
@@ -36,7 +36,9 @@ import (
 
 	"github.com/fsnotify/fsnotify"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -673,8 +675,6 @@ func (tr *TestRunner) watchGPUState() {
 	}
 
 	c := metricssvc.NewMetricsServiceClient(conn)
-	watchTicker := time.NewTicker(globals.GPUStateWatchFreq)
-	defer watchTicker.Stop()
 
 	// handle test runner crash or restart
 	// read existing test runner status db
@@ -715,7 +715,101 @@ func (tr *TestRunner) watchGPUState() {
 	}
 
 	go tr.watchConfigFile()
-	for range watchTicker.C {
+	for {
+		err := tr.watchGPUStateStream(c)
+		if status.Code(err) == codes.Unimplemented {
+			// the exporter may be upgraded, the watch is retried after
+			// polling for a while
+			logger.Log.Printf("exporter doesn't support GPU state watch, polling GPU state for %v",
+				globals.GPUStateWatchRetryFreq)
+			tr.pollGPUState(c, globals.GPUStateWatchRetryFreq)
+			continue
+		}
+		logger.Log.Printf("GPU state watch stream closed: %v, reconnecting", err)
+		time.Sleep(globals.GPUStateConnRetryFreq)
+	}
+}
+
+// watchGPUStateStream keeps the GPU states up to date from the exporter watch
+// stream and handles the GPU states on every change. The states are also
+// handled every GPUStateWatchFreq as the stream only carries the changes and
+// GPUs that stay unhealthy still need the tests to be re-triggered
+func (tr *TestRunner) watchGPUStateStream(c metricssvc.MetricsServiceClient) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := c.Watch(ctx, &metricssvc.GPUWatchRequest{})
+	if err != nil {
+		return err
+	}
+	events := make(chan *metricssvc.GPUStateEvent)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	watchTicker := time.NewTicker(globals.GPUStateWatchFreq)
+	defer watchTicker.Stop()
+	// nil until the snapshot is received
+	var states map[string]*metricssvc.GPUState
+	for {
+		select {
+		case err := <-errCh:
+			return err
+		case event := <-events:
+			logger.Log.Printf("GPU State event: %s", event.String())
+			switch event.Type {
+			case metricssvc.StateEventType_SNAPSHOT:
+				states = map[string]*metricssvc.GPUState{}
+				fallthrough
+			case metricssvc.StateEventType_ADDED, metricssvc.StateEventType_MODIFIED:
+				if states == nil {
+					states = map[string]*metricssvc.GPUState{}
+				}
+				for _, state := range event.GPUState {
+					states[state.ID] = state
+				}
+			case metricssvc.StateEventType_DELETED:
+				for _, state := range event.GPUState {
+					delete(states, state.ID)
+				}
+			}
+		case <-watchTicker.C:
+			if states == nil {
+				continue
+			}
+		}
+		gpuStates := make([]*metricssvc.GPUState, 0, len(states))
+		for _, state := range states {
+			gpuStates = append(gpuStates, state)
+		}
+		tr.handleGPUStates(gpuStates)
+	}
+}
+
+// pollGPUState lists the GPU states every GPUStateWatchFreq for the
+// duration, used with the exporters without the watch support
+func (tr *TestRunner) pollGPUState(c metricssvc.MetricsServiceClient, duration time.Duration) {
+	watchTicker := time.NewTicker(globals.GPUStateWatchFreq)
+	defer watchTicker.Stop()
+	deadline := time.NewTimer(duration)
+	defer deadline.Stop()
+	for {
+		select {
+		case <-deadline.C:
+			return
+		case <-watchTicker.C:
+		}
 		ctx, cancel := context.WithTimeout(context.Background(), globals.GPUStateReqTimeout)
 		r, err := c.List(ctx, &emptypb.Empty{})
 		if err != nil {
@@ -725,34 +819,38 @@ func (tr *TestRunner) watchGPUState() {
 		}
 		logger.Log.Printf("GPU State: %s", r.String())
 		cancel()
+		tr.handleGPUStates(r.GetGPUState())
+	}
+}
 
-		healthyGPUIDs := []string{}
-		unHealthyGPUIDs := []string{}
-		if r != nil {
-			for _, state := range r.GPUState {
-				// if any GPU is not healthy, start a test against those GPUs
-				if !strings.EqualFold(state.Health, metricssvc.GPUHealth_HEALTHY.String()) {
-					if len(state.AssociatedWorkload) == 0 {
-						unHealthyGPUIDs = append(unHealthyGPUIDs, state.ID)
-					} else {
-						logger.Log.Printf("found GPU %+v unhealthy but still associated with workload %+v", state.ID, state.AssociatedWorkload)
-					}
-				} else {
-					healthyGPUIDs = append(healthyGPUIDs, state.ID)
-				}
+// handleGPUStates starts the test on the unhealthy GPUs without workloads and
+// cleans up the test status of the healthy GPUs
+func (tr *TestRunner) handleGPUStates(gpuStates []*metricssvc.GPUState) {
+	healthyGPUIDs := []string{}
+	unHealthyGPUIDs := []string{}
+	testDegraded := tr.getTestDegradedGPU()
+	for _, state := range gpuStates {
+		// if any GPU is not healthy, start a test against those GPUs
+		if !isGPUStateHealthy(state.Health, testDegraded) {
+			if len(state.AssociatedWorkload) == 0 {
+				unHealthyGPUIDs = append(unHealthyGPUIDs, state.ID)
+			} else {
+				logger.Log.Printf("found GPU %+v unhealthy but still associated with workload %+v", state.ID, state.AssociatedWorkload)
 			}
-		}
-
-		// start test on unhealthy GPU
-		if len(unHealthyGPUIDs) > 0 {
-			logger.Log.Printf("found GPU with unhealthy state %+v", unHealthyGPUIDs)
-			go tr.testGPU(testrunnerGen.TestTrigger_AUTO_UNHEALTHY_GPU_WATCH.String(), unHealthyGPUIDs, false)
 		} else {
-			logger.Log.Printf("all GPUs are healthy or associated with workloads, skip testing")
+			healthyGPUIDs = append(healthyGPUIDs, state.ID)
 		}
+	}
 
-		tr.cleanupHealthyGPUTestStatus(healthyGPUIDs)
+	// start test on unhealthy GPU
+	if len(unHealthyGPUIDs) > 0 {
+		logger.Log.Printf("found GPU with unhealthy state %+v", unHealthyGPUIDs)
+		go tr.testGPU(testrunnerGen.TestTrigger_AUTO_UNHEALTHY_GPU_WATCH.String(), unHealthyGPUIDs, false)
+	} else {
+		logger.Log.Printf("all GPUs are healthy or associated with workloads, skip testing")
 	}
+
+	tr.cleanupHealthyGPUTestStatus(healthyGPUIDs)
 }
 
 func (tr *TestRunner) watchConfigFile() {
@@ -1231,6 +1329,21 @@ func (tr *TestRunner) getTestParameters(lock bool) *testrunnerGen.TestParameters
 	return tr.globalTestRunnerConfig.TestConfig[tr.testCategory].TestLocationTrigger[tr.testLocation].TestParameters[tr.testTrigger]
 }
 
+func (tr *TestRunner) getTestDegradedGPU() bool {
+	tr.Lock()
+	defer tr.Unlock()
+	return tr.globalTestRunnerConfig.GetTestDegradedGPU()
+}
+
+// isGPUStateHealthy returns false if the GPU health state should trigger a test
+// DEGRADED GPUs are tested only when testDegraded is set
+func isGPUStateHealthy(health string, testDegraded bool) bool {
+	if strings.EqualFold(health, metricssvc.GPUHealth_HEALTHY.String()) {
+		return true
+	}
+	return strings.EqualFold(health, metricssvc.GPUHealth_DEGRADED.String()) && !testDegraded
+}
+
 func (tr *TestRunner) getLogsExportConfig() []*testrunnerGen.TestLogsExportConfig {
 	tr.Lock()
 	defer tr.Unlock()
@@ -221,3 +221,14 @@ func TestGetOverallResult(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGPUStateHealthy(t *testing.T) {
+	assert.True(t, isGPUStateHealthy("healthy", false))
+	assert.True(t, isGPUStateHealthy("degraded", false))
+	assert.False(t, isGPUStateHealthy("degraded", true))
+	assert.False(t, isGPUStateHealthy("unhealthy", false))
+	assert.False(t, isGPUStateHealthy("unknown", false))
+
+	tr := &TestRunner{globalTestRunnerConfig: &testrunnerGen.TestRunnerConfig{TestDegradedGPU: true}}
+	assert.True(t, tr.getTestDegradedGPU())
+}
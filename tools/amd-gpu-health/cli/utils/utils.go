@@ -355,6 +355,8 @@ func FetchMetricFromGPU(gpu *amdgpu.GPU, metricName string) []float64 {
 		}
 	case exportermetrics.GPUMetricField_GPU_POWER_USAGE.String():
 		res = float64(stats.PowerUsage)
+	case exportermetrics.GPUMetricField_GPU_FAN_SPEED.String():
+		res = float64(stats.FanSpeed)
 	case exportermetrics.GPUMetricField_GPU_TOTAL_VRAM.String():
 		if gpu.Status.GetVRAMStatus() != nil {
 			res = float64(gpu.Status.GetVRAMStatus().Size)
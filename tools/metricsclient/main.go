@@ -25,6 +25,7 @@ import (
 	"log"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -67,17 +68,30 @@ func prettyPrintGPUState(resp *metricssvc.GPUStateResponse) {
 		fmt.Println(string(jsonData))
 		return
 	}
-	sortOp := make(map[string]*metricssvc.GPUState)
-	for _, gs := range resp.GPUState {
-		sortOp[gs.ID] = gs
-	}
-	fmt.Printf("%-10s %-40s %-10s %-30s\n",
-		"ID", "UUID", "Health", "Associated Workload")
+	sortOp := append([]*metricssvc.GPUState{}, resp.GPUState...)
+	sort.Slice(sortOp, func(i, j int) bool {
+		a, _ := strconv.Atoi(sortOp[i].ID)
+		b, _ := strconv.Atoi(sortOp[j].ID)
+		return a < b
+	})
+	fmt.Printf("%-10s %-40s %-10s %-25s %-30s\n",
+		"ID", "UUID", "Health", "Last Transition", "Associated Workload")
 	fmt.Println("------------------------------------------------")
-	for i := 0; i < len(sortOp); i++ {
-		gs := sortOp[fmt.Sprintf("%d", i)]
-		fmt.Printf("%-10v %-40s %-10v %+v\n", gs.ID, gs.UUID,
-			gs.Health, gs.AssociatedWorkload)
+	for _, gs := range sortOp {
+		transitionTime := ""
+		if gs.LastTransitionTime != nil {
+			transitionTime = gs.LastTransitionTime.AsTime().Format(time.RFC3339)
+		}
+		fmt.Printf("%-10v %-40s %-10v %-25s %+v\n", gs.ID, gs.UUID,
+			gs.Health, transitionTime, gs.AssociatedWorkload)
+		for _, r := range gs.Reasons {
+			firstSeen := ""
+			if r.FirstSeen != nil {
+				firstSeen = r.FirstSeen.AsTime().Format(time.RFC3339)
+			}
+			fmt.Printf("%-10s reason: %v rule: %v value: %v threshold: %v event: %v first seen: %v %v\n", "",
+				r.Type, r.Rule, r.Value, r.Threshold, r.EventID, firstSeen, r.Description)
+		}
 	}
 	fmt.Println("------------------------------------------------")
 }
@@ -184,6 +198,100 @@ func setError(socketPath, filepath string) error {
 	return nil
 }
 
+func clearHealth(socketPath, id string) error {
+	conn, err := grpc.NewClient(
+		socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()), // Use insecure credentials for simplicity
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := metricssvc.NewMetricsServiceClient(conn)
+
+	req := &metricssvc.GPUHealthClearRequest{}
+	if id != "" {
+		req.ID = []string{id}
+	}
+	resp, err := client.ClearGPUHealth(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("cleared gpu health : %v\n", resp.ID)
+	return nil
+}
+
+func getHealthHistory(socketPath, id, state string) error {
+	conn, err := grpc.NewClient(
+		socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()), // Use insecure credentials for simplicity
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := metricssvc.NewMetricsServiceClient(conn)
+
+	req := &metricssvc.GPUHealthHistoryRequest{}
+	if id != "" {
+		req.ID = []string{id}
+	}
+	if state != "" {
+		req.Health = []string{state}
+	}
+	resp, err := client.GetHealthHistory(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	if *jout {
+		jsonData, err := json.MarshalIndent(resp, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(jsonData))
+		return nil
+	}
+	fmt.Printf("%-25s %-10s %-10s %-10s %-30s\n", "Time", "ID", "From", "To", "Reasons")
+	fmt.Println("------------------------------------------------")
+	for _, e := range resp.Entry {
+		reasons := []string{}
+		for _, r := range e.Reasons {
+			reasons = append(reasons, fmt.Sprintf("%v %v", r.Type, r.Rule))
+		}
+		fmt.Printf("%-25s %-10s %-10s %-10s %v\n", e.Time.AsTime().Format(time.RFC3339), e.ID,
+			e.PreviousHealth, e.Health, strings.Join(reasons, ","))
+	}
+	fmt.Println("------------------------------------------------")
+	return nil
+}
+
+func watch(socketPath string) error {
+	conn, err := grpc.NewClient(
+		socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()), // Use insecure credentials for simplicity
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := metricssvc.NewMetricsServiceClient(conn)
+	stream, err := client.Watch(context.Background(), &metricssvc.GPUWatchRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("event: %v\n", event.Type)
+		prettyPrintGPUState(&metricssvc.GPUStateResponse{GPUState: event.GPUState})
+	}
+}
+
 func getGpuAgent(port string, isJson bool) {
 	conn, err := grpc.NewClient(
 		fmt.Sprintf("localhost:%s", port),
@@ -360,6 +468,12 @@ func main() {
 	var (
 		socketPath = flag.String("socket", fmt.Sprintf("unix://%v", globals.MetricsSocketPath), "metrics grpc socket path")
 		getOpt     = flag.Bool("get", false, "get health status of gpu")
+		clearOpt   = flag.Bool("clear", false, "clear sticky unhealthy state of gpu")
+		clearID    = flag.String("clear-id", "", "gpu id to clear, all gpus if empty")
+		historyOpt = flag.Bool("history", false, "get health transition history of gpu")
+		watchOpt   = flag.Bool("watch", false, "watch gpu health state changes")
+		historyID  = flag.String("history-id", "", "gpu id filter for history, all gpus if empty")
+		historyHS  = flag.String("history-state", "", "health state filter for history")
 		setId      = flag.String("id", "1", "gpu id")
 		nodeLabel  = flag.Bool("label", false, "get k8s node label")
 		podRes     = flag.Bool("pod", false, "get node resource info")
@@ -404,6 +518,27 @@ func main() {
 		}
 	}
 
+	if *clearOpt {
+		if err := clearHealth(*socketPath, *clearID); err != nil {
+			log.Fatalf("request failed :%v", err)
+		}
+		return
+	}
+
+	if *watchOpt {
+		if err := watch(*socketPath); err != nil {
+			log.Fatalf("request failed :%v", err)
+		}
+		return
+	}
+
+	if *historyOpt {
+		if err := getHealthHistory(*socketPath, *historyID, *historyHS); err != nil {
+			log.Fatalf("request failed :%v", err)
+		}
+		return
+	}
+
 	if *getOpt {
 		err := get(*socketPath, *setId)
 		if err != nil {